@@ -1,18 +1,41 @@
 package main
 
 import (
+	"context"
 	"log"
+	"net"
+	"net/http"
+	"time"
 
 	"pr-reviewer-assignment-service/internal/config"
 	"pr-reviewer-assignment-service/internal/database"
+	"pr-reviewer-assignment-service/internal/graphqlapi"
+	"pr-reviewer-assignment-service/internal/grpcapi"
 	"pr-reviewer-assignment-service/internal/handlers"
 	"pr-reviewer-assignment-service/internal/middleware"
+	"pr-reviewer-assignment-service/internal/notify"
 	"pr-reviewer-assignment-service/internal/repository"
+	"pr-reviewer-assignment-service/internal/scheduler"
 	"pr-reviewer-assignment-service/internal/services"
 
 	"github.com/gin-gonic/gin"
 )
 
+// schedulerLockKey - ключ advisory-блокировки, которой реплики выбирают лидера фоновых задач.
+const schedulerLockKey int64 = 7_362_001
+
+// reminderCheckInterval - как часто ищутся ревью, о которых пора напомнить.
+const reminderCheckInterval = 15 * time.Minute
+
+// idempotencyCleanupInterval - как часто удаляются истёкшие ответы по Idempotency-Key.
+const idempotencyCleanupInterval = time.Hour
+
+// Токены API, общие для REST и gRPC.
+const (
+	adminToken = "admin-token"
+	userToken  = "user-token"
+)
+
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
@@ -28,13 +51,129 @@ func main() {
 	userRepo := repository.NewPostgresUserRepository(db.Pool)
 	teamRepo := repository.NewPostgresTeamRepository(db.Pool)
 	prRepo := repository.NewPostgresPullRequestRepository(db.Pool)
+	repoRepo := repository.NewPostgresRepoRepository(db.Pool)
+	codeOwnersRepo := repository.NewPostgresCodeOwnersRepository(db.Pool)
+	idempotencyRepo := repository.NewPostgresIdempotencyRepository(db.Pool)
+	eventRepo := repository.NewPostgresEventRepository(db.Pool)
+	webhookRepo := repository.NewPostgresWebhookRepository(db.Pool)
+	gitIdentityRepo := repository.NewPostgresGitIdentityRepository(db.Pool)
+	notificationRepo := repository.NewPostgresNotificationRepository(db.Pool)
+
+	businessHours, err := services.NewBusinessHours(cfg.Business.Timezone, cfg.Business.StartHour, cfg.Business.EndHour)
+	if err != nil {
+		log.Fatalf("Invalid business hours: %v", err)
+	}
 
 	userSvc := services.NewUserService(userRepo)
 	teamSvc := services.NewTeamService(teamRepo, userRepo)
-	prSvc := services.NewPullRequestService(prRepo, userRepo, teamRepo, userSvc)
+	prSvc := services.NewPullRequestService(prRepo, userRepo, teamRepo, repoRepo, codeOwnersRepo, userSvc)
+	prSvc.SetRotation(cfg.Rotation.HistorySize, time.Duration(cfg.Rotation.DecayDays)*24*time.Hour)
+	prSvc.SetBusinessHours(businessHours)
+	transactor := repository.NewPostgresTransactor(db.Pool)
+	prSvc.SetOutbox(transactor, eventRepo)
 	statSvc := services.NewStatisticService(prRepo, teamRepo, userRepo)
+	membershipSvc := services.NewMembershipService(teamRepo, userRepo, prRepo, prSvc, transactor)
+	codeOwnersSvc := services.NewCodeOwnersService(codeOwnersRepo, repoRepo, userRepo, teamRepo)
+	repoSvc := services.NewRepoService(repoRepo, teamRepo)
+	slaSvc := services.NewReviewSLAService(prRepo, teamRepo, userRepo, businessHours)
+	autoReassignSvc := services.NewAutoReassignService(prRepo, teamRepo, prSvc, businessHours)
+
+	webhookSvc := services.NewWebhookService(webhookRepo, &http.Client{
+		Timeout: time.Duration(cfg.Webhook.TimeoutSeconds) * time.Second,
+	}, services.WebhookRetryPolicy{
+		MaxAttempts: cfg.Webhook.MaxAttempts,
+		Backoff:     time.Duration(cfg.Webhook.BackoffSeconds) * time.Second,
+		MaxBackoff:  time.Duration(cfg.Webhook.MaxBackoffSeconds) * time.Second,
+	})
 
-	handler := handlers.NewHandler(teamSvc, userSvc, prSvc, statSvc)
+	gitHostSvc := services.NewGitHostService(prSvc, gitIdentityRepo, userRepo, services.GitHostSecrets{
+		GitHub: cfg.GitHost.GitHubWebhookSecret,
+		GitLab: cfg.GitHost.GitLabWebhookToken,
+	})
+
+	eventStreamSvc := services.NewEventStreamService(eventRepo, teamRepo, userRepo,
+		time.Duration(max(cfg.EventStream.PollIntervalSeconds, 1))*time.Second)
+
+	notifiers := map[string]notify.Notifier{notify.ChannelLog: notify.NewLogNotifier(nil)}
+	if cfg.Notify.SMTPHost != "" {
+		notifiers[notify.ChannelEmail] = notify.NewSMTPNotifier(notify.SMTPConfig{
+			Host:     cfg.Notify.SMTPHost,
+			Port:     cfg.Notify.SMTPPort,
+			Username: cfg.Notify.SMTPUsername,
+			Password: cfg.Notify.SMTPPassword,
+			From:     cfg.Notify.SMTPFrom,
+		})
+	}
+	if cfg.Notify.ChatWebhookURL != "" {
+		notifiers[notify.ChannelChat] = notify.NewChatNotifier(cfg.Notify.ChatWebhookURL, &http.Client{Timeout: 10 * time.Second})
+	}
+	notificationSvc := services.NewNotificationService(eventRepo, notificationRepo, prRepo, userRepo, notifiers,
+		time.Duration(cfg.Notify.ReminderAfterHours)*time.Hour)
+	reviewDigestSvc := services.NewReviewDigestService(prRepo, teamRepo, userRepo, statSvc, slaSvc, notificationSvc, businessHours)
+
+	sched := scheduler.New(database.NewAdvisoryLock(db.Pool, schedulerLockKey))
+	sched.Add(scheduler.Job{
+		Name:     "idempotency-keys",
+		Interval: idempotencyCleanupInterval,
+		Run: func(ctx context.Context) error {
+			deleted, err := idempotencyRepo.DeleteExpiredIdempotencyKeys(ctx)
+			if deleted > 0 {
+				log.Printf("Deleted %d expired idempotency keys", deleted)
+			}
+			return err
+		},
+	})
+	if cfg.Scheduler.StaleReviewsIntervalSeconds > 0 {
+		sched.Add(scheduler.Job{
+			Name:     "stale-reviews",
+			Interval: time.Duration(cfg.Scheduler.StaleReviewsIntervalSeconds) * time.Second,
+			Run: func(ctx context.Context) error {
+				records, err := autoReassignSvc.ReassignStaleReviews(ctx)
+				if len(records) > 0 {
+					log.Printf("Reassigned %d stale reviews", len(records))
+				}
+				return err
+			},
+		})
+	}
+	if cfg.Webhook.DispatchIntervalSeconds > 0 {
+		sched.Add(scheduler.Job{
+			Name:     "webhooks",
+			Interval: time.Duration(cfg.Webhook.DispatchIntervalSeconds) * time.Second,
+			Run:      webhookSvc.Dispatch,
+		})
+	}
+	if cfg.Notify.IntervalSeconds > 0 {
+		sched.Add(scheduler.Job{
+			Name:     "notifications",
+			Interval: time.Duration(cfg.Notify.IntervalSeconds) * time.Second,
+			Run:      notificationSvc.ProcessEvents,
+		})
+		if cfg.Notify.ReminderAfterHours > 0 {
+			sched.Add(scheduler.Job{
+				Name:     "review-reminders",
+				Interval: reminderCheckInterval,
+				Run:      notificationSvc.SendReminders,
+			})
+		}
+		if cfg.Notify.DigestIntervalHours > 0 {
+			sched.Add(scheduler.Job{
+				Name:     "notification-digests",
+				Interval: time.Duration(cfg.Notify.DigestIntervalHours) * time.Hour,
+				Run:      notificationSvc.SendDigests,
+			})
+		}
+	}
+	if cfg.Notify.ReviewDigestIntervalHours > 0 {
+		sched.Add(scheduler.Job{
+			Name:     "review-digests",
+			Interval: time.Duration(cfg.Notify.ReviewDigestIntervalHours) * time.Hour,
+			Run:      reviewDigestSvc.SendDigests,
+		})
+	}
+	go sched.Run(context.Background())
+
+	handler := handlers.NewHandler(teamSvc, userSvc, prSvc, statSvc, membershipSvc, codeOwnersSvc, repoSvc, slaSvc, autoReassignSvc, webhookSvc, gitHostSvc, eventStreamSvc, notificationSvc, reviewDigestSvc)
 	healthHandler := handlers.NewHealthHandler(userRepo)
 
 	gin.SetMode(gin.ReleaseMode)
@@ -46,27 +185,117 @@ func main() {
 
 	r.GET("/health", healthHandler.Health)
 
+	// Git-хостинги аутентифицируются подписью запроса, а не токеном API.
+	integrations := r.Group("/integrations")
+	{
+		integrations.POST("/github", handler.ReceiveGitHubWebhook)
+		integrations.POST("/gitlab", handler.ReceiveGitLabWebhook)
+	}
+
 	api := r.Group("/api")
-	api.Use(middleware.AuthMiddleware("admin-token", "user-token"))
+	api.Use(middleware.AuthMiddleware(adminToken, userToken))
+	api.Use(middleware.IdempotencyMiddleware(idempotencyRepo, time.Duration(cfg.Idempotency.TTLHours)*time.Hour))
 	{
 		team := api.Group("/team")
 		{
 			team.POST("/add", handler.CreateTeam)
 			team.GET("/get", handler.GetTeam)
+			team.GET("/subtree", handler.GetTeamSubtree)
+			team.POST("/setParent", middleware.AdminOnlyMiddleware(), handler.SetParentTeam)
+			team.POST("/setPolicies", middleware.AdminOnlyMiddleware(), handler.SetTeamPolicies)
+			team.POST("/setRotationPenalty", middleware.AdminOnlyMiddleware(), handler.SetTeamRotationPenalty)
+			team.POST("/setSLA", middleware.AdminOnlyMiddleware(), handler.SetTeamSLA)
+			team.GET("/overdueReviews", handler.GetTeamOverdueReviews)
+			team.POST("/setAutoReassign", middleware.AdminOnlyMiddleware(), handler.SetTeamAutoReassign)
+			team.POST("/addMembers", middleware.AdminOnlyMiddleware(), handler.AddTeamMembers)
+			team.POST("/removeMembers", middleware.AdminOnlyMiddleware(), handler.RemoveTeamMembers)
+			team.POST("/transferMember", middleware.AdminOnlyMiddleware(), handler.TransferTeamMember)
+			team.POST("/archive", middleware.AdminOnlyMiddleware(), handler.ArchiveTeam)
+			team.POST("/restore", middleware.AdminOnlyMiddleware(), handler.RestoreTeam)
+			team.POST("/delete", middleware.AdminOnlyMiddleware(), handler.DeleteTeam)
 		}
 
 		user := api.Group("/users")
 		{
 			user.POST("/setIsActive", middleware.AdminOnlyMiddleware(), handler.SetUserActive)
+			user.POST("/setSkills", middleware.AdminOnlyMiddleware(), handler.SetUserSkills)
+			user.POST("/setLevel", middleware.AdminOnlyMiddleware(), handler.SetUserLevel)
+			user.POST("/blockPair", middleware.AdminOnlyMiddleware(), handler.BlockPair)
+			user.POST("/unblockPair", middleware.AdminOnlyMiddleware(), handler.UnblockPair)
+			user.GET("/blockedPairs", handler.GetBlockedPairs)
 			user.GET("/getReview", handler.GetUserReviews)
+			user.GET("/overdueReviews", handler.GetUserOverdueReviews)
+			user.POST("/setNotificationPreferences", middleware.AdminOnlyMiddleware(), handler.SetNotificationPreferences)
+			user.GET("/notificationPreferences", handler.GetNotificationPreferences)
+			user.GET("/reviewDigest", handler.GetReviewDigest)
 		}
 
 		pr := api.Group("/pullRequest")
 		{
 			pr.POST("/create", handler.CreatePullRequest)
+			pr.POST("/dryRun", handler.DryRunPullRequest)
 			pr.POST("/merge", handler.MergePullRequest)
+			pr.POST("/ready", handler.MarkReadyForReview)
 			pr.POST("/reassign", handler.ReassignReviewer)
+			pr.POST("/respond", handler.RecordReviewResponse)
+			pr.GET("/get", handler.GetPullRequest)
+			pr.GET("/list", handler.ListPullRequests)
+			pr.GET("/autoReassignments", handler.GetAutoReassignments)
+		}
+
+		codeOwners := api.Group("/codeOwners")
+		{
+			codeOwners.POST("/upload", middleware.AdminOnlyMiddleware(), handler.UploadCodeOwners)
+			codeOwners.GET("/get", handler.GetCodeOwners)
+			codeOwners.GET("/validate", handler.ValidateCodeOwners)
+		}
+
+		repo := api.Group("/repository")
+		{
+			repo.POST("/add", middleware.AdminOnlyMiddleware(), handler.CreateRepo)
+			repo.GET("/get", handler.GetRepo)
+			repo.POST("/update", middleware.AdminOnlyMiddleware(), handler.UpdateRepo)
+		}
+
+		webhooks := api.Group("/webhooks", middleware.AdminOnlyMiddleware())
+		{
+			webhooks.POST("/subscribe", handler.SubscribeWebhook)
+			webhooks.GET("/list", handler.ListWebhooks)
+			webhooks.POST("/delete", handler.DeleteWebhook)
+			webhooks.GET("/deadLetters", handler.GetWebhookDeadLetters)
+			webhooks.POST("/replay", handler.ReplayWebhooks)
+		}
+
+		gitUsers := api.Group("/gitUsers", middleware.AdminOnlyMiddleware())
+		{
+			gitUsers.POST("/map", handler.MapGitUser)
+			gitUsers.POST("/unmap", handler.UnmapGitUser)
+			gitUsers.GET("/list", handler.GetGitUsers)
+		}
+
+		events := api.Group("/events")
+		{
+			events.GET("/stream", handler.StreamEvents)
+		}
+
+		stats := api.Group("/stats")
+		{
+			stats.GET("/fairness", handler.GetFairnessReport)
+		}
+
+		api.POST("/graphql", gin.WrapH(graphqlapi.NewHandler(teamSvc, userSvc, slaSvc, statSvc)))
+	}
+
+	if cfg.Server.GRPCPort != "0" {
+		listener, err := net.Listen("tcp", ":"+cfg.Server.GRPCPort)
+		if err != nil {
+			log.Fatalf("Failed to listen on gRPC port: %v", err)
 		}
+		grpcServer := grpcapi.NewServer(adminToken, userToken, teamSvc, userSvc, prSvc, slaSvc, statSvc)
+		go func() {
+			log.Printf("gRPC server starting on port %s", cfg.Server.GRPCPort)
+			log.Fatal(grpcServer.Serve(listener))
+		}()
 	}
 
 	log.Printf("Server starting on port %s", cfg.Server.Port)
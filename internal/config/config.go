@@ -8,14 +8,24 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	Idempotency IdempotencyConfig
+	Rotation    RotationConfig
+	Business    BusinessHoursConfig
+	Scheduler   SchedulerConfig
+	Webhook     WebhookConfig
+	GitHost     GitHostConfig
+	EventStream EventStreamConfig
+	Notify      NotifyConfig
 }
 
 type ServerConfig struct {
 	Port         string
 	ReadTimeout  int
 	WriteTimeout int
+	// GRPCPort - порт gRPC API; "0" отключает gRPC сервер.
+	GRPCPort string
 }
 
 type DatabaseConfig struct {
@@ -27,6 +37,69 @@ type DatabaseConfig struct {
 	SSLMode  string
 }
 
+type IdempotencyConfig struct {
+	TTLHours int
+}
+
+// RotationConfig - учёт недавних пар автор → ревьювер при назначении: HistorySize последних
+// PR автора, штраф за которые затухает за DecayDays дней.
+type RotationConfig struct {
+	HistorySize int
+	DecayDays   int
+}
+
+// BusinessHoursConfig - рабочее время, в котором отсчитываются сроки SLA ревью:
+// с StartHour до EndHour по будням в часовом поясе Timezone.
+type BusinessHoursConfig struct {
+	Timezone  string
+	StartHour int
+	EndHour   int
+}
+
+// SchedulerConfig - фоновые задачи: StaleReviewsIntervalSeconds - период автоматического
+// переназначения просроченных по SLA ревью, 0 отключает задачу.
+type SchedulerConfig struct {
+	StaleReviewsIntervalSeconds int
+}
+
+// WebhookConfig - рассылка событий подписчикам: период диспетчера (0 отключает рассылку),
+// число попыток доставки, начальная и максимальная задержка повтора и таймаут запроса.
+type WebhookConfig struct {
+	DispatchIntervalSeconds int
+	MaxAttempts             int
+	BackoffSeconds          int
+	MaxBackoffSeconds       int
+	TimeoutSeconds          int
+}
+
+// GitHostConfig - секреты входящих вебхуков GitHub и GitLab; пустой секрет отключает приём.
+type GitHostConfig struct {
+	GitHubWebhookSecret string
+	GitLabWebhookToken  string
+}
+
+// EventStreamConfig - поток событий: период опроса журнала событий для открытых потоков.
+type EventStreamConfig struct {
+	PollIntervalSeconds int
+}
+
+// NotifyConfig - уведомления: период обработки событий (0 отключает уведомления), через
+// сколько часов без ответа ревьювер получает напоминание (0 отключает напоминания), период
+// дайджеста, период сводок ревью (0 отключает сводки) и каналы. Пустой SMTPHost отключает
+// почту, пустой ChatWebhookURL - чат.
+type NotifyConfig struct {
+	IntervalSeconds           int
+	ReminderAfterHours        int
+	DigestIntervalHours       int
+	ReviewDigestIntervalHours int
+	SMTPHost                  string
+	SMTPPort                  int
+	SMTPUsername              string
+	SMTPPassword              string
+	SMTPFrom                  string
+	ChatWebhookURL            string
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
@@ -35,6 +108,7 @@ func Load() (*Config, error) {
 			Port:         getEnv("SERVER_PORT", "8080"),
 			ReadTimeout:  getEnvAsInt("SERVER_READ_TIMEOUT", 30),
 			WriteTimeout: getEnvAsInt("SERVER_WRITE_TIMEOUT", 30),
+			GRPCPort:     getEnv("GRPC_PORT", "9090"),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -44,6 +118,47 @@ func Load() (*Config, error) {
 			DBName:   getEnv("DB_NAME", "reviewer_assigner"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
+		Idempotency: IdempotencyConfig{
+			TTLHours: getEnvAsInt("IDEMPOTENCY_TTL_HOURS", 24),
+		},
+		Rotation: RotationConfig{
+			HistorySize: getEnvAsInt("ROTATION_HISTORY_SIZE", 5),
+			DecayDays:   getEnvAsInt("ROTATION_DECAY_DAYS", 30),
+		},
+		Business: BusinessHoursConfig{
+			Timezone:  getEnv("BUSINESS_TIMEZONE", "UTC"),
+			StartHour: getEnvAsInt("BUSINESS_HOURS_START", 9),
+			EndHour:   getEnvAsInt("BUSINESS_HOURS_END", 18),
+		},
+		Scheduler: SchedulerConfig{
+			StaleReviewsIntervalSeconds: getEnvAsInt("STALE_REVIEWS_INTERVAL_SECONDS", 300),
+		},
+		Webhook: WebhookConfig{
+			DispatchIntervalSeconds: getEnvAsInt("WEBHOOK_DISPATCH_INTERVAL_SECONDS", 5),
+			MaxAttempts:             getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			BackoffSeconds:          getEnvAsInt("WEBHOOK_BACKOFF_SECONDS", 30),
+			MaxBackoffSeconds:       getEnvAsInt("WEBHOOK_MAX_BACKOFF_SECONDS", 3600),
+			TimeoutSeconds:          getEnvAsInt("WEBHOOK_TIMEOUT_SECONDS", 10),
+		},
+		GitHost: GitHostConfig{
+			GitHubWebhookSecret: getEnv("GITHUB_WEBHOOK_SECRET", ""),
+			GitLabWebhookToken:  getEnv("GITLAB_WEBHOOK_TOKEN", ""),
+		},
+		EventStream: EventStreamConfig{
+			PollIntervalSeconds: getEnvAsInt("EVENT_STREAM_POLL_INTERVAL_SECONDS", 1),
+		},
+		Notify: NotifyConfig{
+			IntervalSeconds:           getEnvAsInt("NOTIFY_INTERVAL_SECONDS", 30),
+			ReminderAfterHours:        getEnvAsInt("NOTIFY_REMINDER_AFTER_HOURS", 24),
+			DigestIntervalHours:       getEnvAsInt("NOTIFY_DIGEST_INTERVAL_HOURS", 24),
+			ReviewDigestIntervalHours: getEnvAsInt("REVIEW_DIGEST_INTERVAL_HOURS", 24),
+			SMTPHost:                  getEnv("SMTP_HOST", ""),
+			SMTPPort:                  getEnvAsInt("SMTP_PORT", 587),
+			SMTPUsername:              getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:              getEnv("SMTP_PASSWORD", ""),
+			SMTPFrom:                  getEnv("SMTP_FROM", "reviews@localhost"),
+			ChatWebhookURL:            getEnv("CHAT_WEBHOOK_URL", ""),
+		},
 	}
 
 	return config, nil
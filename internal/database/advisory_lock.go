@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AdvisoryLock - сессионная advisory-блокировка Postgres для выбора лидера среди реплик.
+// Блокировка держится на выделенном соединении пула и снимается с его закрытием,
+// поэтому упавшая реплика не удерживает лидерство.
+type AdvisoryLock struct {
+	pool *pgxpool.Pool
+	key  int64
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+func NewAdvisoryLock(pool *pgxpool.Pool, key int64) *AdvisoryLock {
+	return &AdvisoryLock{pool: pool, key: key}
+}
+
+// TryLock захватывает блокировку без ожидания. Если блокировка уже удерживается, проверяет,
+// что её соединение живо; при потере соединения пробует захватить блокировку заново.
+func (l *AdvisoryLock) TryLock(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.Ping(ctx); err == nil {
+			return true, nil
+		}
+		l.drop()
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var locked bool
+	err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&locked)
+	if err != nil || !locked {
+		conn.Release()
+		return false, err
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Unlock снимает блокировку и возвращает соединение в пул.
+func (l *AdvisoryLock) Unlock(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	if err != nil {
+		l.drop()
+		return err
+	}
+
+	l.conn.Release()
+	l.conn = nil
+	return nil
+}
+
+// drop закрывает соединение блокировки, не возвращая его в пул: вместе с ним сервер
+// снимает блокировку, если она ещё удерживается.
+func (l *AdvisoryLock) drop() {
+	_ = l.conn.Conn().Close(context.Background())
+	l.conn.Release()
+	l.conn = nil
+}
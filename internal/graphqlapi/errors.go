@@ -0,0 +1,47 @@
+package graphqlapi
+
+import (
+	"errors"
+	"log"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
+)
+
+// apiError - ошибка резолвера с кодом ошибки REST API в extensions.code.
+type apiError struct {
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func (e *apiError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+type errorMapping struct {
+	err  error
+	code string
+}
+
+var errorMappings = []errorMapping{
+	{services.ErrTeamNotFound, models.ErrorCodeNotFound},
+	{services.ErrUserNotFound, models.ErrorCodeNotFound},
+	{services.ErrInvalidArgument, models.ErrorCodeInvalidRequest},
+}
+
+// resolverError переводит ошибку сервиса в apiError. Неизвестные ошибки логируются и
+// возвращаются без деталей.
+func resolverError(err error) error {
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			return &apiError{code: m.code, message: err.Error()}
+		}
+	}
+
+	log.Printf("GraphQL resolver error: %v", err)
+	return &apiError{code: models.ErrorCodeInternal, message: "Internal server error"}
+}
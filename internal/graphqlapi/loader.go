@@ -0,0 +1,152 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
+)
+
+// batchLoader загружает значения по ключам пачками. Ключи, заранее зарегистрированные prime,
+// загружаются одним вызовом fetch вместе с первым запрошенным ключом; загруженные значения
+// кешируются до конца запроса. Параллельные load ждут текущую загрузку, а не запускают свою.
+type batchLoader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending []K
+	queued  map[K]bool
+	values  map[K]V
+	errs    map[K]error
+}
+
+func newBatchLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *batchLoader[K, V] {
+	return &batchLoader[K, V]{
+		fetch:  fetch,
+		queued: make(map[K]bool),
+		values: make(map[K]V),
+		errs:   make(map[K]error),
+	}
+}
+
+// prime регистрирует ключи для следующей загрузки.
+func (l *batchLoader[K, V]) prime(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.primeLocked(keys...)
+}
+
+func (l *batchLoader[K, V]) primeLocked(keys ...K) {
+	for _, key := range keys {
+		if _, loaded := l.errs[key]; loaded || l.queued[key] {
+			continue
+		}
+		l.queued[key] = true
+		l.pending = append(l.pending, key)
+	}
+}
+
+// load возвращает значение ключа; отсутствующий в ответе fetch ключ даёт нулевое значение.
+func (l *batchLoader[K, V]) load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err, loaded := l.errs[key]; loaded {
+		return l.values[key], err
+	}
+
+	l.primeLocked(key)
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.fetch(ctx, keys)
+	for _, k := range keys {
+		delete(l.queued, k)
+		l.values[k] = values[k]
+		l.errs[k] = err
+	}
+
+	return l.values[key], err
+}
+
+// onceLoader загружает значение, общее для всего запроса, не больше одного раза.
+type onceLoader[V any] struct {
+	fetch func(ctx context.Context) (V, error)
+
+	once  sync.Once
+	value V
+	err   error
+}
+
+func (l *onceLoader[V]) load(ctx context.Context) (V, error) {
+	l.once.Do(func() {
+		l.value, l.err = l.fetch(ctx)
+	})
+	return l.value, l.err
+}
+
+// loaders - загрузчики одного GraphQL запроса.
+type loaders struct {
+	members       *batchLoader[string, []models.TeamMember]
+	reviews       *batchLoader[string, []models.ReviewAssignment]
+	assignments   *onceLoader[map[string]int]
+	teamStats     *onceLoader[map[string]*models.TeamStats]
+	teamStatsList *onceLoader[[]*models.TeamStats]
+}
+
+func newLoaders(teamService services.TeamService, slaService services.ReviewSLAService, statisticService services.StatisticService) *loaders {
+	l := &loaders{}
+
+	l.reviews = newBatchLoader(slaService.GetOpenReviewsByReviewers)
+	l.members = newBatchLoader(func(ctx context.Context, teamNames []string) (map[string][]models.TeamMember, error) {
+		members, err := teamService.GetMembersByTeams(ctx, teamNames)
+		if err != nil {
+			return nil, err
+		}
+		// Ревью всех загруженных участников понадобятся вместе.
+		for _, teamMembers := range members {
+			for _, member := range teamMembers {
+				l.reviews.prime(member.UserID)
+			}
+		}
+		return members, nil
+	})
+
+	l.assignments = &onceLoader[map[string]int]{fetch: func(ctx context.Context) (map[string]int, error) {
+		stats, err := statisticService.GetAssignmentsByUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		counts := make(map[string]int, len(stats))
+		for _, stat := range stats {
+			counts[stat.UserID] = stat.AssignmentCount
+		}
+		return counts, nil
+	}}
+
+	l.teamStatsList = &onceLoader[[]*models.TeamStats]{fetch: statisticService.GetTeamStatistics}
+	l.teamStats = &onceLoader[map[string]*models.TeamStats]{fetch: func(ctx context.Context) (map[string]*models.TeamStats, error) {
+		stats, err := l.teamStatsList.load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		byTeam := make(map[string]*models.TeamStats, len(stats))
+		for _, stat := range stats {
+			byTeam[stat.TeamName] = stat
+		}
+		return byTeam, nil
+	}}
+
+	return l
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,348 @@
+package graphqlapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
+)
+
+type queryResolver struct {
+	teamService services.TeamService
+	userService services.UserService
+}
+
+func (q *queryResolver) Teams(ctx context.Context) ([]*teamResolver, error) {
+	teams, err := q.teamService.ListTeams(ctx)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+
+	l := loadersFrom(ctx)
+	resolvers := make([]*teamResolver, 0, len(teams))
+	for _, team := range teams {
+		l.members.prime(team.TeamName)
+		resolvers = append(resolvers, &teamResolver{team: team})
+	}
+	return resolvers, nil
+}
+
+func (q *queryResolver) Team(ctx context.Context, args struct{ Name string }) (*teamResolver, error) {
+	team, err := q.teamService.GetTeamWithMembers(ctx, args.Name)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &teamResolver{team: team, membersLoaded: true}, nil
+}
+
+func (q *queryResolver) User(ctx context.Context, args struct{ ID string }) (*userResolver, error) {
+	user, err := q.userService.GetUserWithTeam(ctx, args.ID)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	return &userResolver{user: user}, nil
+}
+
+func (q *queryResolver) TeamStatistics(ctx context.Context) ([]*teamStatsResolver, error) {
+	stats, err := loadersFrom(ctx).teamStatsList.load(ctx)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+
+	resolvers := make([]*teamStatsResolver, 0, len(stats))
+	for _, stat := range stats {
+		resolvers = append(resolvers, &teamStatsResolver{stats: stat})
+	}
+	return resolvers, nil
+}
+
+type teamResolver struct {
+	team *models.Team
+	// membersLoaded - участники уже загружены вместе с командой.
+	membersLoaded bool
+}
+
+func (r *teamResolver) Name() string {
+	return r.team.TeamName
+}
+
+func (r *teamResolver) ParentTeam() *string {
+	return optionalString(r.team.ParentTeam)
+}
+
+func (r *teamResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.team.CreatedAt}
+}
+
+func (r *teamResolver) ArchivedAt() *graphql.Time {
+	return optionalTime(r.team.ArchivedAt)
+}
+
+func (r *teamResolver) Members(ctx context.Context) ([]*memberResolver, error) {
+	l := loadersFrom(ctx)
+
+	members := r.team.Members
+	if r.membersLoaded {
+		for _, member := range members {
+			l.reviews.prime(member.UserID)
+		}
+	} else {
+		var err error
+		members, err = l.members.load(ctx, r.team.TeamName)
+		if err != nil {
+			return nil, resolverError(err)
+		}
+	}
+
+	resolvers := make([]*memberResolver, 0, len(members))
+	for _, member := range members {
+		resolvers = append(resolvers, &memberResolver{member: member})
+	}
+	return resolvers, nil
+}
+
+func (r *teamResolver) Stats(ctx context.Context) (*teamStatsResolver, error) {
+	stats, err := loadersFrom(ctx).teamStats.load(ctx)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+
+	stat, ok := stats[r.team.TeamName]
+	if !ok {
+		// Команда создана после того, как была посчитана статистика.
+		stat = &models.TeamStats{TeamName: r.team.TeamName, ParentTeam: r.team.ParentTeam}
+	}
+	return &teamStatsResolver{stats: stat}, nil
+}
+
+type memberResolver struct {
+	member models.TeamMember
+}
+
+func (r *memberResolver) UserID() string {
+	return r.member.UserID
+}
+
+func (r *memberResolver) Username() string {
+	return r.member.Username
+}
+
+func (r *memberResolver) IsActive() bool {
+	return r.member.IsActive
+}
+
+func (r *memberResolver) Role() *string {
+	return optionalString(r.member.Role)
+}
+
+func (r *memberResolver) Weight() int32 {
+	return int32(r.member.Weight)
+}
+
+func (r *memberResolver) OpenReviews(ctx context.Context) ([]*reviewResolver, error) {
+	return openReviews(ctx, r.member.UserID)
+}
+
+func (r *memberResolver) Stats() *memberStatsResolver {
+	return &memberStatsResolver{userID: r.member.UserID}
+}
+
+type userResolver struct {
+	user *models.User
+}
+
+func (r *userResolver) UserID() string {
+	return r.user.UserID
+}
+
+func (r *userResolver) Username() string {
+	return r.user.Username
+}
+
+func (r *userResolver) TeamName() *string {
+	return optionalString(r.user.TeamName)
+}
+
+func (r *userResolver) IsActive() bool {
+	return r.user.IsActive
+}
+
+func (r *userResolver) Skills() []string {
+	if r.user.Skills == nil {
+		return []string{}
+	}
+	return r.user.Skills
+}
+
+func (r *userResolver) Level() *string {
+	return optionalString(r.user.Level)
+}
+
+func (r *userResolver) OpenReviews(ctx context.Context) ([]*reviewResolver, error) {
+	return openReviews(ctx, r.user.UserID)
+}
+
+func (r *userResolver) Stats() *memberStatsResolver {
+	return &memberStatsResolver{userID: r.user.UserID}
+}
+
+func openReviews(ctx context.Context, userID string) ([]*reviewResolver, error) {
+	reviews, err := loadersFrom(ctx).reviews.load(ctx, userID)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+
+	resolvers := make([]*reviewResolver, 0, len(reviews))
+	for i := range reviews {
+		resolvers = append(resolvers, &reviewResolver{review: &reviews[i]})
+	}
+	return resolvers, nil
+}
+
+type memberStatsResolver struct {
+	userID string
+}
+
+func (r *memberStatsResolver) OpenReviewCount(ctx context.Context) (int32, error) {
+	reviews, err := loadersFrom(ctx).reviews.load(ctx, r.userID)
+	if err != nil {
+		return 0, resolverError(err)
+	}
+	return int32(len(reviews)), nil
+}
+
+func (r *memberStatsResolver) OverdueReviewCount(ctx context.Context) (int32, error) {
+	reviews, err := loadersFrom(ctx).reviews.load(ctx, r.userID)
+	if err != nil {
+		return 0, resolverError(err)
+	}
+
+	var count int32
+	for _, review := range reviews {
+		if review.SLA.Overdue {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memberStatsResolver) AssignmentCount(ctx context.Context) (int32, error) {
+	counts, err := loadersFrom(ctx).assignments.load(ctx)
+	if err != nil {
+		return 0, resolverError(err)
+	}
+	return int32(counts[r.userID]), nil
+}
+
+type reviewResolver struct {
+	review *models.ReviewAssignment
+}
+
+func (r *reviewResolver) Repository() string {
+	return r.review.Repository
+}
+
+func (r *reviewResolver) PullRequestID() string {
+	return r.review.PullRequestID
+}
+
+func (r *reviewResolver) PullRequestName() string {
+	return r.review.PullRequestName
+}
+
+func (r *reviewResolver) AuthorID() string {
+	return r.review.AuthorID
+}
+
+func (r *reviewResolver) Status() string {
+	return r.review.Status
+}
+
+func (r *reviewResolver) AssignedAt() graphql.Time {
+	return graphql.Time{Time: r.review.AssignedAt}
+}
+
+func (r *reviewResolver) RespondedAt() *graphql.Time {
+	return optionalTime(r.review.RespondedAt)
+}
+
+func (r *reviewResolver) Verdict() *string {
+	return optionalString(r.review.Verdict)
+}
+
+func (r *reviewResolver) FirstResponseDueAt() *graphql.Time {
+	return optionalTime(r.review.SLA.FirstResponseDueAt)
+}
+
+func (r *reviewResolver) CompletionDueAt() *graphql.Time {
+	return optionalTime(r.review.SLA.CompletionDueAt)
+}
+
+func (r *reviewResolver) Overdue() bool {
+	return r.review.SLA.Overdue
+}
+
+type teamStatsResolver struct {
+	stats *models.TeamStats
+}
+
+func (r *teamStatsResolver) TeamName() string {
+	return r.stats.TeamName
+}
+
+func (r *teamStatsResolver) ParentTeam() *string {
+	return optionalString(r.stats.ParentTeam)
+}
+
+func (r *teamStatsResolver) MemberCount() int32 {
+	return int32(r.stats.MemberCount)
+}
+
+func (r *teamStatsResolver) ActiveMemberCount() int32 {
+	return int32(r.stats.ActiveMemberCount)
+}
+
+func (r *teamStatsResolver) PRCount() int32 {
+	return int32(r.stats.PRCount)
+}
+
+func (r *teamStatsResolver) Subtree() *teamSubtreeStatsResolver {
+	return &teamSubtreeStatsResolver{stats: r.stats.Subtree}
+}
+
+type teamSubtreeStatsResolver struct {
+	stats models.TeamSubtreeStats
+}
+
+func (r *teamSubtreeStatsResolver) TeamCount() int32 {
+	return int32(r.stats.TeamCount)
+}
+
+func (r *teamSubtreeStatsResolver) MemberCount() int32 {
+	return int32(r.stats.MemberCount)
+}
+
+func (r *teamSubtreeStatsResolver) ActiveMemberCount() int32 {
+	return int32(r.stats.ActiveMemberCount)
+}
+
+func (r *teamSubtreeStatsResolver) PRCount() int32 {
+	return int32(r.stats.PRCount)
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
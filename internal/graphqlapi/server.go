@@ -0,0 +1,52 @@
+// Package graphqlapi реализует GraphQL API (schema.graphql) для дашбордов поверх тех же сервисов,
+// что и REST API. Вложенные поля загружаются загрузчиками запроса (loader.go) пачками.
+package graphqlapi
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"pr-reviewer-assignment-service/internal/services"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// maxQueryDepth ограничивает вложенность запросов.
+const maxQueryDepth = 10
+
+// Handler выполняет GraphQL запросы POST {"query", "operationName", "variables"}.
+type Handler struct {
+	relay            *relay.Handler
+	teamService      services.TeamService
+	slaService       services.ReviewSLAService
+	statisticService services.StatisticService
+}
+
+func NewHandler(
+	teamService services.TeamService,
+	userService services.UserService,
+	slaService services.ReviewSLAService,
+	statisticService services.StatisticService,
+) *Handler {
+	schema := graphql.MustParseSchema(schemaSDL,
+		&queryResolver{teamService: teamService, userService: userService},
+		graphql.MaxDepth(maxQueryDepth),
+	)
+
+	return &Handler{
+		relay:            &relay.Handler{Schema: schema},
+		teamService:      teamService,
+		slaService:       slaService,
+		statisticService: statisticService,
+	}
+}
+
+// ServeHTTP создаёт загрузчики на время запроса: данные кешируются только в его пределах.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l := newLoaders(h.teamService, h.slaService, h.statisticService)
+	h.relay.ServeHTTP(w, r.WithContext(withLoaders(r.Context(), l)))
+}
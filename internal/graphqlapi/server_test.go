@@ -0,0 +1,306 @@
+package graphqlapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
+)
+
+// calls считает вызовы сервисов: число вызовов равно числу пачек SQL запросов.
+type calls struct {
+	mu     sync.Mutex
+	counts map[string]int
+	keys   map[string][]string
+}
+
+func (c *calls) record(method string, keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[method]++
+	c.keys[method] = append(c.keys[method], keys...)
+}
+
+func (c *calls) count(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counts[method]
+}
+
+func (c *calls) sortedKeys(method string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	keys := append([]string(nil), c.keys[method]...)
+	sort.Strings(keys)
+	return keys
+}
+
+type fakeTeamService struct {
+	services.TeamService
+	calls   *calls
+	teams   []*models.Team
+	members map[string][]models.TeamMember
+}
+
+func (f *fakeTeamService) ListTeams(_ context.Context) ([]*models.Team, error) {
+	f.calls.record("ListTeams")
+	return f.teams, nil
+}
+
+func (f *fakeTeamService) GetMembersByTeams(_ context.Context, teamNames []string) (map[string][]models.TeamMember, error) {
+	f.calls.record("GetMembersByTeams", teamNames...)
+	members := make(map[string][]models.TeamMember)
+	for _, name := range teamNames {
+		if m, ok := f.members[name]; ok {
+			members[name] = m
+		}
+	}
+	return members, nil
+}
+
+func (f *fakeTeamService) GetTeamWithMembers(_ context.Context, teamName string) (*models.Team, error) {
+	f.calls.record("GetTeamWithMembers", teamName)
+	for _, team := range f.teams {
+		if team.TeamName == teamName {
+			full := *team
+			full.Members = f.members[teamName]
+			return &full, nil
+		}
+	}
+	return nil, services.ErrTeamNotFound
+}
+
+type fakeUserService struct {
+	services.UserService
+}
+
+func (f *fakeUserService) GetUserWithTeam(_ context.Context, userID string) (*models.User, error) {
+	if userID != "u1" {
+		return nil, services.ErrUserNotFound
+	}
+	return &models.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}, nil
+}
+
+type fakeSLAService struct {
+	services.ReviewSLAService
+	calls   *calls
+	reviews map[string][]models.ReviewAssignment
+	err     error
+}
+
+func (f *fakeSLAService) GetOpenReviewsByReviewers(_ context.Context, userIDs []string) (map[string][]models.ReviewAssignment, error) {
+	f.calls.record("GetOpenReviewsByReviewers", userIDs...)
+	if f.err != nil {
+		return nil, f.err
+	}
+	reviews := make(map[string][]models.ReviewAssignment)
+	for _, id := range userIDs {
+		if r, ok := f.reviews[id]; ok {
+			reviews[id] = r
+		}
+	}
+	return reviews, nil
+}
+
+type fakeStatisticService struct {
+	services.StatisticService
+	calls *calls
+}
+
+func (f *fakeStatisticService) GetAssignmentsByUsers(_ context.Context) ([]*models.UserAssignmentStats, error) {
+	f.calls.record("GetAssignmentsByUsers")
+	return []*models.UserAssignmentStats{{UserID: "u1", AssignmentCount: 7}, {UserID: "u2", AssignmentCount: 2}}, nil
+}
+
+func (f *fakeStatisticService) GetTeamStatistics(_ context.Context) ([]*models.TeamStats, error) {
+	f.calls.record("GetTeamStatistics")
+	return []*models.TeamStats{
+		{TeamName: "backend", MemberCount: 2, PRCount: 4, Subtree: models.TeamSubtreeStats{TeamCount: 2, PRCount: 6}},
+		{TeamName: "frontend", MemberCount: 2, PRCount: 1},
+		{TeamName: "payments", ParentTeam: "backend", MemberCount: 1, PRCount: 2},
+	}, nil
+}
+
+type testEnv struct {
+	handler *Handler
+	calls   *calls
+	sla     *fakeSLAService
+}
+
+func setupHandler() *testEnv {
+	c := &calls{counts: make(map[string]int), keys: make(map[string][]string)}
+	overdue := time.Now().Add(-time.Hour)
+	teamSvc := &fakeTeamService{
+		calls: c,
+		teams: []*models.Team{
+			{TeamName: "backend"},
+			{TeamName: "frontend"},
+			{TeamName: "payments", ParentTeam: "backend"},
+		},
+		members: map[string][]models.TeamMember{
+			"backend":  {{UserID: "u1", Username: "Alice", IsActive: true, Role: "lead"}, {UserID: "u2", Username: "Bob", IsActive: true}},
+			"frontend": {{UserID: "u3", Username: "Carol", IsActive: true}, {UserID: "u4", Username: "Dave"}},
+			"payments": {{UserID: "u1", Username: "Alice", IsActive: true}},
+		},
+	}
+	slaSvc := &fakeSLAService{calls: c, reviews: map[string][]models.ReviewAssignment{
+		"u1": {
+			{PullRequestID: "pr-1", ReviewerID: "u1", Status: models.PRStatusOpen, SLA: models.SLAState{CompletionDueAt: &overdue, Overdue: true}},
+			{PullRequestID: "pr-2", ReviewerID: "u1", Status: models.PRStatusOpen},
+		},
+		"u3": {{PullRequestID: "pr-3", ReviewerID: "u3", Status: models.PRStatusOpen}},
+	}}
+
+	return &testEnv{
+		handler: NewHandler(teamSvc, &fakeUserService{}, slaSvc, &fakeStatisticService{calls: c}),
+		calls:   c,
+		sla:     slaSvc,
+	}
+}
+
+type gqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func (e *testEnv) query(t *testing.T, query string) gqlResponse {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]interface{}{"query": query})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	e.handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/graphql", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp gqlResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestHandler_TeamsBatchesNestedQueries(t *testing.T) {
+	env := setupHandler()
+
+	resp := env.query(t, `{
+		teams {
+			name
+			stats { prCount subtree { prCount } }
+			members {
+				userId
+				openReviews { pullRequestId overdue }
+				stats { openReviewCount overdueReviewCount assignmentCount }
+			}
+		}
+	}`)
+	require.Empty(t, resp.Errors)
+
+	var data struct {
+		Teams []struct {
+			Name  string
+			Stats struct {
+				PrCount int
+				Subtree struct{ PrCount int }
+			}
+			Members []struct {
+				UserID      string `json:"userId"`
+				OpenReviews []struct {
+					PullRequestID string `json:"pullRequestId"`
+					Overdue       bool
+				} `json:"openReviews"`
+				Stats struct {
+					OpenReviewCount    int
+					OverdueReviewCount int
+					AssignmentCount    int
+				}
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	require.Len(t, data.Teams, 3)
+
+	backend := data.Teams[0]
+	assert.Equal(t, "backend", backend.Name)
+	assert.Equal(t, 4, backend.Stats.PrCount)
+	assert.Equal(t, 6, backend.Stats.Subtree.PrCount)
+	require.Len(t, backend.Members, 2)
+	assert.Equal(t, "u1", backend.Members[0].UserID)
+	require.Len(t, backend.Members[0].OpenReviews, 2)
+	assert.True(t, backend.Members[0].OpenReviews[0].Overdue)
+	assert.Equal(t, 2, backend.Members[0].Stats.OpenReviewCount)
+	assert.Equal(t, 1, backend.Members[0].Stats.OverdueReviewCount)
+	assert.Equal(t, 7, backend.Members[0].Stats.AssignmentCount)
+	assert.Empty(t, backend.Members[1].OpenReviews)
+
+	frontend := data.Teams[1]
+	require.Len(t, frontend.Members, 2)
+	assert.Equal(t, 1, frontend.Members[0].Stats.OpenReviewCount)
+	assert.Equal(t, 0, frontend.Members[1].Stats.AssignmentCount)
+
+	// Каждый уровень вложенности загружается одним вызовом, сколько бы ни было команд и участников.
+	assert.Equal(t, 1, env.calls.count("ListTeams"))
+	assert.Equal(t, 1, env.calls.count("GetMembersByTeams"))
+	assert.Equal(t, []string{"backend", "frontend", "payments"}, env.calls.sortedKeys("GetMembersByTeams"))
+	assert.Equal(t, 1, env.calls.count("GetOpenReviewsByReviewers"))
+	assert.Equal(t, []string{"u1", "u2", "u3", "u4"}, env.calls.sortedKeys("GetOpenReviewsByReviewers"))
+	assert.Equal(t, 1, env.calls.count("GetAssignmentsByUsers"))
+	assert.Equal(t, 1, env.calls.count("GetTeamStatistics"))
+}
+
+func TestHandler_Team(t *testing.T) {
+	env := setupHandler()
+
+	resp := env.query(t, `{ team(name: "payments") { name parentTeam members { username role openReviews { pullRequestId } } } }`)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"team": {"name": "payments", "parentTeam": "backend", "members": [
+		{"username": "Alice", "role": null, "openReviews": [{"pullRequestId": "pr-1"}, {"pullRequestId": "pr-2"}]}
+	]}}`, string(resp.Data))
+
+	assert.Equal(t, 0, env.calls.count("GetMembersByTeams"))
+	assert.Equal(t, 1, env.calls.count("GetOpenReviewsByReviewers"))
+}
+
+func TestHandler_User(t *testing.T) {
+	env := setupHandler()
+
+	resp := env.query(t, `{ user(id: "u1") { username teamName skills stats { openReviewCount assignmentCount } } }`)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"user": {"username": "Alice", "teamName": "backend", "skills": [],
+		"stats": {"openReviewCount": 2, "assignmentCount": 7}}}`, string(resp.Data))
+}
+
+func TestHandler_Errors(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		env := setupHandler()
+
+		resp := env.query(t, `{ team(name: "missing") { name } }`)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, models.ErrorCodeNotFound, resp.Errors[0].Extensions["code"])
+		assert.JSONEq(t, `{"team": null}`, string(resp.Data))
+	})
+
+	t.Run("internal error hides details", func(t *testing.T) {
+		env := setupHandler()
+		env.sla.err = errors.New("connection refused")
+
+		resp := env.query(t, `{ teams { members { openReviews { pullRequestId } } } }`)
+		require.NotEmpty(t, resp.Errors)
+		assert.Equal(t, models.ErrorCodeInternal, resp.Errors[0].Extensions["code"])
+		assert.Equal(t, "Internal server error", resp.Errors[0].Message)
+		assert.Equal(t, 1, env.calls.count("GetOpenReviewsByReviewers"))
+	})
+}
@@ -0,0 +1,55 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"pr-reviewer-assignment-service/internal/grpcapi/reviewerv1"
+)
+
+// Типы клиентов, как в AuthMiddleware.
+const (
+	userTypeAdmin = "admin"
+	userTypeUser  = "user"
+)
+
+// adminOnlyMethods - методы, которые в REST API закрыты AdminOnlyMiddleware.
+var adminOnlyMethods = map[string]bool{
+	reviewerv1.UserService_SetUserActive_FullMethodName: true,
+}
+
+// AuthInterceptor проверяет токен из метаданных authorization так же, как AuthMiddleware
+// проверяет заголовок Authorization, и требует admin токен для методов из adminOnlyMethods.
+func AuthInterceptor(adminToken, userToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		values := metadata.ValueFromIncomingContext(ctx, "authorization")
+		if len(values) == 0 {
+			return nil, newStatusError(codes.Unauthenticated, "UNAUTHORIZED", "Authorization header required")
+		}
+
+		parts := strings.Split(values[0], " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			return nil, newStatusError(codes.Unauthenticated, "INVALID_TOKEN_FORMAT", "Invalid token format")
+		}
+
+		var userType string
+		switch parts[1] {
+		case adminToken:
+			userType = userTypeAdmin
+		case userToken:
+			userType = userTypeUser
+		default:
+			return nil, newStatusError(codes.Unauthenticated, "INVALID_TOKEN", "Invalid token")
+		}
+
+		if adminOnlyMethods[info.FullMethod] && userType != userTypeAdmin {
+			return nil, newStatusError(codes.PermissionDenied, "ADMIN_REQUIRED", "Admin access required")
+		}
+
+		return handler(ctx, req)
+	}
+}
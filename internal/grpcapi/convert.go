@@ -0,0 +1,155 @@
+package grpcapi
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pr-reviewer-assignment-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+// timestamp возвращает nil для отсутствующего времени.
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// timeOrNil возвращает nil для незаданного Timestamp.
+func timeOrNil(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
+
+func toTeamMembers(members []*reviewerv1.TeamMember) []models.TeamMember {
+	result := make([]models.TeamMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, models.TeamMember{
+			UserID:   m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+			Role:     m.GetRole(),
+			Weight:   int(m.GetWeight()),
+		})
+	}
+	return result
+}
+
+func teamToProto(team *models.Team) *reviewerv1.Team {
+	members := make([]*reviewerv1.TeamMember, 0, len(team.Members))
+	for _, m := range team.Members {
+		members = append(members, &reviewerv1.TeamMember{
+			UserId:   m.UserID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+			Role:     m.Role,
+			Weight:   int32(m.Weight),
+		})
+	}
+
+	return &reviewerv1.Team{
+		TeamName:        team.TeamName,
+		Members:         members,
+		ParentTeam:      team.ParentTeam,
+		Policies:        team.Policies,
+		RotationPenalty: int32(team.RotationPenalty),
+		Sla: &reviewerv1.ReviewSLA{
+			FirstResponseHours: optionalInt32(team.SLA.FirstResponseHours),
+			CompletionHours:    optionalInt32(team.SLA.CompletionHours),
+		},
+		CreatedAt:  timestamppb.New(team.CreatedAt),
+		ArchivedAt: timestamp(team.ArchivedAt),
+	}
+}
+
+func userToProto(user *models.User) *reviewerv1.User {
+	return &reviewerv1.User{
+		UserId:   user.UserID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+		Skills:   user.Skills,
+		Level:    user.Level,
+	}
+}
+
+func slaStateToProto(sla *models.SLAState) *reviewerv1.SLAState {
+	if sla == nil {
+		return nil
+	}
+	return &reviewerv1.SLAState{
+		FirstResponseDueAt: timestamp(sla.FirstResponseDueAt),
+		CompletionDueAt:    timestamp(sla.CompletionDueAt),
+		Overdue:            sla.Overdue,
+	}
+}
+
+func pullRequestToProto(pr *models.PullRequest) *reviewerv1.PullRequest {
+	return &reviewerv1.PullRequest{
+		Repository:        pr.Repository,
+		PullRequestId:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorID,
+		Status:            pr.Status,
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         timestamp(pr.CreatedAt),
+		MergedAt:          timestamp(pr.MergedAt),
+		RequiredSkills:    pr.RequiredSkills,
+		SkillMatch:        pr.SkillMatch,
+		ChangedFiles:      pr.ChangedFiles,
+		Url:               pr.URL,
+		Description:       pr.Description,
+		Labels:            pr.Labels,
+		LinesAdded:        int32(pr.LinesAdded),
+		LinesRemoved:      int32(pr.LinesRemoved),
+		FilesChanged:      int32(pr.FilesChanged),
+		Priority:          pr.Priority,
+		ExcludedReviewers: pr.ExcludedReviewers,
+		Draft:             pr.Draft,
+		Sla:               slaStateToProto(pr.SLA),
+	}
+}
+
+func pullRequestShortToProto(pr *models.PullRequestShort) *reviewerv1.PullRequestShort {
+	return &reviewerv1.PullRequestShort{
+		Repository:      pr.Repository,
+		PullRequestId:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorID,
+		Status:          pr.Status,
+		CreatedAt:       timestamp(pr.CreatedAt),
+		AssignedAt:      timestamp(pr.AssignedAt),
+		RespondedAt:     timestamp(pr.RespondedAt),
+		Verdict:         pr.Verdict,
+		Sla:             slaStateToProto(pr.SLA),
+	}
+}
+
+func reviewAssignmentToProto(a *models.ReviewAssignment) *reviewerv1.ReviewAssignment {
+	return &reviewerv1.ReviewAssignment{
+		Repository:      a.Repository,
+		PullRequestId:   a.PullRequestID,
+		PullRequestName: a.PullRequestName,
+		AuthorId:        a.AuthorID,
+		ReviewerId:      a.ReviewerID,
+		TeamName:        a.TeamName,
+		Status:          a.Status,
+		AssignedAt:      timestamppb.New(a.AssignedAt),
+		RespondedAt:     timestamp(a.RespondedAt),
+		Verdict:         a.Verdict,
+		Sla:             slaStateToProto(&a.SLA),
+	}
+}
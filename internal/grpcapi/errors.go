@@ -0,0 +1,80 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
+)
+
+// errorDomain - домен ErrorInfo в ошибках gRPC API.
+const errorDomain = "pr-reviewer-assignment-service"
+
+type errorMapping struct {
+	err       error
+	code      codes.Code
+	errorCode string
+}
+
+// errorMappings сопоставляет доменные ошибки сервисов с кодами gRPC; errorCode - тот же код,
+// что REST API отдаёт в ErrorResponse (handlers/errors.go).
+var errorMappings = []errorMapping{
+	{services.ErrTeamExists, codes.AlreadyExists, models.ErrorCodeTeamExists},
+	{services.ErrTeamNotFound, codes.NotFound, models.ErrorCodeNotFound},
+	{services.ErrUserNotFound, codes.NotFound, models.ErrorCodeNotFound},
+	{services.ErrPRNotFound, codes.NotFound, models.ErrorCodeNotFound},
+	{services.ErrRepoNotFound, codes.NotFound, models.ErrorCodeNotFound},
+	{services.ErrPRExists, codes.AlreadyExists, models.ErrorCodePRExists},
+	{services.ErrPRMerged, codes.FailedPrecondition, models.ErrorCodePRMerged},
+	{services.ErrNotAssigned, codes.FailedPrecondition, models.ErrorCodeNotAssigned},
+	{services.ErrNoCandidate, codes.FailedPrecondition, models.ErrorCodeNoCandidate},
+	{services.ErrPolicyViolation, codes.FailedPrecondition, models.ErrorCodePolicyViolation},
+	{services.ErrUserWithoutTeam, codes.FailedPrecondition, models.ErrorCodeUnprocessable},
+	{services.ErrInvalidArgument, codes.InvalidArgument, models.ErrorCodeInvalidRequest},
+}
+
+// newStatusError возвращает ошибку gRPC с кодом ошибки REST API в ErrorInfo.
+func newStatusError(code codes.Code, errorCode, message string) error {
+	st, err := status.New(code, message).WithDetails(&errdetails.ErrorInfo{Reason: errorCode, Domain: errorDomain})
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+func invalidArgument(message string) error {
+	return newStatusError(codes.InvalidArgument, models.ErrorCodeInvalidRequest, message)
+}
+
+// ErrorInterceptor переводит доменные ошибки сервисов в статусы gRPC. Неизвестные ошибки
+// логируются и возвращаются как Internal без деталей.
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		for _, m := range errorMappings {
+			if errors.Is(err, m.err) {
+				return nil, newStatusError(m.code, m.errorCode, err.Error())
+			}
+		}
+
+		log.Printf("Internal error on %s: %v", info.FullMethod, err)
+		return nil, newStatusError(codes.Internal, models.ErrorCodeInternal, "Internal server error")
+	}
+}
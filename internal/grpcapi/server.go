@@ -0,0 +1,413 @@
+// Package grpcapi реализует gRPC API (api/proto/reviewer/v1) поверх тех же сервисов, что и REST API.
+package grpcapi
+
+//go:generate protoc -I ../../api/proto --go_out=../.. --go_opt=module=pr-reviewer-assignment-service --go-grpc_out=../.. --go-grpc_opt=module=pr-reviewer-assignment-service reviewer/v1/reviewer.proto
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"pr-reviewer-assignment-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
+)
+
+// NewServer создаёт gRPC сервер со всеми сервисами API. Ошибки переводятся в статусы gRPC
+// ErrorInterceptor, токены проверяет AuthInterceptor.
+func NewServer(
+	adminToken, userToken string,
+	teamService services.TeamService,
+	userService services.UserService,
+	prService services.PullRequestService,
+	slaService services.ReviewSLAService,
+	statisticService services.StatisticService,
+	opts ...grpc.ServerOption,
+) *grpc.Server {
+	opts = append(opts, grpc.ChainUnaryInterceptor(
+		ErrorInterceptor(),
+		AuthInterceptor(adminToken, userToken),
+	))
+	server := grpc.NewServer(opts...)
+
+	reviewerv1.RegisterTeamServiceServer(server, &teamServer{teamService: teamService})
+	reviewerv1.RegisterUserServiceServer(server, &userServer{userService: userService})
+	reviewerv1.RegisterPullRequestServiceServer(server, &pullRequestServer{prService: prService})
+	reviewerv1.RegisterReviewServiceServer(server, &reviewServer{prService: prService, slaService: slaService})
+	reviewerv1.RegisterStatisticsServiceServer(server, &statisticsServer{statisticService: statisticService})
+
+	return server
+}
+
+type teamServer struct {
+	reviewerv1.UnimplementedTeamServiceServer
+	teamService services.TeamService
+}
+
+func (s *teamServer) CreateTeam(ctx context.Context, req *reviewerv1.CreateTeamRequest) (*reviewerv1.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+	for _, m := range req.GetMembers() {
+		if m.GetUserId() == "" {
+			return nil, invalidArgument("members.user_id is required")
+		}
+		if m.GetWeight() < 0 {
+			return nil, invalidArgument("members.weight must be at least 1")
+		}
+	}
+
+	team, err := s.teamService.CreateTeamWithMembers(ctx, req.GetTeamName(), toTeamMembers(req.GetMembers()))
+	if err != nil {
+		return nil, err
+	}
+	return teamToProto(team), nil
+}
+
+func (s *teamServer) GetTeam(ctx context.Context, req *reviewerv1.GetTeamRequest) (*reviewerv1.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name parameter is required")
+	}
+
+	team, err := s.teamService.GetTeamWithMembers(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return teamToProto(team), nil
+}
+
+type userServer struct {
+	reviewerv1.UnimplementedUserServiceServer
+	userService services.UserService
+}
+
+func (s *userServer) GetUser(ctx context.Context, req *reviewerv1.GetUserRequest) (*reviewerv1.User, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id parameter is required")
+	}
+
+	user, err := s.userService.GetUserWithTeam(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return userToProto(user), nil
+}
+
+func (s *userServer) SetUserActive(ctx context.Context, req *reviewerv1.SetUserActiveRequest) (*reviewerv1.User, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	if err := s.userService.SetUserActiveStatus(ctx, req.GetUserId(), req.GetIsActive()); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.GetUserWithTeam(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return userToProto(user), nil
+}
+
+type pullRequestServer struct {
+	reviewerv1.UnimplementedPullRequestServiceServer
+	prService services.PullRequestService
+}
+
+func (s *pullRequestServer) CreatePullRequest(ctx context.Context, req *reviewerv1.CreatePullRequestRequest) (*reviewerv1.PullRequest, error) {
+	switch {
+	case req.GetPullRequestId() == "":
+		return nil, invalidArgument("pull_request_id is required")
+	case req.GetPullRequestName() == "":
+		return nil, invalidArgument("pull_request_name is required")
+	case req.GetAuthorId() == "":
+		return nil, invalidArgument("author_id is required")
+	}
+
+	pr, err := s.prService.CreatePullRequest(ctx, &models.PullRequest{
+		PullRequestID:     req.GetPullRequestId(),
+		PullRequestName:   req.GetPullRequestName(),
+		AuthorID:          req.GetAuthorId(),
+		Status:            models.PRStatusOpen,
+		RequiredSkills:    req.GetRequiredSkills(),
+		SkillMatch:        req.GetSkillMatch(),
+		Repository:        req.GetRepository(),
+		ChangedFiles:      req.GetChangedFiles(),
+		URL:               req.GetUrl(),
+		Description:       req.GetDescription(),
+		Labels:            req.GetLabels(),
+		LinesAdded:        int(req.GetLinesAdded()),
+		LinesRemoved:      int(req.GetLinesRemoved()),
+		FilesChanged:      int(req.GetFilesChanged()),
+		Priority:          req.GetPriority(),
+		ExcludedReviewers: req.GetExcludedReviewers(),
+		Draft:             req.GetDraft(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pullRequestToProto(pr), nil
+}
+
+func (s *pullRequestServer) GetPullRequest(ctx context.Context, req *reviewerv1.PullRequestRef) (*reviewerv1.PullRequest, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id parameter is required")
+	}
+
+	pr, err := s.prService.GetPullRequest(ctx, req.GetRepository(), req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return pullRequestToProto(pr), nil
+}
+
+func (s *pullRequestServer) ListPullRequests(ctx context.Context, req *reviewerv1.ListPullRequestsRequest) (*reviewerv1.ListPullRequestsResponse, error) {
+	filter := models.PullRequestFilter{
+		Repository:  req.GetRepository(),
+		Status:      req.GetStatus(),
+		AuthorID:    req.GetAuthorId(),
+		TeamName:    req.GetTeamName(),
+		ReviewerID:  req.GetReviewerId(),
+		Label:       req.GetLabel(),
+		Priority:    req.GetPriority(),
+		CreatedFrom: timeOrNil(req.GetCreatedFrom()),
+		CreatedTo:   timeOrNil(req.GetCreatedTo()),
+		MergedFrom:  timeOrNil(req.GetMergedFrom()),
+		MergedTo:    timeOrNil(req.GetMergedTo()),
+		SortBy:      req.GetSortBy(),
+		SortOrder:   req.GetOrder(),
+		Limit:       int(req.GetLimit()),
+	}
+
+	page, err := s.prService.ListPullRequests(ctx, filter, req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewerv1.ListPullRequestsResponse{
+		PullRequests: make([]*reviewerv1.PullRequest, 0, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
+	}
+	for _, pr := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, pullRequestToProto(pr))
+	}
+	return resp, nil
+}
+
+func (s *pullRequestServer) MergePullRequest(ctx context.Context, req *reviewerv1.PullRequestRef) (*reviewerv1.PullRequest, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id is required")
+	}
+
+	pr, err := s.prService.MergePullRequest(ctx, req.GetRepository(), req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return pullRequestToProto(pr), nil
+}
+
+func (s *pullRequestServer) ReassignReviewer(ctx context.Context, req *reviewerv1.ReassignReviewerRequest) (*reviewerv1.ReassignReviewerResponse, error) {
+	switch {
+	case req.GetPullRequestId() == "":
+		return nil, invalidArgument("pull_request_id is required")
+	case req.GetOldReviewerId() == "":
+		return nil, invalidArgument("old_reviewer_id is required")
+	}
+
+	pr, replacedBy, err := s.prService.ReassignReviewer(ctx, req.GetRepository(), req.GetPullRequestId(), req.GetOldReviewerId())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewerv1.ReassignReviewerResponse{Pr: pullRequestToProto(pr), ReplacedBy: replacedBy}, nil
+}
+
+type reviewServer struct {
+	reviewerv1.UnimplementedReviewServiceServer
+	prService  services.PullRequestService
+	slaService services.ReviewSLAService
+}
+
+func (s *reviewServer) ListUserReviews(ctx context.Context, req *reviewerv1.ListUserReviewsRequest) (*reviewerv1.ListUserReviewsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id parameter is required")
+	}
+
+	filter := models.ReviewFilter{Status: req.GetStatus(), Limit: int(req.GetLimit())}
+	page, err := s.prService.GetUserPullRequests(ctx, req.GetUserId(), filter, req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewerv1.ListUserReviewsResponse{
+		UserId:       page.UserID,
+		PullRequests: make([]*reviewerv1.PullRequestShort, 0, len(page.PullRequests)),
+		TotalCount:   int32(page.TotalCount),
+		NextCursor:   page.NextCursor,
+	}
+	for _, pr := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, pullRequestShortToProto(pr))
+	}
+	return resp, nil
+}
+
+func (s *reviewServer) SubmitReview(ctx context.Context, req *reviewerv1.SubmitReviewRequest) (*reviewerv1.ReviewAssignment, error) {
+	switch {
+	case req.GetPullRequestId() == "":
+		return nil, invalidArgument("pull_request_id is required")
+	case req.GetReviewerId() == "":
+		return nil, invalidArgument("reviewer_id is required")
+	}
+
+	assignment, err := s.prService.RecordReviewResponse(ctx, req.GetRepository(), req.GetPullRequestId(), req.GetReviewerId(), req.GetVerdict())
+	if err != nil {
+		return nil, err
+	}
+	return reviewAssignmentToProto(assignment), nil
+}
+
+func (s *reviewServer) ListOverdueReviews(ctx context.Context, req *reviewerv1.ListOverdueReviewsRequest) (*reviewerv1.ListOverdueReviewsResponse, error) {
+	var (
+		overdue *models.OverdueReviews
+		err     error
+	)
+	switch {
+	case req.GetTeamName() != "" && req.GetUserId() != "":
+		return nil, invalidArgument("only one of team_name and user_id can be set")
+	case req.GetTeamName() != "":
+		overdue, err = s.slaService.GetOverdueByTeam(ctx, req.GetTeamName())
+	case req.GetUserId() != "":
+		overdue, err = s.slaService.GetOverdueByReviewer(ctx, req.GetUserId())
+	default:
+		return nil, invalidArgument("team_name or user_id is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewerv1.ListOverdueReviewsResponse{
+		TeamName: overdue.TeamName,
+		UserId:   overdue.UserID,
+		Reviews:  make([]*reviewerv1.ReviewAssignment, 0, len(overdue.Reviews)),
+	}
+	for i := range overdue.Reviews {
+		resp.Reviews = append(resp.Reviews, reviewAssignmentToProto(&overdue.Reviews[i]))
+	}
+	return resp, nil
+}
+
+type statisticsServer struct {
+	reviewerv1.UnimplementedStatisticsServiceServer
+	statisticService services.StatisticService
+}
+
+func (s *statisticsServer) GetAssignmentsByUsers(ctx context.Context, _ *reviewerv1.GetAssignmentsByUsersRequest) (*reviewerv1.GetAssignmentsByUsersResponse, error) {
+	stats, err := s.statisticService.GetAssignmentsByUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewerv1.GetAssignmentsByUsersResponse{Users: make([]*reviewerv1.UserAssignmentStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Users = append(resp.Users, &reviewerv1.UserAssignmentStats{
+			UserId:          st.UserID,
+			Username:        st.Username,
+			AssignmentCount: int32(st.AssignmentCount),
+		})
+	}
+	return resp, nil
+}
+
+func (s *statisticsServer) GetPullRequestCountByStatus(ctx context.Context, _ *reviewerv1.GetPullRequestCountByStatusRequest) (*reviewerv1.GetPullRequestCountByStatusResponse, error) {
+	stats, err := s.statisticService.GetPRCountByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewerv1.GetPullRequestCountByStatusResponse{Statuses: make([]*reviewerv1.PullRequestStatusStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Statuses = append(resp.Statuses, &reviewerv1.PullRequestStatusStats{Status: st.Status, Count: int32(st.Count)})
+	}
+	return resp, nil
+}
+
+func (s *statisticsServer) GetTeamStatistics(ctx context.Context, _ *reviewerv1.GetTeamStatisticsRequest) (*reviewerv1.GetTeamStatisticsResponse, error) {
+	stats, err := s.statisticService.GetTeamStatistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewerv1.GetTeamStatisticsResponse{Teams: make([]*reviewerv1.TeamStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Teams = append(resp.Teams, &reviewerv1.TeamStats{
+			TeamName:          st.TeamName,
+			ParentTeam:        st.ParentTeam,
+			MemberCount:       int32(st.MemberCount),
+			ActiveMemberCount: int32(st.ActiveMemberCount),
+			PrCount:           int32(st.PRCount),
+			Subtree: &reviewerv1.TeamSubtreeStats{
+				TeamCount:         int32(st.Subtree.TeamCount),
+				MemberCount:       int32(st.Subtree.MemberCount),
+				ActiveMemberCount: int32(st.Subtree.ActiveMemberCount),
+				PrCount:           int32(st.Subtree.PRCount),
+			},
+		})
+	}
+	return resp, nil
+}
+
+func (s *statisticsServer) GetFairnessReport(ctx context.Context, req *reviewerv1.GetFairnessReportRequest) (*reviewerv1.FairnessReport, error) {
+	report, err := s.statisticService.GetFairnessReport(ctx, req.GetTeamName(), timeOrNil(req.GetSince()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewerv1.FairnessReport{
+		TeamName: report.TeamName,
+		Since:    timestamp(report.Since),
+		Authors:  make([]*reviewerv1.AuthorPairings, 0, len(report.Authors)),
+	}
+	for _, author := range report.Authors {
+		pairings := make([]*reviewerv1.PairingStats, 0, len(author.Pairings))
+		for _, p := range author.Pairings {
+			pairings = append(pairings, &reviewerv1.PairingStats{
+				AuthorId:     p.AuthorID,
+				ReviewerId:   p.ReviewerID,
+				Count:        int32(p.Count),
+				LastPairedAt: timestamp(&p.LastPairedAt),
+			})
+		}
+		resp.Authors = append(resp.Authors, &reviewerv1.AuthorPairings{
+			AuthorId:          author.AuthorID,
+			ReviewCount:       int32(author.ReviewCount),
+			DistinctReviewers: int32(author.DistinctReviewers),
+			TopReviewerShare:  author.TopReviewerShare,
+			Pairings:          pairings,
+		})
+	}
+	return resp, nil
+}
+
+func (s *statisticsServer) GetTeamLoad(ctx context.Context, req *reviewerv1.GetTeamLoadRequest) (*reviewerv1.TeamLoad, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name parameter is required")
+	}
+
+	load, err := s.statisticService.GetTeamLoad(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewerv1.TeamLoad{
+		TeamName: load.TeamName,
+		Members:  make([]*reviewerv1.MemberLoad, 0, len(load.Members)),
+	}
+	for _, m := range load.Members {
+		resp.Members = append(resp.Members, &reviewerv1.MemberLoad{
+			UserId:      m.UserID,
+			Username:    m.Username,
+			IsActive:    m.IsActive,
+			OpenReviews: int32(m.OpenReviews),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,333 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pr-reviewer-assignment-service/internal/grpcapi/reviewerv1"
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
+)
+
+const (
+	testAdminToken = "admin-token"
+	testUserToken  = "user-token"
+)
+
+// Фейковые сервисы: не переопределённые методы паникуют на nil интерфейсе.
+type fakeTeamService struct {
+	services.TeamService
+	teams map[string]*models.Team
+}
+
+func (f *fakeTeamService) CreateTeamWithMembers(_ context.Context, teamName string, members []models.TeamMember) (*models.Team, error) {
+	if _, ok := f.teams[teamName]; ok {
+		return nil, services.ErrTeamExists
+	}
+	team := &models.Team{TeamName: teamName, Members: members, CreatedAt: time.Now()}
+	f.teams[teamName] = team
+	return team, nil
+}
+
+func (f *fakeTeamService) GetTeamWithMembers(_ context.Context, teamName string) (*models.Team, error) {
+	team, ok := f.teams[teamName]
+	if !ok {
+		return nil, services.ErrTeamNotFound
+	}
+	return team, nil
+}
+
+type fakeUserService struct {
+	services.UserService
+	users map[string]*models.User
+}
+
+func (f *fakeUserService) SetUserActiveStatus(_ context.Context, userID string, isActive bool) error {
+	user, ok := f.users[userID]
+	if !ok {
+		return services.ErrUserNotFound
+	}
+	user.IsActive = isActive
+	return nil
+}
+
+func (f *fakeUserService) GetUserWithTeam(_ context.Context, userID string) (*models.User, error) {
+	user, ok := f.users[userID]
+	if !ok {
+		return nil, services.ErrUserNotFound
+	}
+	return user, nil
+}
+
+type fakePullRequestService struct {
+	services.PullRequestService
+	listFilter models.PullRequestFilter
+	listErr    error
+}
+
+func (f *fakePullRequestService) CreatePullRequest(_ context.Context, pr *models.PullRequest) (*models.PullRequest, error) {
+	created := *pr
+	now := time.Now()
+	created.CreatedAt = &now
+	created.AssignedReviewers = []string{"u2"}
+	return &created, nil
+}
+
+func (f *fakePullRequestService) ReassignReviewer(_ context.Context, _, _, _ string) (*models.PullRequest, string, error) {
+	return nil, "", services.ErrPRMerged
+}
+
+func (f *fakePullRequestService) ListPullRequests(_ context.Context, filter models.PullRequestFilter, _ string) (*models.PullRequestPage, error) {
+	f.listFilter = filter
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	return &models.PullRequestPage{
+		PullRequests: []*models.PullRequest{{PullRequestID: "pr-1", Status: models.PRStatusOpen}},
+		NextCursor:   "next",
+	}, nil
+}
+
+type fakeSLAService struct {
+	services.ReviewSLAService
+}
+
+func (f *fakeSLAService) GetOverdueByTeam(_ context.Context, teamName string) (*models.OverdueReviews, error) {
+	return &models.OverdueReviews{
+		TeamName: teamName,
+		Reviews:  []models.ReviewAssignment{{PullRequestID: "pr-1", ReviewerID: "u2", AssignedAt: time.Now()}},
+	}, nil
+}
+
+type fakeStatisticService struct {
+	services.StatisticService
+}
+
+func (f *fakeStatisticService) GetTeamLoad(_ context.Context, teamName string) (*models.TeamLoad, error) {
+	return &models.TeamLoad{
+		TeamName: teamName,
+		Members:  []models.MemberLoad{{UserID: "u1", Username: "Alice", IsActive: true, OpenReviews: 3}},
+	}, nil
+}
+
+type testClients struct {
+	teams    reviewerv1.TeamServiceClient
+	users    reviewerv1.UserServiceClient
+	prs      reviewerv1.PullRequestServiceClient
+	reviews  reviewerv1.ReviewServiceClient
+	stats    reviewerv1.StatisticsServiceClient
+	prFake   *fakePullRequestService
+	userFake *fakeUserService
+}
+
+func setupServer(t *testing.T) *testClients {
+	t.Helper()
+
+	teamFake := &fakeTeamService{teams: map[string]*models.Team{
+		"backend": {TeamName: "backend", Members: []models.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}},
+	}}
+	userFake := &fakeUserService{users: map[string]*models.User{
+		"u1": {UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+	}}
+	prFake := &fakePullRequestService{}
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer(testAdminToken, testUserToken, teamFake, userFake, prFake, &fakeSLAService{}, &fakeStatisticService{})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return &testClients{
+		teams:    reviewerv1.NewTeamServiceClient(conn),
+		users:    reviewerv1.NewUserServiceClient(conn),
+		prs:      reviewerv1.NewPullRequestServiceClient(conn),
+		reviews:  reviewerv1.NewReviewServiceClient(conn),
+		stats:    reviewerv1.NewStatisticsServiceClient(conn),
+		prFake:   prFake,
+		userFake: userFake,
+	}
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+// requireStatus проверяет код gRPC и код ошибки REST API в ErrorInfo.
+func requireStatus(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok, "expected gRPC status, got %v", err)
+	assert.Equal(t, code, st.Code())
+
+	var info *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = d
+		}
+	}
+	require.NotNil(t, info, "ErrorInfo detail is missing")
+	assert.Equal(t, reason, info.GetReason())
+	assert.Equal(t, errorDomain, info.GetDomain())
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	clients := setupServer(t)
+	req := &reviewerv1.GetTeamRequest{TeamName: "backend"}
+
+	t.Run("missing token", func(t *testing.T) {
+		_, err := clients.teams.GetTeam(context.Background(), req)
+		requireStatus(t, err, codes.Unauthenticated, "UNAUTHORIZED")
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", testUserToken)
+		_, err := clients.teams.GetTeam(ctx, req)
+		requireStatus(t, err, codes.Unauthenticated, "INVALID_TOKEN_FORMAT")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := clients.teams.GetTeam(withToken("unknown"), req)
+		requireStatus(t, err, codes.Unauthenticated, "INVALID_TOKEN")
+	})
+
+	t.Run("user token", func(t *testing.T) {
+		team, err := clients.teams.GetTeam(withToken(testUserToken), req)
+		require.NoError(t, err)
+		assert.Equal(t, "backend", team.GetTeamName())
+		require.Len(t, team.GetMembers(), 1)
+		assert.Equal(t, "u1", team.GetMembers()[0].GetUserId())
+	})
+
+	t.Run("admin only method with user token", func(t *testing.T) {
+		_, err := clients.users.SetUserActive(withToken(testUserToken), &reviewerv1.SetUserActiveRequest{UserId: "u1"})
+		requireStatus(t, err, codes.PermissionDenied, "ADMIN_REQUIRED")
+		assert.True(t, clients.userFake.users["u1"].IsActive)
+	})
+
+	t.Run("admin only method with admin token", func(t *testing.T) {
+		user, err := clients.users.SetUserActive(withToken(testAdminToken), &reviewerv1.SetUserActiveRequest{UserId: "u1"})
+		require.NoError(t, err)
+		assert.False(t, user.GetIsActive())
+	})
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	clients := setupServer(t)
+	ctx := withToken(testUserToken)
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := clients.teams.GetTeam(ctx, &reviewerv1.GetTeamRequest{TeamName: "missing"})
+		requireStatus(t, err, codes.NotFound, models.ErrorCodeNotFound)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		_, err := clients.teams.CreateTeam(ctx, &reviewerv1.CreateTeamRequest{TeamName: "backend"})
+		requireStatus(t, err, codes.AlreadyExists, models.ErrorCodeTeamExists)
+	})
+
+	t.Run("failed precondition", func(t *testing.T) {
+		_, err := clients.prs.ReassignReviewer(ctx, &reviewerv1.ReassignReviewerRequest{PullRequestId: "pr-1", OldReviewerId: "u2"})
+		requireStatus(t, err, codes.FailedPrecondition, models.ErrorCodePRMerged)
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+		_, err := clients.teams.GetTeam(ctx, &reviewerv1.GetTeamRequest{})
+		requireStatus(t, err, codes.InvalidArgument, models.ErrorCodeInvalidRequest)
+	})
+
+	t.Run("internal error hides details", func(t *testing.T) {
+		clients.prFake.listErr = errors.New("connection refused")
+		defer func() { clients.prFake.listErr = nil }()
+
+		_, err := clients.prs.ListPullRequests(ctx, &reviewerv1.ListPullRequestsRequest{})
+		requireStatus(t, err, codes.Internal, models.ErrorCodeInternal)
+		assert.Equal(t, "Internal server error", status.Convert(err).Message())
+	})
+}
+
+func TestPullRequestService(t *testing.T) {
+	clients := setupServer(t)
+	ctx := withToken(testUserToken)
+
+	pr, err := clients.prs.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorId:        "u1",
+		Labels:          []string{"feature"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.PRStatusOpen, pr.GetStatus())
+	assert.Equal(t, []string{"u2"}, pr.GetAssignedReviewers())
+	assert.Equal(t, []string{"feature"}, pr.GetLabels())
+	assert.NotNil(t, pr.GetCreatedAt())
+	assert.Nil(t, pr.GetMergedAt())
+
+	createdFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	page, err := clients.prs.ListPullRequests(ctx, &reviewerv1.ListPullRequestsRequest{
+		Status:      models.PRStatusOpen,
+		CreatedFrom: timestamppb.New(createdFrom),
+		Limit:       10,
+	})
+	require.NoError(t, err)
+	require.Len(t, page.GetPullRequests(), 1)
+	assert.Equal(t, "next", page.GetNextCursor())
+
+	filter := clients.prFake.listFilter
+	assert.Equal(t, models.PRStatusOpen, filter.Status)
+	assert.Equal(t, 10, filter.Limit)
+	require.NotNil(t, filter.CreatedFrom)
+	assert.True(t, createdFrom.Equal(*filter.CreatedFrom))
+	assert.Nil(t, filter.CreatedTo)
+}
+
+func TestReviewService_ListOverdueReviews(t *testing.T) {
+	clients := setupServer(t)
+	ctx := withToken(testUserToken)
+
+	resp, err := clients.reviews.ListOverdueReviews(ctx, &reviewerv1.ListOverdueReviewsRequest{TeamName: "backend"})
+	require.NoError(t, err)
+	assert.Equal(t, "backend", resp.GetTeamName())
+	require.Len(t, resp.GetReviews(), 1)
+	assert.Equal(t, "u2", resp.GetReviews()[0].GetReviewerId())
+
+	_, err = clients.reviews.ListOverdueReviews(ctx, &reviewerv1.ListOverdueReviewsRequest{TeamName: "backend", UserId: "u1"})
+	requireStatus(t, err, codes.InvalidArgument, models.ErrorCodeInvalidRequest)
+
+	_, err = clients.reviews.ListOverdueReviews(ctx, &reviewerv1.ListOverdueReviewsRequest{})
+	requireStatus(t, err, codes.InvalidArgument, models.ErrorCodeInvalidRequest)
+}
+
+func TestStatisticsService_GetTeamLoad(t *testing.T) {
+	clients := setupServer(t)
+
+	load, err := clients.stats.GetTeamLoad(withToken(testUserToken), &reviewerv1.GetTeamLoadRequest{TeamName: "backend"})
+	require.NoError(t, err)
+	assert.Equal(t, "backend", load.GetTeamName())
+	require.Len(t, load.GetMembers(), 1)
+	assert.Equal(t, int32(3), load.GetMembers()[0].GetOpenReviews())
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UploadCodeOwnersRequest struct {
+	Repository string `json:"repository" binding:"required"`
+	Content    string `json:"content" binding:"required"`
+}
+
+func (h *Handler) UploadCodeOwners(c *gin.Context) {
+	var req UploadCodeOwnersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	file, err := h.codeOwnersService.UploadCodeOwners(c.Request.Context(), req.Repository, req.Content)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, file)
+}
+
+func (h *Handler) GetCodeOwners(c *gin.Context) {
+	repo, version, ok := codeOwnersQuery(c)
+	if !ok {
+		return
+	}
+
+	file, err := h.codeOwnersService.GetCodeOwners(c.Request.Context(), repo, version)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, file)
+}
+
+func (h *Handler) ValidateCodeOwners(c *gin.Context) {
+	repo, version, ok := codeOwnersQuery(c)
+	if !ok {
+		return
+	}
+
+	validation, err := h.codeOwnersService.ValidateCodeOwners(c.Request.Context(), repo, version)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, validation)
+}
+
+// codeOwnersQuery читает параметры repository и version (по умолчанию - последняя версия).
+func codeOwnersQuery(c *gin.Context) (string, int, bool) {
+	repo := c.Query("repository")
+	if repo == "" {
+		respondBadRequest(c, "repository parameter is required")
+		return "", 0, false
+	}
+
+	version := 0
+	if value := c.Query("version"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			respondBadRequest(c, "version must be a positive integer")
+			return "", 0, false
+		}
+		version = parsed
+	}
+
+	return repo, version, true
+}
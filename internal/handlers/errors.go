@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type errorMapping struct {
+	err    error
+	status int
+	code   string
+}
+
+// errorMappings сопоставляет доменные ошибки сервисов со статусами и кодами из openapi.yml.
+var errorMappings = []errorMapping{
+	{services.ErrTeamExists, http.StatusBadRequest, models.ErrorCodeTeamExists},
+	{services.ErrTeamNotFound, http.StatusNotFound, models.ErrorCodeNotFound},
+	{services.ErrUserNotFound, http.StatusNotFound, models.ErrorCodeNotFound},
+	{services.ErrPRNotFound, http.StatusNotFound, models.ErrorCodeNotFound},
+	{services.ErrCodeOwnersNotFound, http.StatusNotFound, models.ErrorCodeNotFound},
+	{services.ErrRepoNotFound, http.StatusNotFound, models.ErrorCodeNotFound},
+	{services.ErrBlockedPairNotFound, http.StatusNotFound, models.ErrorCodeNotFound},
+	{services.ErrWebhookNotFound, http.StatusNotFound, models.ErrorCodeNotFound},
+	{services.ErrGitIdentityNotFound, http.StatusNotFound, models.ErrorCodeNotFound},
+	{services.ErrInvalidSignature, http.StatusUnauthorized, models.ErrorCodeInvalidSignature},
+	{services.ErrGitUserNotMapped, http.StatusUnprocessableEntity, models.ErrorCodeUnprocessable},
+	{services.ErrRepoExists, http.StatusBadRequest, models.ErrorCodeRepositoryExists},
+	{services.ErrPRExists, http.StatusConflict, models.ErrorCodePRExists},
+	{services.ErrPRMerged, http.StatusConflict, models.ErrorCodePRMerged},
+	{services.ErrNotAssigned, http.StatusConflict, models.ErrorCodeNotAssigned},
+	{services.ErrNoCandidate, http.StatusConflict, models.ErrorCodeNoCandidate},
+	{services.ErrPolicyViolation, http.StatusConflict, models.ErrorCodePolicyViolation},
+	{services.ErrUserWithoutTeam, http.StatusUnprocessableEntity, models.ErrorCodeUnprocessable},
+	{services.ErrInvalidArgument, http.StatusBadRequest, models.ErrorCodeInvalidRequest},
+	{services.ErrNotTeamMember, http.StatusConflict, models.ErrorCodeMembershipConflict},
+	{services.ErrTeamHasOpenPRs, http.StatusConflict, models.ErrorCodeTeamHasOpenPRs},
+}
+
+func errorResponse(code, message string) models.ErrorResponse {
+	return models.ErrorResponse{Error: models.ErrorDetail{Code: code, Message: message}}
+}
+
+func respondBadRequest(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, errorResponse(models.ErrorCodeInvalidRequest, message))
+}
+
+// respondError отвечает клиенту в формате ErrorResponse. Неизвестные ошибки
+// логируются и возвращаются как 500 без деталей.
+func respondError(c *gin.Context, err error) {
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			c.JSON(m.status, errorResponse(m.code, err.Error()))
+			return
+		}
+	}
+
+	log.Printf("Internal error on %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+	c.JSON(http.StatusInternalServerError, errorResponse(models.ErrorCodeInternal, "Internal server error"))
+}
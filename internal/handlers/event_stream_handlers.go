@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// eventStreamHeartbeat - как часто поток без событий отправляет комментарий, чтобы прокси
+// не закрывали простаивающее соединение, а обрыв клиента обнаруживался.
+const eventStreamHeartbeat = 15 * time.Second
+
+// StreamEvents отдаёт события назначений как Server-Sent Events. Клиент продолжает поток
+// после переподключения заголовком Last-Event-ID или параметром last_event_id.
+func (h *Handler) StreamEvents(c *gin.Context) {
+	lastEventID, err := parseLastEventID(c)
+	if err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	filter := models.EventStreamFilter{
+		TeamName: c.Query("team_name"),
+		UserID:   c.Query("user_id"),
+	}
+
+	opened := false
+	var lastWrite time.Time
+	err = h.eventStreamService.Stream(c.Request.Context(), filter, lastEventID, func(events []*models.Event) error {
+		if len(events) == 0 && opened && time.Since(lastWrite) < eventStreamHeartbeat {
+			return nil
+		}
+		if !opened {
+			c.Header("Content-Type", "text/event-stream")
+			c.Header("Cache-Control", "no-cache")
+			c.Header("Connection", "keep-alive")
+			c.Header("X-Accel-Buffering", "no")
+			c.Status(http.StatusOK)
+			opened = true
+		}
+
+		// Комментарий без событий отправляет заголовки сразу и служит пульсом потока.
+		if len(events) == 0 {
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return err
+			}
+		}
+		for _, event := range events {
+			data, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return err
+			}
+		}
+
+		c.Writer.Flush()
+		lastWrite = time.Now()
+		return nil
+	})
+	if err == nil {
+		return
+	}
+	if !opened {
+		respondError(c, err)
+		return
+	}
+	log.Printf("Event stream closed: %v", err)
+}
+
+func parseLastEventID(c *gin.Context) (*int64, error) {
+	raw := c.GetHeader("Last-Event-ID")
+	if raw == "" {
+		raw = c.Query("last_event_id")
+	}
+	if raw == "" {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 0 {
+		return nil, fmt.Errorf("last event id must be a non-negative integer")
+	}
+	return &id, nil
+}
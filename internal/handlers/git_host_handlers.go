@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"net/http"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MapGitUserRequest struct {
+	Provider string `json:"provider" binding:"required"`
+	Username string `json:"username" binding:"required"`
+	UserID   string `json:"user_id" binding:"required"`
+}
+
+type UnmapGitUserRequest struct {
+	Provider string `json:"provider" binding:"required"`
+	Username string `json:"username" binding:"required"`
+}
+
+// ReceiveGitHubWebhook принимает события pull_request GitHub. Запрос аутентифицируется
+// подписью X-Hub-Signature-256, а не токеном API.
+func (h *Handler) ReceiveGitHubWebhook(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.gitHostService.HandleGitHub(c.Request.Context(), c.GetHeader("X-GitHub-Event"), c.GetHeader("X-Hub-Signature-256"), body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReceiveGitLabWebhook принимает события Merge Request Hook GitLab. Запрос аутентифицируется
+// заголовком X-Gitlab-Token, а не токеном API.
+func (h *Handler) ReceiveGitLabWebhook(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	result, err := h.gitHostService.HandleGitLab(c.Request.Context(), c.GetHeader("X-Gitlab-Event"), c.GetHeader("X-Gitlab-Token"), body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *Handler) MapGitUser(c *gin.Context) {
+	var req MapGitUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	identity, err := h.gitHostService.MapUser(c.Request.Context(), &models.GitIdentity{
+		Provider: req.Provider,
+		Username: req.Username,
+		UserID:   req.UserID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, identity)
+}
+
+func (h *Handler) UnmapGitUser(c *gin.Context) {
+	var req UnmapGitUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.gitHostService.UnmapUser(c.Request.Context(), req.Provider, req.Username); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"provider": req.Provider, "username": req.Username})
+}
+
+// GetGitUsers отдаёт связи логинов Git-хостингов с пользователями; без provider - всех хостингов.
+func (h *Handler) GetGitUsers(c *gin.Context) {
+	identities, err := h.gitHostService.ListMappings(c.Request.Context(), c.Query("provider"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"git_users": identities})
+}
@@ -5,10 +5,20 @@ import (
 )
 
 type Handler struct {
-	teamService      services.TeamService
-	userService      services.UserService
-	prService        services.PullRequestService
-	statisticService services.StatisticService
+	teamService         services.TeamService
+	userService         services.UserService
+	prService           services.PullRequestService
+	statisticService    services.StatisticService
+	membershipService   services.MembershipService
+	codeOwnersService   services.CodeOwnersService
+	repoService         services.RepoService
+	slaService          services.ReviewSLAService
+	autoReassignService services.AutoReassignService
+	webhookService      services.WebhookService
+	gitHostService      services.GitHostService
+	eventStreamService  services.EventStreamService
+	notificationService services.NotificationService
+	reviewDigestService services.ReviewDigestService
 }
 
 func NewHandler(
@@ -16,11 +26,31 @@ func NewHandler(
 	userService services.UserService,
 	prService services.PullRequestService,
 	statisticService services.StatisticService,
+	membershipService services.MembershipService,
+	codeOwnersService services.CodeOwnersService,
+	repoService services.RepoService,
+	slaService services.ReviewSLAService,
+	autoReassignService services.AutoReassignService,
+	webhookService services.WebhookService,
+	gitHostService services.GitHostService,
+	eventStreamService services.EventStreamService,
+	notificationService services.NotificationService,
+	reviewDigestService services.ReviewDigestService,
 ) *Handler {
 	return &Handler{
-		teamService:      teamService,
-		userService:      userService,
-		prService:        prService,
-		statisticService: statisticService,
+		teamService:         teamService,
+		userService:         userService,
+		prService:           prService,
+		statisticService:    statisticService,
+		membershipService:   membershipService,
+		codeOwnersService:   codeOwnersService,
+		repoService:         repoService,
+		slaService:          slaService,
+		autoReassignService: autoReassignService,
+		webhookService:      webhookService,
+		gitHostService:      gitHostService,
+		eventStreamService:  eventStreamService,
+		notificationService: notificationService,
+		reviewDigestService: reviewDigestService,
 	}
 }
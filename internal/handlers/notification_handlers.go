@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/notify"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SetNotificationPreferencesRequest struct {
+	UserID  string `json:"user_id" binding:"required"`
+	Mode    string `json:"mode"`
+	Channel string `json:"channel"`
+	Email   string `json:"email"`
+}
+
+func (h *Handler) SetNotificationPreferences(c *gin.Context) {
+	var req SetNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	prefs, err := h.notificationService.SetPreferences(c.Request.Context(), &models.NotificationPreferences{
+		UserID:  req.UserID,
+		Mode:    req.Mode,
+		Channel: req.Channel,
+		Email:   req.Email,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, prefs)
+}
+
+func (h *Handler) GetNotificationPreferences(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		respondBadRequest(c, "user_id parameter is required")
+		return
+	}
+
+	prefs, err := h.notificationService.GetPreferences(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, prefs)
+}
+
+// GetReviewDigest показывает сводку ревью пользователя: format=json (по умолчанию), text или html.
+func (h *Handler) GetReviewDigest(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		respondBadRequest(c, "user_id parameter is required")
+		return
+	}
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "text" && format != "html" {
+		respondBadRequest(c, "format must be json, text or html")
+		return
+	}
+
+	digest, err := h.reviewDigestService.GetDigest(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	if format == "json" {
+		c.JSON(http.StatusOK, digest)
+		return
+	}
+
+	_, text, html, err := notify.RenderReviewDigest(digest)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	if format == "text" {
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(text))
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(html))
+}
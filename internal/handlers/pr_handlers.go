@@ -1,79 +1,259 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/services"
 
 	"github.com/gin-gonic/gin"
 )
 
+type PRResponse struct {
+	PR *models.PullRequest `json:"pr"`
+}
+
+type ReassignPRResponse struct {
+	PR         *models.PullRequest `json:"pr"`
+	ReplacedBy string              `json:"replaced_by"`
+}
+
+// PRConflictResponse возвращается при повторном создании PR вместе с существующим PR.
+type PRConflictResponse struct {
+	models.ErrorResponse
+	PR *models.PullRequest `json:"pr"`
+}
+
 type CreatePRRequest struct {
-	PullRequestID   string `json:"pull_request_id" binding:"required"`
-	PullRequestName string `json:"pull_request_name" binding:"required"`
-	AuthorID        string `json:"author_id" binding:"required"`
+	PullRequestID     string   `json:"pull_request_id" binding:"required"`
+	PullRequestName   string   `json:"pull_request_name" binding:"required"`
+	AuthorID          string   `json:"author_id" binding:"required"`
+	RequiredSkills    []string `json:"required_skills"`
+	SkillMatch        string   `json:"skill_match"`
+	Repository        string   `json:"repository"`
+	ChangedFiles      []string `json:"changed_files"`
+	URL               string   `json:"url"`
+	Description       string   `json:"description"`
+	Labels            []string `json:"labels"`
+	LinesAdded        int      `json:"lines_added"`
+	LinesRemoved      int      `json:"lines_removed"`
+	FilesChanged      int      `json:"files_changed"`
+	Priority          string   `json:"priority"`
+	ExcludedReviewers []string `json:"excluded_reviewers"`
+	Draft             bool     `json:"draft"`
+}
+
+func (r CreatePRRequest) pullRequest() *models.PullRequest {
+	return &models.PullRequest{
+		PullRequestID:     r.PullRequestID,
+		PullRequestName:   r.PullRequestName,
+		AuthorID:          r.AuthorID,
+		Status:            models.PRStatusOpen,
+		RequiredSkills:    r.RequiredSkills,
+		SkillMatch:        r.SkillMatch,
+		Repository:        r.Repository,
+		ChangedFiles:      r.ChangedFiles,
+		URL:               r.URL,
+		Description:       r.Description,
+		Labels:            r.Labels,
+		LinesAdded:        r.LinesAdded,
+		LinesRemoved:      r.LinesRemoved,
+		FilesChanged:      r.FilesChanged,
+		Priority:          r.Priority,
+		ExcludedReviewers: r.ExcludedReviewers,
+		Draft:             r.Draft,
+	}
 }
 
 type MergePRRequest struct {
+	Repository    string `json:"repository"`
 	PullRequestID string `json:"pull_request_id" binding:"required"`
 }
 
 type ReassignPRRequest struct {
+	Repository    string `json:"repository"`
 	PullRequestID string `json:"pull_request_id" binding:"required"`
 	OldReviewerID string `json:"old_reviewer_id" binding:"required"`
 }
 
+// RecordReviewRequest - ответ ревьювера на PR, необязательно с вердиктом.
+type RecordReviewRequest struct {
+	Repository    string `json:"repository"`
+	PullRequestID string `json:"pull_request_id" binding:"required"`
+	ReviewerID    string `json:"reviewer_id" binding:"required"`
+	Verdict       string `json:"verdict"`
+}
+
 func (h *Handler) CreatePullRequest(c *gin.Context) {
 	var req CreatePRRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "INVALID_REQUEST", "message": err.Error()}})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	pr := &models.PullRequest{
-		PullRequestID:   req.PullRequestID,
-		PullRequestName: req.PullRequestName,
-		AuthorID:        req.AuthorID,
-		Status:          models.PRStatusOpen,
+	createdPR, err := h.prService.CreatePullRequest(c.Request.Context(), req.pullRequest())
+	if errors.Is(err, services.ErrPRExists) && createdPR != nil {
+		c.JSON(http.StatusConflict, PRConflictResponse{
+			ErrorResponse: errorResponse(models.ErrorCodePRExists, err.Error()),
+			PR:            createdPR,
+		})
+		return
+	}
+	if err != nil {
+		respondError(c, err)
+		return
 	}
 
-	createdPR, err := h.prService.CreatePullRequest(c.Request.Context(), pr)
+	c.JSON(http.StatusCreated, PRResponse{PR: createdPR})
+}
+
+// DryRunPullRequest показывает, кто был бы назначен ревьюверами PR, не создавая его.
+func (h *Handler) DryRunPullRequest(c *gin.Context) {
+	var req CreatePRRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	preview, err := h.prService.DryRunPullRequest(c.Request.Context(), req.pullRequest())
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "PR_CREATE_FAILED", "message": err.Error()}})
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, createdPR)
+	c.JSON(http.StatusOK, preview)
 }
 
 func (h *Handler) MergePullRequest(c *gin.Context) {
 	var req MergePRRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "INVALID_REQUEST", "message": err.Error()}})
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	mergedPR, err := h.prService.MergePullRequest(c.Request.Context(), req.Repository, req.PullRequestID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, PRResponse{PR: mergedPR})
+}
+
+// MarkReadyForReview снимает с PR отметку черновика и назначает ревьюверов.
+func (h *Handler) MarkReadyForReview(c *gin.Context) {
+	var req MergePRRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
 		return
 	}
 
-	err := h.prService.MergePullRequest(c.Request.Context(), req.PullRequestID)
+	pr, err := h.prService.MarkReadyForReview(c.Request.Context(), req.Repository, req.PullRequestID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "PR_MERGE_FAILED", "message": err.Error()}})
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Pull request merged successfully"})
+	c.JSON(http.StatusOK, PRResponse{PR: pr})
 }
 
 func (h *Handler) ReassignReviewer(c *gin.Context) {
 	var req ReassignPRRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "INVALID_REQUEST", "message": err.Error()}})
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	pr, replacedBy, err := h.prService.ReassignReviewer(c.Request.Context(), req.Repository, req.PullRequestID, req.OldReviewerID)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
-	err := h.prService.ReassignReviewer(c.Request.Context(), req.PullRequestID, req.OldReviewerID)
+	c.JSON(http.StatusOK, ReassignPRResponse{PR: pr, ReplacedBy: replacedBy})
+}
+
+func (h *Handler) RecordReviewResponse(c *gin.Context) {
+	var req RecordReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	assignment, err := h.prService.RecordReviewResponse(c.Request.Context(), req.Repository, req.PullRequestID, req.ReviewerID, req.Verdict)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, assignment)
+}
+
+func (h *Handler) GetPullRequest(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		respondBadRequest(c, "pull_request_id parameter is required")
+		return
+	}
+
+	pr, err := h.prService.GetPullRequest(c.Request.Context(), c.Query("repository"), prID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, PRResponse{PR: pr})
+}
+
+func (h *Handler) ListPullRequests(c *gin.Context) {
+	filter := models.PullRequestFilter{
+		Repository: c.Query("repository"),
+		Status:     c.Query("status"),
+		AuthorID:   c.Query("author_id"),
+		TeamName:   c.Query("team_name"),
+		ReviewerID: c.Query("reviewer_id"),
+		Label:      c.Query("label"),
+		Priority:   c.Query("priority"),
+		SortBy:     c.Query("sort_by"),
+		SortOrder:  c.Query("order"),
+	}
+
+	timeParams := map[string]**time.Time{
+		"created_from": &filter.CreatedFrom,
+		"created_to":   &filter.CreatedTo,
+		"merged_from":  &filter.MergedFrom,
+		"merged_to":    &filter.MergedTo,
+	}
+	for name, target := range timeParams {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			respondBadRequest(c, name+" must be an RFC 3339 timestamp")
+			return
+		}
+		*target = &parsed
+	}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			respondBadRequest(c, "limit must be an integer")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	page, err := h.prService.ListPullRequests(c.Request.Context(), filter, c.Query("cursor"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "PR_REASSIGN_FAILED", "message": err.Error()}})
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Reviewer reassigned successfully"})
+	c.JSON(http.StatusOK, page)
 }
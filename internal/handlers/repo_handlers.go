@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"net/http"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RepoRequest задаёт репозиторий и его настройки назначения ревьюверов.
+type RepoRequest struct {
+	Repository    string                  `json:"repository" binding:"required"`
+	OwnerTeam     string                  `json:"owner_team"`
+	ReviewerCount *int                    `json:"reviewer_count"`
+	Strategy      string                  `json:"strategy"`
+	EligibleTeams []string                `json:"eligible_teams"`
+	Rules         []models.AssignmentRule `json:"rules"`
+}
+
+func (r RepoRequest) repo() *models.Repo {
+	return &models.Repo{
+		Name:          r.Repository,
+		OwnerTeam:     r.OwnerTeam,
+		ReviewerCount: r.ReviewerCount,
+		Strategy:      r.Strategy,
+		EligibleTeams: r.EligibleTeams,
+		Rules:         r.Rules,
+	}
+}
+
+func (h *Handler) CreateRepo(c *gin.Context) {
+	var req RepoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	repo, err := h.repoService.CreateRepo(c.Request.Context(), req.repo())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, repo)
+}
+
+func (h *Handler) GetRepo(c *gin.Context) {
+	name := c.Query("repository")
+	if name == "" {
+		respondBadRequest(c, "repository parameter is required")
+		return
+	}
+
+	repo, err := h.repoService.GetRepo(c.Request.Context(), name)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, repo)
+}
+
+func (h *Handler) UpdateRepo(c *gin.Context) {
+	var req RepoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	repo, err := h.repoService.UpdateRepo(c.Request.Context(), req.repo())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, repo)
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetTeamOverdueReviews отдаёт просроченные по SLA назначения на PR авторов команды.
+func (h *Handler) GetTeamOverdueReviews(c *gin.Context) {
+	teamName := c.Query("team_name")
+	if teamName == "" {
+		respondBadRequest(c, "team_name parameter is required")
+		return
+	}
+
+	overdue, err := h.slaService.GetOverdueByTeam(c.Request.Context(), teamName)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, overdue)
+}
+
+// GetUserOverdueReviews отдаёт просроченные по SLA назначения пользователя.
+func (h *Handler) GetUserOverdueReviews(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		respondBadRequest(c, "user_id parameter is required")
+		return
+	}
+
+	overdue, err := h.slaService.GetOverdueByReviewer(c.Request.Context(), userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, overdue)
+}
+
+// GetAutoReassignments отдаёт аудит автоматических переназначений ревьюверов PR.
+func (h *Handler) GetAutoReassignments(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		respondBadRequest(c, "pull_request_id parameter is required")
+		return
+	}
+
+	records, err := h.autoReassignService.GetAutoReassignments(c.Request.Context(), c.Query("repository"), prID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"reassignments": records})
+}
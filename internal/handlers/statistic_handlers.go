@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetFairnessReport отдаёт распределение ревью PR авторов между ревьюверами.
+func (h *Handler) GetFairnessReport(c *gin.Context) {
+	var since *time.Time
+	if value := c.Query("since"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			respondBadRequest(c, "since must be an RFC 3339 timestamp")
+			return
+		}
+		since = &parsed
+	}
+
+	report, err := h.statisticService.GetFairnessReport(c.Request.Context(), c.Query("team_name"), since)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -13,6 +13,55 @@ type CreateTeamRequest struct {
 	Members  []models.TeamMember `json:"members" binding:"required,dive"`
 }
 
+type AddMembersRequest struct {
+	TeamName string              `json:"team_name" binding:"required"`
+	Members  []models.TeamMember `json:"members" binding:"required,min=1,dive"`
+}
+
+type RemoveMembersRequest struct {
+	TeamName    string   `json:"team_name" binding:"required"`
+	UserIDs     []string `json:"user_ids" binding:"required,min=1"`
+	OpenReviews string   `json:"open_reviews"`
+}
+
+type TransferMemberRequest struct {
+	UserID      string `json:"user_id" binding:"required"`
+	TargetTeam  string `json:"target_team" binding:"required"`
+	OpenReviews string `json:"open_reviews"`
+}
+
+type DeleteTeamRequest struct {
+	TeamName   string `json:"team_name" binding:"required"`
+	ReassignTo string `json:"reassign_to"`
+}
+
+type SetParentTeamRequest struct {
+	TeamName   string `json:"team_name" binding:"required"`
+	ParentTeam string `json:"parent_team"`
+}
+
+type SetTeamPoliciesRequest struct {
+	TeamName string   `json:"team_name" binding:"required"`
+	Policies []string `json:"policies" binding:"required"`
+}
+
+type SetRotationPenaltyRequest struct {
+	TeamName        string `json:"team_name" binding:"required"`
+	RotationPenalty *int   `json:"rotation_penalty" binding:"required"`
+}
+
+type SetTeamSLARequest struct {
+	TeamName           string `json:"team_name" binding:"required"`
+	FirstResponseHours *int   `json:"first_response_hours"`
+	CompletionHours    *int   `json:"completion_hours"`
+}
+
+type SetAutoReassignRequest struct {
+	TeamName string `json:"team_name" binding:"required"`
+	Enabled  *bool  `json:"enabled" binding:"required"`
+	MaxPerPR int    `json:"max_per_pr"`
+}
+
 type GetTeamRequest struct {
 	TeamName string `json:"team_name" binding:"required"`
 }
@@ -20,13 +69,13 @@ type GetTeamRequest struct {
 func (h *Handler) CreateTeam(c *gin.Context) {
 	var req CreateTeamRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "INVALID_REQUEST", "message": err.Error()}})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
 	team, err := h.teamService.CreateTeamWithMembers(c.Request.Context(), req.TeamName, req.Members)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "TEAM_CREATE_FAILED", "message": err.Error()}})
+		respondError(c, err)
 		return
 	}
 
@@ -36,15 +85,220 @@ func (h *Handler) CreateTeam(c *gin.Context) {
 func (h *Handler) GetTeam(c *gin.Context) {
 	teamName := c.Query("team_name")
 	if teamName == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "INVALID_REQUEST", "message": "team_name parameter is required"}})
+		respondBadRequest(c, "team_name parameter is required")
 		return
 	}
 
 	team, err := h.teamService.GetTeamWithMembers(c.Request.Context(), teamName)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "TEAM_GET_FAILED", "message": err.Error()}})
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) GetTeamSubtree(c *gin.Context) {
+	teamName := c.Query("team_name")
+	if teamName == "" {
+		respondBadRequest(c, "team_name parameter is required")
+		return
+	}
+
+	tree, err := h.teamService.GetTeamSubtree(c.Request.Context(), teamName)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tree)
+}
+
+func (h *Handler) SetParentTeam(c *gin.Context) {
+	var req SetParentTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	team, err := h.teamService.SetParentTeam(c.Request.Context(), req.TeamName, req.ParentTeam)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) SetTeamPolicies(c *gin.Context) {
+	var req SetTeamPoliciesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	team, err := h.teamService.SetTeamPolicies(c.Request.Context(), req.TeamName, req.Policies)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) SetTeamRotationPenalty(c *gin.Context) {
+	var req SetRotationPenaltyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	team, err := h.teamService.SetRotationPenalty(c.Request.Context(), req.TeamName, *req.RotationPenalty)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, team)
 }
+
+func (h *Handler) SetTeamSLA(c *gin.Context) {
+	var req SetTeamSLARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	team, err := h.teamService.SetTeamSLA(c.Request.Context(), req.TeamName, models.ReviewSLA{
+		FirstResponseHours: req.FirstResponseHours,
+		CompletionHours:    req.CompletionHours,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) SetTeamAutoReassign(c *gin.Context) {
+	var req SetAutoReassignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	team, err := h.teamService.SetAutoReassign(c.Request.Context(), req.TeamName, models.AutoReassignSettings{
+		Enabled:  *req.Enabled,
+		MaxPerPR: req.MaxPerPR,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) AddTeamMembers(c *gin.Context) {
+	var req AddMembersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	team, err := h.membershipService.AddMembers(c.Request.Context(), req.TeamName, req.Members)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) RemoveTeamMembers(c *gin.Context) {
+	var req RemoveMembersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	changes, err := h.membershipService.RemoveMembers(c.Request.Context(), req.TeamName, req.UserIDs, openReviewsPolicy(req.OpenReviews))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"removed": changes})
+}
+
+func (h *Handler) TransferTeamMember(c *gin.Context) {
+	var req TransferMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	change, err := h.membershipService.TransferMember(c.Request.Context(), req.UserID, req.TargetTeam, openReviewsPolicy(req.OpenReviews))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, change)
+}
+
+func (h *Handler) ArchiveTeam(c *gin.Context) {
+	var req GetTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	report, err := h.membershipService.ArchiveTeam(c.Request.Context(), req.TeamName)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (h *Handler) RestoreTeam(c *gin.Context) {
+	var req GetTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	team, err := h.membershipService.RestoreTeam(c.Request.Context(), req.TeamName)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, team)
+}
+
+func (h *Handler) DeleteTeam(c *gin.Context) {
+	var req DeleteTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	report, err := h.membershipService.DeleteTeam(c.Request.Context(), req.TeamName, req.ReassignTo)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func openReviewsPolicy(value string) string {
+	if value == "" {
+		return models.OpenReviewsKeep
+	}
+	return value
+}
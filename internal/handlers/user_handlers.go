@@ -2,6 +2,10 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
 
 	"github.com/gin-gonic/gin"
 )
@@ -11,6 +15,30 @@ type SetUserActiveRequest struct {
 	IsActive bool   `json:"is_active"`
 }
 
+type SetUserSkillsRequest struct {
+	UserID string   `json:"user_id" binding:"required"`
+	Skills []string `json:"skills" binding:"required"`
+}
+
+type SetUserLevelRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+	Level  string `json:"level"`
+}
+
+type BlockPairRequest struct {
+	AuthorID   string     `json:"author_id" binding:"required"`
+	ReviewerID string     `json:"reviewer_id" binding:"required"`
+	Reason     string     `json:"reason"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	Mutual     bool       `json:"mutual"`
+}
+
+type UnblockPairRequest struct {
+	AuthorID   string `json:"author_id" binding:"required"`
+	ReviewerID string `json:"reviewer_id" binding:"required"`
+	Mutual     bool   `json:"mutual"`
+}
+
 type GetUserReviewRequest struct {
 	UserID string `json:"user_id" binding:"required"`
 }
@@ -18,43 +46,133 @@ type GetUserReviewRequest struct {
 func (h *Handler) SetUserActive(c *gin.Context) {
 	var req SetUserActiveRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "INVALID_REQUEST", "message": err.Error()}})
+		respondBadRequest(c, err.Error())
 		return
 	}
 
 	err := h.userService.SetUserActiveStatus(c.Request.Context(), req.UserID, req.IsActive)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "USER_UPDATE_FAILED", "message": err.Error()}})
+		respondError(c, err)
 		return
 	}
 
 	user, err := h.userService.GetUserWithTeam(c.Request.Context(), req.UserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": gin.H{"code": "USER_GET_FAILED", "message": err.Error()}})
+		respondError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, user)
 }
 
-func (h *Handler) GetUserReviews(c *gin.Context) {
+func (h *Handler) SetUserSkills(c *gin.Context) {
+	var req SetUserSkillsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	user, err := h.userService.SetUserSkills(c.Request.Context(), req.UserID, req.Skills)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+func (h *Handler) SetUserLevel(c *gin.Context) {
+	var req SetUserLevelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	user, err := h.userService.SetUserLevel(c.Request.Context(), req.UserID, req.Level)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+func (h *Handler) BlockPair(c *gin.Context) {
+	var req BlockPairRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	pair := &models.BlockedPair{
+		AuthorID:   req.AuthorID,
+		ReviewerID: req.ReviewerID,
+		Reason:     req.Reason,
+		ExpiresAt:  req.ExpiresAt,
+	}
+	pairs, err := h.userService.BlockPair(c.Request.Context(), pair, req.Mutual)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pairs)
+}
+
+func (h *Handler) UnblockPair(c *gin.Context) {
+	var req UnblockPairRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	pairs, err := h.userService.UnblockPair(c.Request.Context(), req.AuthorID, req.ReviewerID, req.Mutual)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pairs)
+}
+
+func (h *Handler) GetBlockedPairs(c *gin.Context) {
 	userID := c.Query("user_id")
 	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "INVALID_REQUEST", "message": "user_id parameter is required"}})
+		respondBadRequest(c, "user_id parameter is required")
 		return
 	}
 
-	err := h.userService.ValidateUserExists(c.Request.Context(), userID)
+	pairs, err := h.userService.GetBlockedPairs(c.Request.Context(), userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "USER_NOT_FOUND", "message": err.Error()}})
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pairs)
+}
+
+func (h *Handler) GetUserReviews(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		respondBadRequest(c, "user_id parameter is required")
 		return
 	}
 
-	prs, err := h.prService.GetUserPullRequests(c.Request.Context(), userID)
+	filter := models.ReviewFilter{Status: c.Query("status")}
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			respondBadRequest(c, "limit must be an integer")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	page, err := h.prService.GetUserPullRequests(c.Request.Context(), userID, filter, c.Query("cursor"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": gin.H{"code": "PR_GET_FAILED", "message": err.Error()}})
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"pull_requests": prs})
+	c.JSON(http.StatusOK, page)
 }
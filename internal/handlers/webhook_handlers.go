@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SubscribeWebhookRequest struct {
+	URL    string   `json:"url" binding:"required"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret" binding:"required"`
+}
+
+type DeleteWebhookRequest struct {
+	ID int64 `json:"id" binding:"required"`
+}
+
+type ReplayWebhooksRequest struct {
+	SubscriptionID int64   `json:"subscription_id"`
+	DeadLetterIDs  []int64 `json:"dead_letter_ids"`
+}
+
+func (h *Handler) SubscribeWebhook(c *gin.Context) {
+	var req SubscribeWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	sub, err := h.webhookService.Subscribe(c.Request.Context(), &models.WebhookSubscription{
+		URL:    req.URL,
+		Events: req.Events,
+		Secret: req.Secret,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, sub)
+}
+
+func (h *Handler) ListWebhooks(c *gin.Context) {
+	subs, err := h.webhookService.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"subscriptions": subs})
+}
+
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	var req DeleteWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	if err := h.webhookService.DeleteSubscription(c.Request.Context(), req.ID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"id": req.ID})
+}
+
+// GetWebhookDeadLetters отдаёт недоставленные события; без subscription_id - всех подписок.
+func (h *Handler) GetWebhookDeadLetters(c *gin.Context) {
+	var subscriptionID int64
+	if value := c.Query("subscription_id"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed <= 0 {
+			respondBadRequest(c, "subscription_id must be a positive integer")
+			return
+		}
+		subscriptionID = parsed
+	}
+
+	letters, err := h.webhookService.GetDeadLetters(c.Request.Context(), subscriptionID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"dead_letters": letters})
+}
+
+// ReplayWebhooks возвращает недоставленные события в очередь доставки.
+func (h *Handler) ReplayWebhooks(c *gin.Context) {
+	var req ReplayWebhooksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBadRequest(c, err.Error())
+		return
+	}
+
+	replayed, err := h.webhookService.ReplayDeadLetters(c.Request.Context(), req.SubscriptionID, req.DeadLetterIDs)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"replayed": replayed})
+}
@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	idempotencySaveTimeout  = 5 * time.Second
+)
+
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware обрабатывает заголовок Idempotency-Key для POST-запросов.
+// Первый запрос с ключом выполняется и его ответ сохраняется в БД на ttl;
+// повторы с тем же ключом и телом получают сохранённый ответ без повторного выполнения.
+// Ответы 5xx не сохраняются, чтобы клиент мог повторить запрос.
+func IdempotencyMiddleware(repo repository.IdempotencyRepository, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			abortWithError(c, http.StatusBadRequest, models.ErrorCodeInvalidRequest, "Idempotency-Key is too long")
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			abortWithError(c, http.StatusBadRequest, models.ErrorCodeInvalidRequest, "Failed to read request body")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		hash := sha256.Sum256(body)
+		record := &models.IdempotencyRecord{
+			Key:         key,
+			RequestPath: c.Request.URL.Path,
+			RequestHash: hex.EncodeToString(hash[:]),
+			ExpiresAt:   time.Now().Add(ttl),
+		}
+
+		ctx := c.Request.Context()
+		reserved, err := repo.ReserveIdempotencyKey(ctx, record)
+		if err != nil {
+			log.Printf("Failed to reserve idempotency key %q: %v", key, err)
+			abortWithError(c, http.StatusInternalServerError, models.ErrorCodeInternal, "Internal server error")
+			return
+		}
+
+		if !reserved {
+			replayIdempotentResponse(c, repo, record)
+			return
+		}
+
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		// Ключ освобождается и при панике обработчика: RecoveryMiddleware ответит 500 уже после
+		// выхода из этого middleware, а незавершённая запись блокировала бы ретраи до истечения ttl.
+		completed := false
+		defer func() {
+			// Сохранение не должно зависеть от отмены контекста клиентом,
+			// иначе ретрай после таймаута снова увидит незавершённую запись.
+			saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), idempotencySaveTimeout)
+			defer cancel()
+
+			status := writer.Status()
+			if !completed || !writer.Written() || status >= http.StatusInternalServerError {
+				if err := repo.DeleteIdempotencyKey(saveCtx, record.Key, record.RequestPath); err != nil {
+					log.Printf("Failed to release idempotency key %q: %v", key, err)
+				}
+				return
+			}
+
+			record.StatusCode = status
+			record.ResponseBody = writer.body.Bytes()
+			if err := repo.CompleteIdempotencyKey(saveCtx, record); err != nil {
+				log.Printf("Failed to store idempotent response for key %q: %v", key, err)
+			}
+		}()
+
+		c.Next()
+		completed = true
+	}
+}
+
+func replayIdempotentResponse(c *gin.Context, repo repository.IdempotencyRepository, record *models.IdempotencyRecord) {
+	stored, err := repo.GetIdempotencyRecord(c.Request.Context(), record.Key, record.RequestPath)
+	if err != nil {
+		log.Printf("Failed to load idempotency key %q: %v", record.Key, err)
+		abortWithError(c, http.StatusInternalServerError, models.ErrorCodeInternal, "Internal server error")
+		return
+	}
+
+	switch {
+	case stored != nil && stored.RequestHash != record.RequestHash:
+		abortWithError(c, http.StatusUnprocessableEntity, models.ErrorCodeIdempotencyKeyReused,
+			"Idempotency-Key was already used with a different request body")
+	case stored == nil || stored.StatusCode == 0:
+		abortWithError(c, http.StatusConflict, models.ErrorCodeIdempotencyKeyInProgress,
+			"A request with this Idempotency-Key is still being processed")
+	default:
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Data(stored.StatusCode, "application/json; charset=utf-8", stored.ResponseBody)
+		c.Abort()
+	}
+}
+
+func abortWithError(c *gin.Context, status int, code, message string) {
+	c.AbortWithStatusJSON(status, models.ErrorResponse{Error: models.ErrorDetail{Code: code, Message: message}})
+}
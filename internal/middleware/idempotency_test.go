@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func newIdempotencyRouter(repo *mocks.MockIdempotencyRepository, calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RecoveryMiddleware())
+	r.Use(IdempotencyMiddleware(repo, time.Hour))
+	r.POST("/reassign", func(c *gin.Context) {
+		*calls++
+		c.JSON(http.StatusOK, gin.H{"replaced_by": "u5"})
+	})
+	r.POST("/fail", func(c *gin.Context) {
+		*calls++
+		c.JSON(http.StatusInternalServerError, gin.H{})
+	})
+	r.POST("/panic", func(c *gin.Context) {
+		*calls++
+		panic("handler bug")
+	})
+	return r
+}
+
+func doIdempotentRequest(r *gin.Engine, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIdempotencyRepository(ctrl)
+
+	t.Run("without key passes through", func(t *testing.T) {
+		calls := 0
+		r := newIdempotencyRouter(mockRepo, &calls)
+
+		w := doIdempotentRequest(r, "/reassign", "", `{}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("first request stores response", func(t *testing.T) {
+		calls := 0
+		r := newIdempotencyRouter(mockRepo, &calls)
+
+		mockRepo.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().CompleteIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, record *models.IdempotencyRecord) error {
+				assert.Equal(t, "key-1", record.Key)
+				assert.Equal(t, "/reassign", record.RequestPath)
+				assert.Equal(t, http.StatusOK, record.StatusCode)
+				assert.JSONEq(t, `{"replaced_by":"u5"}`, string(record.ResponseBody))
+				return nil
+			})
+
+		w := doIdempotentRequest(r, "/reassign", "key-1", `{"pull_request_id":"pr1"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("retry replays stored response", func(t *testing.T) {
+		calls := 0
+		r := newIdempotencyRouter(mockRepo, &calls)
+		body := `{"pull_request_id":"pr1"}`
+
+		var stored models.IdempotencyRecord
+		mockRepo.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, record *models.IdempotencyRecord) (bool, error) {
+				stored = *record
+				stored.StatusCode = http.StatusOK
+				stored.ResponseBody = []byte(`{"replaced_by":"u5"}`)
+				return false, nil
+			})
+		mockRepo.EXPECT().GetIdempotencyRecord(gomock.Any(), "key-1", "/reassign").
+			DoAndReturn(func(_ interface{}, _, _ string) (*models.IdempotencyRecord, error) {
+				return &stored, nil
+			})
+
+		w := doIdempotentRequest(r, "/reassign", "key-1", body)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 0, calls)
+		assert.Equal(t, "true", w.Header().Get(IdempotentReplayedHeader))
+		assert.JSONEq(t, `{"replaced_by":"u5"}`, w.Body.String())
+	})
+
+	t.Run("key reused with different body", func(t *testing.T) {
+		calls := 0
+		r := newIdempotencyRouter(mockRepo, &calls)
+
+		mockRepo.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Return(false, nil)
+		mockRepo.EXPECT().GetIdempotencyRecord(gomock.Any(), "key-1", "/reassign").
+			Return(&models.IdempotencyRecord{RequestHash: "other", StatusCode: http.StatusOK}, nil)
+
+		w := doIdempotentRequest(r, "/reassign", "key-1", `{"pull_request_id":"pr2"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), models.ErrorCodeIdempotencyKeyReused)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("server error releases key", func(t *testing.T) {
+		calls := 0
+		r := newIdempotencyRouter(mockRepo, &calls)
+
+		mockRepo.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().DeleteIdempotencyKey(gomock.Any(), "key-2", "/fail").Return(nil)
+
+		w := doIdempotentRequest(r, "/fail", "key-2", `{}`)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("panic releases key", func(t *testing.T) {
+		calls := 0
+		r := newIdempotencyRouter(mockRepo, &calls)
+
+		mockRepo.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Return(true, nil)
+		mockRepo.EXPECT().DeleteIdempotencyKey(gomock.Any(), "key-3", "/panic").Return(nil)
+
+		w := doIdempotentRequest(r, "/panic", "key-3", `{}`)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+}
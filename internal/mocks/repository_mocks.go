@@ -4,6 +4,7 @@ import (
 	context "context"
 	models "pr-reviewer-assignment-service/internal/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -127,6 +128,105 @@ func (mr *MockUserRepositoryMockRecorder) UserExists(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserExists", reflect.TypeOf((*MockUserRepository)(nil).UserExists), arg0, arg1)
 }
 
+func (m *MockUserRepository) SetUserTeam(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserTeam", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockUserRepositoryMockRecorder) SetUserTeam(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserTeam", reflect.TypeOf((*MockUserRepository)(nil).SetUserTeam), arg0, arg1, arg2)
+}
+
+func (m *MockUserRepository) SetUserSkills(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserSkills", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockUserRepositoryMockRecorder) SetUserSkills(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserSkills", reflect.TypeOf((*MockUserRepository)(nil).SetUserSkills), arg0, arg1, arg2)
+}
+
+func (m *MockUserRepository) SetUserLevel(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserLevel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockUserRepositoryMockRecorder) SetUserLevel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserLevel", reflect.TypeOf((*MockUserRepository)(nil).SetUserLevel), arg0, arg1, arg2)
+}
+
+func (m *MockUserRepository) CreateBlockedPair(arg0 context.Context, arg1 *models.BlockedPair) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlockedPair", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockUserRepositoryMockRecorder) CreateBlockedPair(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlockedPair", reflect.TypeOf((*MockUserRepository)(nil).CreateBlockedPair), arg0, arg1)
+}
+
+func (m *MockUserRepository) DeleteBlockedPair(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlockedPair", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockUserRepositoryMockRecorder) DeleteBlockedPair(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlockedPair", reflect.TypeOf((*MockUserRepository)(nil).DeleteBlockedPair), arg0, arg1, arg2)
+}
+
+func (m *MockUserRepository) GetBlockedPairs(arg0 context.Context, arg1 string) ([]*models.BlockedPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedPairs", arg0, arg1)
+	ret0, _ := ret[0].([]*models.BlockedPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockUserRepositoryMockRecorder) GetBlockedPairs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedPairs", reflect.TypeOf((*MockUserRepository)(nil).GetBlockedPairs), arg0, arg1)
+}
+
+func (m *MockUserRepository) GetBlockedReviewers(arg0 context.Context, arg1 string) ([]*models.BlockedPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedReviewers", arg0, arg1)
+	ret0, _ := ret[0].([]*models.BlockedPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockUserRepositoryMockRecorder) GetBlockedReviewers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedReviewers", reflect.TypeOf((*MockUserRepository)(nil).GetBlockedReviewers), arg0, arg1)
+}
+
+func (m *MockUserRepository) GetUsersByIDs(arg0 context.Context, arg1 []string) (map[string]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", arg0, arg1)
+	ret0, _ := ret[0].(map[string]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockUserRepositoryMockRecorder) GetUsersByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserRepository)(nil).GetUsersByIDs), arg0, arg1)
+}
+
 type MockTeamRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockTeamRepositoryMockRecorder
@@ -234,135 +334,235 @@ func (mr *MockTeamRepositoryMockRecorder) GetAllTeams(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTeams", reflect.TypeOf((*MockTeamRepository)(nil).GetAllTeams), arg0)
 }
 
-type MockPullRequestRepository struct {
-	ctrl     *gomock.Controller
-	recorder *MockPullRequestRepositoryMockRecorder
+func (m *MockTeamRepository) SetTeamArchived(arg0 context.Context, arg1 string, arg2 bool) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamArchived", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-type MockPullRequestRepositoryMockRecorder struct {
-	mock *MockPullRequestRepository
+func (mr *MockTeamRepositoryMockRecorder) SetTeamArchived(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamArchived", reflect.TypeOf((*MockTeamRepository)(nil).SetTeamArchived), arg0, arg1, arg2)
 }
 
-func NewMockPullRequestRepository(ctrl *gomock.Controller) *MockPullRequestRepository {
-	mock := &MockPullRequestRepository{ctrl: ctrl}
-	mock.recorder = &MockPullRequestRepositoryMockRecorder{mock}
-	return mock
+func (m *MockTeamRepository) AddTeamMember(arg0 context.Context, arg1 string, arg2 models.TeamMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-func (m *MockPullRequestRepository) EXPECT() *MockPullRequestRepositoryMockRecorder {
-	return m.recorder
+func (mr *MockTeamRepositoryMockRecorder) AddTeamMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockTeamRepository)(nil).AddTeamMember), arg0, arg1, arg2)
 }
 
-func (m *MockPullRequestRepository) CreatePullRequest(arg0 context.Context, arg1 *models.PullRequest) error {
+func (m *MockTeamRepository) RemoveTeamMember(arg0 context.Context, arg1 string, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePullRequest", arg0, arg1)
+	ret := m.ctrl.Call(m, "RemoveTeamMember", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) CreatePullRequest(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTeamRepositoryMockRecorder) RemoveTeamMember(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*MockPullRequestRepository)(nil).CreatePullRequest), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMember", reflect.TypeOf((*MockTeamRepository)(nil).RemoveTeamMember), arg0, arg1, arg2)
 }
 
-func (m *MockPullRequestRepository) GetPullRequestByID(arg0 context.Context, arg1 string) (*models.PullRequest, error) {
+func (m *MockTeamRepository) IsTeamMember(arg0 context.Context, arg1 string, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPullRequestByID", arg0, arg1)
-	ret0, _ := ret[0].(*models.PullRequest)
+	ret := m.ctrl.Call(m, "IsTeamMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) GetPullRequestByID(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTeamRepositoryMockRecorder) IsTeamMember(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByID", reflect.TypeOf((*MockPullRequestRepository)(nil).GetPullRequestByID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTeamMember", reflect.TypeOf((*MockTeamRepository)(nil).IsTeamMember), arg0, arg1, arg2)
 }
 
-func (m *MockPullRequestRepository) GetPullRequestsByReviewer(arg0 context.Context, arg1 string) ([]*models.PullRequestShort, error) {
+func (m *MockTeamRepository) SetTeamParent(arg0 context.Context, arg1 string, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPullRequestsByReviewer", arg0, arg1)
-	ret0, _ := ret[0].([]*models.PullRequestShort)
+	ret := m.ctrl.Call(m, "SetTeamParent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockTeamRepositoryMockRecorder) SetTeamParent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamParent", reflect.TypeOf((*MockTeamRepository)(nil).SetTeamParent), arg0, arg1, arg2)
+}
+
+func (m *MockTeamRepository) GetTeamSubtree(arg0 context.Context, arg1 string) ([]*models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSubtree", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Team)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) GetPullRequestsByReviewer(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTeamRepositoryMockRecorder) GetTeamSubtree(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsByReviewer", reflect.TypeOf((*MockPullRequestRepository)(nil).GetPullRequestsByReviewer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSubtree", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamSubtree), arg0, arg1)
 }
 
-func (m *MockPullRequestRepository) UpdatePullRequest(arg0 context.Context, arg1 *models.PullRequest) error {
+func (m *MockTeamRepository) GetTeamPolicies(arg0 context.Context, arg1 string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePullRequest", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetTeamPolicies", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockTeamRepositoryMockRecorder) GetTeamPolicies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamPolicies", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamPolicies), arg0, arg1)
+}
+
+func (m *MockTeamRepository) SetTeamPolicies(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamPolicies", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) UpdatePullRequest(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTeamRepositoryMockRecorder) SetTeamPolicies(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockPullRequestRepository)(nil).UpdatePullRequest), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamPolicies", reflect.TypeOf((*MockTeamRepository)(nil).SetTeamPolicies), arg0, arg1, arg2)
 }
 
-func (m *MockPullRequestRepository) DeletePullRequest(arg0 context.Context, arg1 string) error {
+func (m *MockTeamRepository) GetTeamRotationPenalty(arg0 context.Context, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePullRequest", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetTeamRotationPenalty", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockTeamRepositoryMockRecorder) GetTeamRotationPenalty(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamRotationPenalty", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamRotationPenalty), arg0, arg1)
+}
+
+func (m *MockTeamRepository) SetTeamRotationPenalty(arg0 context.Context, arg1 string, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamRotationPenalty", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) DeletePullRequest(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTeamRepositoryMockRecorder) SetTeamRotationPenalty(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePullRequest", reflect.TypeOf((*MockPullRequestRepository)(nil).DeletePullRequest), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamRotationPenalty", reflect.TypeOf((*MockTeamRepository)(nil).SetTeamRotationPenalty), arg0, arg1, arg2)
 }
 
-func (m *MockPullRequestRepository) MergePullRequest(arg0 context.Context, arg1 string) error {
+func (m *MockTeamRepository) SetTeamSLA(arg0 context.Context, arg1 string, arg2 models.ReviewSLA) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MergePullRequest", arg0, arg1)
+	ret := m.ctrl.Call(m, "SetTeamSLA", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) MergePullRequest(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTeamRepositoryMockRecorder) SetTeamSLA(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePullRequest", reflect.TypeOf((*MockPullRequestRepository)(nil).MergePullRequest), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamSLA", reflect.TypeOf((*MockTeamRepository)(nil).SetTeamSLA), arg0, arg1, arg2)
 }
 
-func (m *MockPullRequestRepository) PullRequestExists(arg0 context.Context, arg1 string) (bool, error) {
+func (m *MockTeamRepository) SetTeamAutoReassign(arg0 context.Context, arg1 string, arg2 models.AutoReassignSettings) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PullRequestExists", arg0, arg1)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "SetTeamAutoReassign", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockTeamRepositoryMockRecorder) SetTeamAutoReassign(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamAutoReassign", reflect.TypeOf((*MockTeamRepository)(nil).SetTeamAutoReassign), arg0, arg1, arg2)
+}
+
+func (m *MockTeamRepository) GetTeamsByMemberRole(arg0 context.Context, arg1 string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamsByMemberRole", arg0, arg1)
+	ret0, _ := ret[0].(map[string][]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) PullRequestExists(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockTeamRepositoryMockRecorder) GetTeamsByMemberRole(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullRequestExists", reflect.TypeOf((*MockPullRequestRepository)(nil).PullRequestExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamsByMemberRole", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamsByMemberRole), arg0, arg1)
 }
 
-func (m *MockPullRequestRepository) GetAssignedReviewers(arg0 context.Context, arg1 string) ([]string, error) {
+func (m *MockTeamRepository) GetMembersByTeams(arg0 context.Context, arg1 []string) (map[string][]models.TeamMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAssignedReviewers", arg0, arg1)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetMembersByTeams", arg0, arg1)
+	ret0, _ := ret[0].(map[string][]models.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockTeamRepositoryMockRecorder) GetMembersByTeams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersByTeams", reflect.TypeOf((*MockTeamRepository)(nil).GetMembersByTeams), arg0, arg1)
+}
+
+type MockPullRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPullRequestRepositoryMockRecorder
+}
+
+type MockPullRequestRepositoryMockRecorder struct {
+	mock *MockPullRequestRepository
+}
+
+func NewMockPullRequestRepository(ctrl *gomock.Controller) *MockPullRequestRepository {
+	mock := &MockPullRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockPullRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockPullRequestRepository) EXPECT() *MockPullRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockPullRequestRepository) CreatePullRequest(arg0 context.Context, arg1 *models.PullRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) CreatePullRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*MockPullRequestRepository)(nil).CreatePullRequest), arg0, arg1)
+}
+
+func (m *MockPullRequestRepository) GetPullRequestsByReviewer(arg0 context.Context, arg1 string, arg2 models.ReviewFilter) ([]*models.PullRequestShort, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestsByReviewer", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.PullRequestShort)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) GetAssignedReviewers(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockPullRequestRepositoryMockRecorder) GetPullRequestsByReviewer(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedReviewers", reflect.TypeOf((*MockPullRequestRepository)(nil).GetAssignedReviewers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsByReviewer", reflect.TypeOf((*MockPullRequestRepository)(nil).GetPullRequestsByReviewer), arg0, arg1, arg2)
 }
 
-func (m *MockPullRequestRepository) SetAssignedReviewers(arg0 context.Context, arg1 string, arg2 []string) error {
+func (m *MockPullRequestRepository) UpdatePullRequest(arg0 context.Context, arg1 *models.PullRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetAssignedReviewers", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdatePullRequest", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) SetAssignedReviewers(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockPullRequestRepositoryMockRecorder) UpdatePullRequest(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssignedReviewers", reflect.TypeOf((*MockPullRequestRepository)(nil).SetAssignedReviewers), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockPullRequestRepository)(nil).UpdatePullRequest), arg0, arg1)
 }
 
 func (m *MockPullRequestRepository) GetPRCountByStatus(arg0 context.Context) (map[string]int, error) {
@@ -391,15 +591,859 @@ func (mr *MockPullRequestRepositoryMockRecorder) GetAssignmentsByUsers(arg0 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentsByUsers", reflect.TypeOf((*MockPullRequestRepository)(nil).GetAssignmentsByUsers), arg0)
 }
 
-func (m *MockPullRequestRepository) GetTeamPRCount(arg0 context.Context, arg1 string) (int, error) {
+func (m *MockPullRequestRepository) GetPRCountsByTeam(arg0 context.Context) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPRCountsByTeam", arg0)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetPRCountsByTeam(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRCountsByTeam", reflect.TypeOf((*MockPullRequestRepository)(nil).GetPRCountsByTeam), arg0)
+}
+
+func (m *MockPullRequestRepository) ListPullRequests(arg0 context.Context, arg1 models.PullRequestFilter) ([]*models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequests", arg0, arg1)
+	ret0, _ := ret[0].([]*models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) ListPullRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequests", reflect.TypeOf((*MockPullRequestRepository)(nil).ListPullRequests), arg0, arg1)
+}
+
+func (m *MockPullRequestRepository) CountPullRequestsByReviewer(arg0 context.Context, arg1 string, arg2 string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTeamPRCount", arg0, arg1)
+	ret := m.ctrl.Call(m, "CountPullRequestsByReviewer", arg0, arg1, arg2)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockPullRequestRepositoryMockRecorder) GetTeamPRCount(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockPullRequestRepositoryMockRecorder) CountPullRequestsByReviewer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPullRequestsByReviewer", reflect.TypeOf((*MockPullRequestRepository)(nil).CountPullRequestsByReviewer), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) GetOpenReviewCounts(arg0 context.Context, arg1 []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenReviewCounts", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetOpenReviewCounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenReviewCounts", reflect.TypeOf((*MockPullRequestRepository)(nil).GetOpenReviewCounts), arg0, arg1)
+}
+
+func (m *MockPullRequestRepository) GetOpenPullRequestRefsByReviewer(arg0 context.Context, arg1 string) ([]models.PullRequestRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenPullRequestRefsByReviewer", arg0, arg1)
+	ret0, _ := ret[0].([]models.PullRequestRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetOpenPullRequestRefsByReviewer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPullRequestRefsByReviewer", reflect.TypeOf((*MockPullRequestRepository)(nil).GetOpenPullRequestRefsByReviewer), arg0, arg1)
+}
+
+func (m *MockPullRequestRepository) GetOpenPullRequestRefsByTeam(arg0 context.Context, arg1 string) ([]models.PullRequestRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenPullRequestRefsByTeam", arg0, arg1)
+	ret0, _ := ret[0].([]models.PullRequestRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetOpenPullRequestRefsByTeam(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPullRequestRefsByTeam", reflect.TypeOf((*MockPullRequestRepository)(nil).GetOpenPullRequestRefsByTeam), arg0, arg1)
+}
+
+func (m *MockPullRequestRepository) GetPullRequestByID(arg0 context.Context, arg1 string, arg2 string) (*models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetPullRequestByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByID", reflect.TypeOf((*MockPullRequestRepository)(nil).GetPullRequestByID), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) DeletePullRequest(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePullRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) DeletePullRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePullRequest", reflect.TypeOf((*MockPullRequestRepository)(nil).DeletePullRequest), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) MergePullRequest(arg0 context.Context, arg1 string, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergePullRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) MergePullRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePullRequest", reflect.TypeOf((*MockPullRequestRepository)(nil).MergePullRequest), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) PullRequestExists(arg0 context.Context, arg1 string, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullRequestExists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) PullRequestExists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullRequestExists", reflect.TypeOf((*MockPullRequestRepository)(nil).PullRequestExists), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) GetAssignedReviewers(arg0 context.Context, arg1 string, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedReviewers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetAssignedReviewers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedReviewers", reflect.TypeOf((*MockPullRequestRepository)(nil).GetAssignedReviewers), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) SetAssignedReviewers(arg0 context.Context, arg1 string, arg2 string, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAssignedReviewers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) SetAssignedReviewers(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssignedReviewers", reflect.TypeOf((*MockPullRequestRepository)(nil).SetAssignedReviewers), arg0, arg1, arg2, arg3)
+}
+
+func (m *MockPullRequestRepository) GetRecentPairings(arg0 context.Context, arg1 string, arg2 int, arg3 time.Time) ([]models.ReviewPairing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentPairings", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.ReviewPairing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetRecentPairings(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentPairings", reflect.TypeOf((*MockPullRequestRepository)(nil).GetRecentPairings), arg0, arg1, arg2, arg3)
+}
+
+func (m *MockPullRequestRepository) GetPairingCounts(arg0 context.Context, arg1 string, arg2 *time.Time) ([]models.PairingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairingCounts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.PairingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetPairingCounts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairingCounts", reflect.TypeOf((*MockPullRequestRepository)(nil).GetPairingCounts), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) SetReviewResponded(arg0 context.Context, arg1 string, arg2 string, arg3 string, arg4 time.Time, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewResponded", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) SetReviewResponded(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewResponded", reflect.TypeOf((*MockPullRequestRepository)(nil).SetReviewResponded), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+func (m *MockPullRequestRepository) GetReviewAssignments(arg0 context.Context, arg1 models.ReviewAssignmentFilter) ([]*models.ReviewAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewAssignments", arg0, arg1)
+	ret0, _ := ret[0].([]*models.ReviewAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetReviewAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewAssignments", reflect.TypeOf((*MockPullRequestRepository)(nil).GetReviewAssignments), arg0, arg1)
+}
+
+func (m *MockPullRequestRepository) CreateAutoReassignment(arg0 context.Context, arg1 *models.AutoReassignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoReassignment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) CreateAutoReassignment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoReassignment", reflect.TypeOf((*MockPullRequestRepository)(nil).CreateAutoReassignment), arg0, arg1)
+}
+
+func (m *MockPullRequestRepository) GetAutoReassignments(arg0 context.Context, arg1 string, arg2 string) ([]*models.AutoReassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoReassignments", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.AutoReassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) GetAutoReassignments(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoReassignments", reflect.TypeOf((*MockPullRequestRepository)(nil).GetAutoReassignments), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) SetPullRequestReady(arg0 context.Context, arg1 string, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPullRequestReady", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) SetPullRequestReady(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPullRequestReady", reflect.TypeOf((*MockPullRequestRepository)(nil).SetPullRequestReady), arg0, arg1, arg2)
+}
+
+func (m *MockPullRequestRepository) SetReviewReminded(arg0 context.Context, arg1 string, arg2 string, arg3 string, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewReminded", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockPullRequestRepositoryMockRecorder) SetReviewReminded(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewReminded", reflect.TypeOf((*MockPullRequestRepository)(nil).SetReviewReminded), arg0, arg1, arg2, arg3, arg4)
+}
+
+type MockIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRepositoryMockRecorder
+}
+
+type MockIdempotencyRepositoryMockRecorder struct {
+	mock *MockIdempotencyRepository
+}
+
+func NewMockIdempotencyRepository(ctrl *gomock.Controller) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockIdempotencyRepository) ReserveIdempotencyKey(arg0 context.Context, arg1 *models.IdempotencyRecord) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIdempotencyKey", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockIdempotencyRepositoryMockRecorder) ReserveIdempotencyKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).ReserveIdempotencyKey), arg0, arg1)
+}
+
+func (m *MockIdempotencyRepository) GetIdempotencyRecord(arg0 context.Context, arg1 string, arg2 string) (*models.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockIdempotencyRepositoryMockRecorder) GetIdempotencyRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyRecord", reflect.TypeOf((*MockIdempotencyRepository)(nil).GetIdempotencyRecord), arg0, arg1, arg2)
+}
+
+func (m *MockIdempotencyRepository) CompleteIdempotencyKey(arg0 context.Context, arg1 *models.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotencyKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockIdempotencyRepositoryMockRecorder) CompleteIdempotencyKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).CompleteIdempotencyKey), arg0, arg1)
+}
+
+func (m *MockIdempotencyRepository) DeleteIdempotencyKey(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotencyKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteIdempotencyKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotencyKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteIdempotencyKey), arg0, arg1, arg2)
+}
+
+func (m *MockIdempotencyRepository) DeleteExpiredIdempotencyKeys(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyKeys", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockIdempotencyRepositoryMockRecorder) DeleteExpiredIdempotencyKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyKeys", reflect.TypeOf((*MockIdempotencyRepository)(nil).DeleteExpiredIdempotencyKeys), arg0)
+}
+
+type MockCodeOwnersRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCodeOwnersRepositoryMockRecorder
+}
+
+type MockCodeOwnersRepositoryMockRecorder struct {
+	mock *MockCodeOwnersRepository
+}
+
+func NewMockCodeOwnersRepository(ctrl *gomock.Controller) *MockCodeOwnersRepository {
+	mock := &MockCodeOwnersRepository{ctrl: ctrl}
+	mock.recorder = &MockCodeOwnersRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockCodeOwnersRepository) EXPECT() *MockCodeOwnersRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockCodeOwnersRepository) CreateCodeOwnersFile(arg0 context.Context, arg1 *models.CodeOwnersFile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCodeOwnersFile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockCodeOwnersRepositoryMockRecorder) CreateCodeOwnersFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCodeOwnersFile", reflect.TypeOf((*MockCodeOwnersRepository)(nil).CreateCodeOwnersFile), arg0, arg1)
+}
+
+func (m *MockCodeOwnersRepository) GetCodeOwnersFile(arg0 context.Context, arg1 string, arg2 int) (*models.CodeOwnersFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeOwnersFile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.CodeOwnersFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockCodeOwnersRepositoryMockRecorder) GetCodeOwnersFile(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeOwnersFile", reflect.TypeOf((*MockCodeOwnersRepository)(nil).GetCodeOwnersFile), arg0, arg1, arg2)
+}
+
+type MockRepoRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepoRepositoryMockRecorder
+}
+
+type MockRepoRepositoryMockRecorder struct {
+	mock *MockRepoRepository
+}
+
+func NewMockRepoRepository(ctrl *gomock.Controller) *MockRepoRepository {
+	mock := &MockRepoRepository{ctrl: ctrl}
+	mock.recorder = &MockRepoRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockRepoRepository) EXPECT() *MockRepoRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockRepoRepository) CreateRepo(arg0 context.Context, arg1 *models.Repo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRepo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockRepoRepositoryMockRecorder) CreateRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepo", reflect.TypeOf((*MockRepoRepository)(nil).CreateRepo), arg0, arg1)
+}
+
+func (m *MockRepoRepository) GetRepo(arg0 context.Context, arg1 string) (*models.Repo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepo", arg0, arg1)
+	ret0, _ := ret[0].(*models.Repo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockRepoRepositoryMockRecorder) GetRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepo", reflect.TypeOf((*MockRepoRepository)(nil).GetRepo), arg0, arg1)
+}
+
+func (m *MockRepoRepository) UpdateRepo(arg0 context.Context, arg1 *models.Repo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRepo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockRepoRepositoryMockRecorder) UpdateRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepo", reflect.TypeOf((*MockRepoRepository)(nil).UpdateRepo), arg0, arg1)
+}
+
+func (m *MockRepoRepository) RepoExists(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepoExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockRepoRepositoryMockRecorder) RepoExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepoExists", reflect.TypeOf((*MockRepoRepository)(nil).RepoExists), arg0, arg1)
+}
+
+type MockTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactorMockRecorder
+}
+
+type MockTransactorMockRecorder struct {
+	mock *MockTransactor
+}
+
+func NewMockTransactor(ctrl *gomock.Controller) *MockTransactor {
+	mock := &MockTransactor{ctrl: ctrl}
+	mock.recorder = &MockTransactorMockRecorder{mock}
+	return mock
+}
+
+func (m *MockTransactor) EXPECT() *MockTransactorMockRecorder {
+	return m.recorder
+}
+
+func (m *MockTransactor) WithinTransaction(arg0 context.Context, arg1 func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockTransactorMockRecorder) WithinTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTransaction", reflect.TypeOf((*MockTransactor)(nil).WithinTransaction), arg0, arg1)
+}
+
+type MockEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryMockRecorder
+}
+
+type MockEventRepositoryMockRecorder struct {
+	mock *MockEventRepository
+}
+
+func NewMockEventRepository(ctrl *gomock.Controller) *MockEventRepository {
+	mock := &MockEventRepository{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockEventRepository) AddEvents(arg0 context.Context, arg1 []*models.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockEventRepositoryMockRecorder) AddEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvents", reflect.TypeOf((*MockEventRepository)(nil).AddEvents), arg0, arg1)
+}
+
+func (m *MockEventRepository) GetEventsAfter(arg0 context.Context, arg1 int64, arg2 int) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockEventRepositoryMockRecorder) GetEventsAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsAfter", reflect.TypeOf((*MockEventRepository)(nil).GetEventsAfter), arg0, arg1, arg2)
+}
+
+func (m *MockEventRepository) GetLastEventID(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastEventID", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockEventRepositoryMockRecorder) GetLastEventID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastEventID", reflect.TypeOf((*MockEventRepository)(nil).GetLastEventID), arg0)
+}
+
+func (m *MockEventRepository) GetEventCursor(arg0 context.Context, arg1 string) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventCursor", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockEventRepositoryMockRecorder) GetEventCursor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventCursor", reflect.TypeOf((*MockEventRepository)(nil).GetEventCursor), arg0, arg1)
+}
+
+func (m *MockEventRepository) SetEventCursor(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventCursor", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockEventRepositoryMockRecorder) SetEventCursor(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventCursor", reflect.TypeOf((*MockEventRepository)(nil).SetEventCursor), arg0, arg1, arg2)
+}
+
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockWebhookRepository) CreateSubscription(arg0 context.Context, arg1 *models.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) CreateSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).CreateSubscription), arg0, arg1)
+}
+
+func (m *MockWebhookRepository) GetSubscriptions(arg0 context.Context) ([]*models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptions", arg0)
+	ret0, _ := ret[0].([]*models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) GetSubscriptions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).GetSubscriptions), arg0)
+}
+
+func (m *MockWebhookRepository) DeleteSubscription(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) DeleteSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteSubscription), arg0, arg1)
+}
+
+func (m *MockWebhookRepository) EnqueueDeliveries(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueDeliveries", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) EnqueueDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).EnqueueDeliveries), arg0, arg1)
+}
+
+func (m *MockWebhookRepository) GetDueDeliveries(arg0 context.Context, arg1 time.Time, arg2 int) ([]*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueDeliveries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) GetDueDeliveries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).GetDueDeliveries), arg0, arg1, arg2)
+}
+
+func (m *MockWebhookRepository) MarkDelivered(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) MarkDelivered(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockWebhookRepository)(nil).MarkDelivered), arg0, arg1)
+}
+
+func (m *MockWebhookRepository) ScheduleRetry(arg0 context.Context, arg1 int64, arg2 int, arg3 time.Time, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleRetry", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ScheduleRetry(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleRetry", reflect.TypeOf((*MockWebhookRepository)(nil).ScheduleRetry), arg0, arg1, arg2, arg3, arg4)
+}
+
+func (m *MockWebhookRepository) MoveToDeadLetter(arg0 context.Context, arg1 int64, arg2 int, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToDeadLetter", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) MoveToDeadLetter(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToDeadLetter", reflect.TypeOf((*MockWebhookRepository)(nil).MoveToDeadLetter), arg0, arg1, arg2, arg3)
+}
+
+func (m *MockWebhookRepository) GetDeadLetters(arg0 context.Context, arg1 int64) ([]*models.WebhookDeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetters", arg0, arg1)
+	ret0, _ := ret[0].([]*models.WebhookDeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) GetDeadLetters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetters", reflect.TypeOf((*MockWebhookRepository)(nil).GetDeadLetters), arg0, arg1)
+}
+
+func (m *MockWebhookRepository) ReplayDeadLetters(arg0 context.Context, arg1 int64, arg2 []int64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetters", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockWebhookRepositoryMockRecorder) ReplayDeadLetters(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetters", reflect.TypeOf((*MockWebhookRepository)(nil).ReplayDeadLetters), arg0, arg1, arg2)
+}
+
+type MockGitIdentityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitIdentityRepositoryMockRecorder
+}
+
+type MockGitIdentityRepositoryMockRecorder struct {
+	mock *MockGitIdentityRepository
+}
+
+func NewMockGitIdentityRepository(ctrl *gomock.Controller) *MockGitIdentityRepository {
+	mock := &MockGitIdentityRepository{ctrl: ctrl}
+	mock.recorder = &MockGitIdentityRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockGitIdentityRepository) EXPECT() *MockGitIdentityRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockGitIdentityRepository) SetGitIdentity(arg0 context.Context, arg1 *models.GitIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGitIdentity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockGitIdentityRepositoryMockRecorder) SetGitIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGitIdentity", reflect.TypeOf((*MockGitIdentityRepository)(nil).SetGitIdentity), arg0, arg1)
+}
+
+func (m *MockGitIdentityRepository) DeleteGitIdentity(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGitIdentity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockGitIdentityRepositoryMockRecorder) DeleteGitIdentity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGitIdentity", reflect.TypeOf((*MockGitIdentityRepository)(nil).DeleteGitIdentity), arg0, arg1, arg2)
+}
+
+func (m *MockGitIdentityRepository) GetGitIdentities(arg0 context.Context, arg1 string) ([]*models.GitIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGitIdentities", arg0, arg1)
+	ret0, _ := ret[0].([]*models.GitIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockGitIdentityRepositoryMockRecorder) GetGitIdentities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitIdentities", reflect.TypeOf((*MockGitIdentityRepository)(nil).GetGitIdentities), arg0, arg1)
+}
+
+func (m *MockGitIdentityRepository) GetUserIDByGitUsername(arg0 context.Context, arg1 string, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByGitUsername", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockGitIdentityRepositoryMockRecorder) GetUserIDByGitUsername(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByGitUsername", reflect.TypeOf((*MockGitIdentityRepository)(nil).GetUserIDByGitUsername), arg0, arg1, arg2)
+}
+
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+}
+
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockNotificationRepository) SetNotificationPreferences(arg0 context.Context, arg1 *models.NotificationPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotificationPreferences", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) SetNotificationPreferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationPreferences", reflect.TypeOf((*MockNotificationRepository)(nil).SetNotificationPreferences), arg0, arg1)
+}
+
+func (m *MockNotificationRepository) GetNotificationPreferences(arg0 context.Context, arg1 string) (*models.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", arg0, arg1)
+	ret0, _ := ret[0].(*models.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) GetNotificationPreferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockNotificationRepository)(nil).GetNotificationPreferences), arg0, arg1)
+}
+
+func (m *MockNotificationRepository) AddDigestItem(arg0 context.Context, arg1 *models.DigestItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDigestItem", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) AddDigestItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDigestItem", reflect.TypeOf((*MockNotificationRepository)(nil).AddDigestItem), arg0, arg1)
+}
+
+func (m *MockNotificationRepository) GetDigestItems(arg0 context.Context) ([]*models.DigestItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestItems", arg0)
+	ret0, _ := ret[0].([]*models.DigestItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) GetDigestItems(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestItems", reflect.TypeOf((*MockNotificationRepository)(nil).GetDigestItems), arg0)
+}
+
+func (m *MockNotificationRepository) DeleteDigestItems(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDigestItems", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockNotificationRepositoryMockRecorder) DeleteDigestItems(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamPRCount", reflect.TypeOf((*MockPullRequestRepository)(nil).GetTeamPRCount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDigestItems", reflect.TypeOf((*MockNotificationRepository)(nil).DeleteDigestItems), arg0, arg1)
 }
@@ -2,26 +2,229 @@ package models
 
 import "time"
 
+// User.TeamName - основная команда пользователя: из неё выбираются ревьюверы его PR.
+// Пользователь может состоять и в других командах (см. user_teams).
 type User struct {
 	UserID    string    `json:"user_id" db:"user_id"`
 	Username  string    `json:"username" db:"username"`
 	TeamName  string    `json:"team_name" db:"team_name"`
 	IsActive  bool      `json:"is_active" db:"is_active"`
+	Skills    []string  `json:"skills,omitempty" db:"skills"`
+	Level     string    `json:"level,omitempty" db:"level"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// ReviewWeight - вес участника в команде, из которой он выбирается ревьювером.
+	// Нулевое значение равносильно весу по умолчанию.
+	ReviewWeight int `json:"-" db:"weight"`
 }
 
+// DefaultMemberWeight - вес участника команды, если он не задан явно.
+const DefaultMemberWeight = 1
+
 type TeamMember struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
 	IsActive bool   `json:"is_active"`
+	Role     string `json:"role,omitempty"`
+	Weight   int    `json:"weight,omitempty" binding:"omitempty,min=1"`
 }
 
 type Team struct {
-	TeamName  string       `json:"team_name"`
-	Members   []TeamMember `json:"members"`
-	CreatedAt time.Time    `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time    `json:"updated_at" db:"updated_at"`
+	TeamName   string       `json:"team_name"`
+	Members    []TeamMember `json:"members"`
+	CreatedAt  time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at" db:"updated_at"`
+	ArchivedAt *time.Time   `json:"archived_at,omitempty" db:"archived_at"`
+	ParentTeam string       `json:"parent_team,omitempty" db:"parent_team"`
+	Policies   []string     `json:"policies,omitempty"`
+
+	// RotationPenalty - сила штрафа (0-100) за повторное назначение недавнего ревьювера
+	// автора из этой команды; 0 отключает ротацию.
+	RotationPenalty int `json:"rotation_penalty" db:"rotation_penalty"`
+
+	SLA          ReviewSLA            `json:"sla"`
+	AutoReassign AutoReassignSettings `json:"auto_reassign"`
+}
+
+// AutoReassignSettings - автоматическое переназначение ревьюверов, не ответивших к сроку SLA на PR
+// авторов команды. MaxPerPR ограничивает число таких переназначений одного PR.
+type AutoReassignSettings struct {
+	Enabled  bool `json:"enabled" db:"auto_reassign_enabled"`
+	MaxPerPR int  `json:"max_per_pr" db:"auto_reassign_max_per_pr"`
+}
+
+// DefaultAutoReassignMaxPerPR - лимит автоматических переназначений одного PR у новых команд.
+const DefaultAutoReassignMaxPerPR = 1
+
+// AutoReassignment - запись аудита автоматического переназначения просроченного ревью.
+type AutoReassignment struct {
+	ID            int64     `json:"id"`
+	Repository    string    `json:"repository"`
+	PullRequestID string    `json:"pull_request_id"`
+	OldReviewerID string    `json:"old_reviewer_id"`
+	NewReviewerID string    `json:"new_reviewer_id"`
+	TeamName      string    `json:"team_name"`
+	Reason        string    `json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Причины автоматического переназначения.
+const (
+	AutoReassignFirstResponseOverdue = "first_response_overdue"
+	AutoReassignCompletionOverdue    = "completion_overdue"
+)
+
+// ReviewSLA - сроки ревью PR авторов команды в рабочих часах: FirstResponseHours - на первый
+// ответ ревьювера, CompletionHours - на завершение ревью. nil - срок не задан.
+type ReviewSLA struct {
+	FirstResponseHours *int `json:"first_response_hours,omitempty" db:"sla_first_response_hours"`
+	CompletionHours    *int `json:"completion_hours,omitempty" db:"sla_completion_hours"`
+}
+
+// SLAState - сроки по SLA и признак просрочки. Назначение ревьювера просрочено, если он не
+// ответил к FirstResponseDueAt или PR не завершён к CompletionDueAt; PR - если он открыт после CompletionDueAt.
+type SLAState struct {
+	FirstResponseDueAt *time.Time `json:"first_response_due_at,omitempty"`
+	CompletionDueAt    *time.Time `json:"completion_due_at,omitempty"`
+	Overdue            bool       `json:"overdue"`
+}
+
+// ReviewAssignment - назначение ревьювера на открытый PR с состоянием SLA. TeamName - основная
+// команда автора, по SLA которой отсчитываются сроки.
+type ReviewAssignment struct {
+	Repository      string     `json:"repository"`
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	ReviewerID      string     `json:"reviewer_id"`
+	TeamName        string     `json:"team_name,omitempty"`
+	Status          string     `json:"status"`
+	AssignedAt      time.Time  `json:"assigned_at"`
+	RespondedAt     *time.Time `json:"responded_at,omitempty"`
+	Verdict         string     `json:"verdict,omitempty"`
+	SLA             SLAState   `json:"sla"`
+
+	TeamSLA    ReviewSLA  `json:"-"`
+	RemindedAt *time.Time `json:"-"`
+}
+
+// ReviewAssignmentFilter - параметры выборки назначений ревьюверов. Пустые поля не ограничивают выборку.
+type ReviewAssignmentFilter struct {
+	TeamName      string
+	ReviewerID    string
+	ReviewerIDs   []string
+	Repository    string
+	PullRequestID string
+	OpenOnly      bool
+}
+
+// OverdueReviews - просроченные назначения ревьюверов команды или пользователя.
+type OverdueReviews struct {
+	TeamName string             `json:"team_name,omitempty"`
+	UserID   string             `json:"user_id,omitempty"`
+	Reviews  []ReviewAssignment `json:"reviews"`
+}
+
+// DefaultRotationPenalty - сила штрафа ротации ревьюверов у новых команд.
+const DefaultRotationPenalty = 50
+
+// ReviewPairing - ревью PR автора AuthorID пользователем ReviewerID. CreatedAt - время создания PR.
+type ReviewPairing struct {
+	AuthorID   string    `json:"author_id" db:"author_id"`
+	ReviewerID string    `json:"reviewer_id" db:"user_id"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// BlockedPair запрещает назначать ReviewerID ревьювером PR автора AuthorID. После ExpiresAt
+// запрет перестаёт действовать; без ExpiresAt он бессрочный.
+type BlockedPair struct {
+	AuthorID   string     `json:"author_id" db:"author_id"`
+	ReviewerID string     `json:"reviewer_id" db:"reviewer_id"`
+	Reason     string     `json:"reason,omitempty" db:"reason"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// BlockedPairList - запреты, в которых пользователь выступает автором или ревьювером.
+type BlockedPairList struct {
+	UserID       string         `json:"user_id"`
+	BlockedPairs []*BlockedPair `json:"blocked_pairs"`
+}
+
+// Причины исключения пользователя из кандидатов в ревьюверы PR.
+const (
+	ExclusionBlockedPair = "blocked_pair"
+	ExclusionExcluded    = "excluded"
+)
+
+// ReviewerExclusion объясняет, почему пользователь не может быть ревьювером PR.
+// Detail - причина запрета пары, если она указана.
+type ReviewerExclusion struct {
+	UserID string `json:"user_id"`
+	Reason string `json:"reason"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// AssignmentPreview - результат пробного назначения ревьюверов без создания PR.
+type AssignmentPreview struct {
+	PullRequest *PullRequest        `json:"pr"`
+	Excluded    []ReviewerExclusion `json:"excluded"`
+}
+
+// Уровни пользователей в порядке возрастания.
+const (
+	LevelJunior = "junior"
+	LevelMid    = "mid"
+	LevelSenior = "senior"
+	LevelLead   = "lead"
+)
+
+// Политики наставничества команды: junior_needs_senior - у PR автора уровня junior
+// должен быть ревьювер уровня senior или выше, mentorship_pairing - среди ревьюверов
+// PR должны быть junior и senior (или выше).
+const (
+	PolicyJuniorNeedsSenior = "junior_needs_senior"
+	PolicyMentorshipPairing = "mentorship_pairing"
+)
+
+// TeamNode - узел дерева команд, возвращаемого /team/subtree.
+type TeamNode struct {
+	TeamName   string       `json:"team_name"`
+	ParentTeam string       `json:"parent_team,omitempty"`
+	ArchivedAt *time.Time   `json:"archived_at,omitempty"`
+	Members    []TeamMember `json:"members"`
+	Children   []*TeamNode  `json:"children"`
+}
+
+// TeamRemovalReport описывает последствия архивации или удаления команды.
+type TeamRemovalReport struct {
+	TeamName         string           `json:"team_name"`
+	Deleted          bool             `json:"deleted"`
+	ArchivedAt       *time.Time       `json:"archived_at,omitempty"`
+	MovedTo          string           `json:"moved_to,omitempty"`
+	AffectedUsers    []string         `json:"affected_users"`
+	OpenPullRequests []PullRequestRef `json:"open_pull_requests"`
+}
+
+// Политика обработки открытых ревью при выходе пользователя из команды.
+const (
+	OpenReviewsKeep     = "keep"
+	OpenReviewsReassign = "reassign"
+)
+
+type ReviewReassignment struct {
+	Repository    string `json:"repository"`
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	// NewReviewerID пуст, если замену найти не удалось и ревьювер просто снят с PR.
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+}
+
+type MembershipChange struct {
+	User              *User                `json:"user"`
+	PreviousTeam      string               `json:"previous_team,omitempty"`
+	ReassignedReviews []ReviewReassignment `json:"reassigned_reviews"`
 }
 
 type PullRequest struct {
@@ -32,13 +235,188 @@ type PullRequest struct {
 	AssignedReviewers []string   `json:"assigned_reviewers" db:"assigned_reviewers"`
 	CreatedAt         *time.Time `json:"createdAt,omitempty" db:"created_at"`
 	MergedAt          *time.Time `json:"mergedAt,omitempty" db:"merged_at"`
+	RequiredSkills    []string   `json:"required_skills,omitempty" db:"required_skills"`
+	SkillMatch        string     `json:"skill_match,omitempty" db:"skill_match"`
+	Repository        string     `json:"repository" db:"repository"`
+	ChangedFiles      []string   `json:"changed_files,omitempty" db:"changed_files"`
+	URL               string     `json:"url,omitempty" db:"url"`
+	Description       string     `json:"description,omitempty" db:"description"`
+	Labels            []string   `json:"labels,omitempty" db:"labels"`
+	LinesAdded        int        `json:"lines_added,omitempty" db:"lines_added"`
+	LinesRemoved      int        `json:"lines_removed,omitempty" db:"lines_removed"`
+	FilesChanged      int        `json:"files_changed,omitempty" db:"files_changed"`
+	Priority          string     `json:"priority,omitempty" db:"priority"`
+	ExcludedReviewers []string   `json:"excluded_reviewers,omitempty" db:"excluded_reviewers"`
+	Draft             bool       `json:"draft,omitempty" db:"draft"`
+	SLA               *SLAState  `json:"sla,omitempty"`
+
+	// CompletionSLAHours - срок завершения ревью по SLA основной команды автора.
+	CompletionSLAHours *int `json:"-"`
+}
+
+// Приоритеты PR.
+const (
+	PriorityLow      = "low"
+	PriorityNormal   = "normal"
+	PriorityHigh     = "high"
+	PriorityCritical = "critical"
+)
+
+// PullRequestRef идентифицирует PR: pull_request_id уникален в пределах репозитория.
+type PullRequestRef struct {
+	Repository    string `json:"repository"`
+	PullRequestID string `json:"pull_request_id"`
+}
+
+// DefaultRepository - репозиторий PR, для которых репозиторий не указан.
+const DefaultRepository = "default"
+
+// Repo - репозиторий с настройками назначения ревьюверов. Незаданные настройки
+// (nil ReviewerCount, пустые Strategy и EligibleTeams) заменяются значениями по умолчанию:
+// два ревьювера, стратегия random, команда автора. Rules добавляют ревьюверов сверх ReviewerCount.
+type Repo struct {
+	Name          string           `json:"repository" db:"repository"`
+	OwnerTeam     string           `json:"owner_team,omitempty" db:"owner_team"`
+	ReviewerCount *int             `json:"reviewer_count,omitempty" db:"reviewer_count"`
+	Strategy      string           `json:"strategy,omitempty" db:"strategy"`
+	EligibleTeams []string         `json:"eligible_teams"`
+	Rules         []AssignmentRule `json:"rules"`
+	CreatedAt     time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// AssignmentRule назначает PR дополнительных ревьюверов, если PR подходит под все
+// заданные условия: есть метка Label, приоритет равен Priority, изменено (добавлено и
+// удалено) не меньше MinChangedLines строк. Хотя бы одно условие обязательно.
+// MinLevel требует среди ревьюверов подходящего PR пользователя этого уровня или выше;
+// правило с MinLevel может не добавлять ревьюверов.
+type AssignmentRule struct {
+	Label           string `json:"label,omitempty"`
+	Priority        string `json:"priority,omitempty"`
+	MinChangedLines int    `json:"min_changed_lines,omitempty"`
+	ExtraReviewers  int    `json:"extra_reviewers"`
+	MinLevel        string `json:"min_level,omitempty"`
+}
+
+// Стратегии выбора ревьюверов: random - случайно с учётом веса участника,
+// least_loaded - наименее загруженные открытыми ревью.
+const (
+	StrategyRandom      = "random"
+	StrategyLeastLoaded = "least_loaded"
+)
+
+// Режимы учёта навыков при назначении ревьюверов: prefer - предпочитать кандидатов
+// с нужными навыками, require - назначать только их и требовать покрытия всех навыков PR.
+const (
+	SkillMatchPrefer  = "prefer"
+	SkillMatchRequire = "require"
+)
+
+// CodeOwnersFile - версия файла владения кодом репозитория в формате CODEOWNERS.
+// Rules заполняется при чтении файла.
+type CodeOwnersFile struct {
+	Repository string          `json:"repository" db:"repository"`
+	Version    int             `json:"version" db:"version"`
+	Content    string          `json:"content" db:"content"`
+	Rules      []OwnershipRule `json:"rules,omitempty"`
+	CreatedAt  time.Time       `json:"created_at" db:"created_at"`
+}
+
+// OwnershipRule - строка CODEOWNERS: шаблон пути и его владельцы. Владелец вида
+// @user_id - пользователь, @org/team_name - команда. Правило без владельцев снимает
+// владение, заданное выше.
+type OwnershipRule struct {
+	Line    int      `json:"line"`
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+}
+
+// CodeOwnersIssue - ссылка правила на несуществующего пользователя или команду.
+type CodeOwnersIssue struct {
+	Line   int    `json:"line"`
+	Owner  string `json:"owner"`
+	Reason string `json:"reason"`
+}
+
+const (
+	CodeOwnersUnknownUser = "unknown_user"
+	CodeOwnersUnknownTeam = "unknown_team"
+)
+
+type CodeOwnersValidation struct {
+	Repository string            `json:"repository"`
+	Version    int               `json:"version"`
+	Valid      bool              `json:"valid"`
+	Issues     []CodeOwnersIssue `json:"issues"`
+}
+
+const (
+	PRSortByCreatedAt = "created_at"
+	PRSortByID        = "pull_request_id"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// PullRequestCursor - позиция в выборке PR для курсорной пагинации.
+type PullRequestCursor struct {
+	CreatedAt  time.Time `json:"created_at"`
+	ID         string    `json:"id"`
+	Repository string    `json:"repository,omitempty"`
+}
+
+type PullRequestFilter struct {
+	Repository  string
+	Status      string
+	AuthorID    string
+	TeamName    string
+	ReviewerID  string
+	Label       string
+	Priority    string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+
+	SortBy    string
+	SortOrder string
+	Cursor    *PullRequestCursor
+	Limit     int
+}
+
+type PullRequestPage struct {
+	PullRequests []*PullRequest `json:"pull_requests"`
+	NextCursor   string         `json:"next_cursor,omitempty"`
 }
 
 type PullRequestShort struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
-	Status          string `json:"status"`
+	Repository      string     `json:"repository"`
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	Status          string     `json:"status"`
+	CreatedAt       *time.Time `json:"createdAt,omitempty"`
+	AssignedAt      *time.Time `json:"assigned_at,omitempty"`
+	RespondedAt     *time.Time `json:"responded_at,omitempty"`
+	Verdict         string     `json:"verdict,omitempty"`
+	SLA             *SLAState  `json:"sla,omitempty"`
+
+	TeamSLA ReviewSLA `json:"-"`
+}
+
+// ReviewFilter - параметры выборки PR, назначенных ревьюверу.
+// Status принимает значения PRStatusOpen, PRStatusMerged или PRStatusAll.
+type ReviewFilter struct {
+	Status string
+	Cursor *PullRequestCursor
+	Limit  int
+}
+
+type ReviewPage struct {
+	UserID       string              `json:"user_id"`
+	PullRequests []*PullRequestShort `json:"pull_requests"`
+	TotalCount   int                 `json:"total_count"`
+	NextCursor   string              `json:"next_cursor,omitempty"`
 }
 
 type ErrorResponse struct {
@@ -57,13 +435,44 @@ const (
 	ErrorCodeNotAssigned = "NOT_ASSIGNED"
 	ErrorCodeNoCandidate = "NO_CANDIDATE"
 	ErrorCodeNotFound    = "NOT_FOUND"
+
+	ErrorCodeInvalidRequest = "INVALID_REQUEST"
+	ErrorCodeUnprocessable  = "UNPROCESSABLE"
+	ErrorCodeInternal       = "INTERNAL_ERROR"
+
+	ErrorCodeIdempotencyKeyReused     = "IDEMPOTENCY_KEY_REUSED"
+	ErrorCodeIdempotencyKeyInProgress = "IDEMPOTENCY_KEY_IN_PROGRESS"
+
+	ErrorCodeMembershipConflict = "MEMBERSHIP_CONFLICT"
+	ErrorCodeTeamHasOpenPRs     = "TEAM_HAS_OPEN_PRS"
+
+	ErrorCodeRepositoryExists = "REPOSITORY_EXISTS"
+
+	ErrorCodePolicyViolation = "POLICY_VIOLATION"
+
+	ErrorCodeInvalidSignature = "INVALID_SIGNATURE"
 )
 
 const (
 	PRStatusOpen   = "OPEN"
 	PRStatusMerged = "MERGED"
+
+	// PRStatusAll используется только в фильтрах и означает любой статус.
+	PRStatusAll = "ALL"
 )
 
+// IdempotencyRecord хранит снимок ответа на запрос с заголовком Idempotency-Key.
+// StatusCode == 0 означает, что запрос ещё выполняется.
+type IdempotencyRecord struct {
+	Key          string    `json:"idempotency_key" db:"idempotency_key"`
+	RequestPath  string    `json:"request_path" db:"request_path"`
+	RequestHash  string    `json:"request_hash" db:"request_hash"`
+	StatusCode   int       `json:"status_code" db:"status_code"`
+	ResponseBody []byte    `json:"response_body" db:"response_body"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	ExpiresAt    time.Time `json:"expires_at" db:"expires_at"`
+}
+
 // Statistic models
 type UserAssignmentStats struct {
 	UserID          string `json:"user_id"`
@@ -78,7 +487,230 @@ type PRStatusStats struct {
 
 type TeamStats struct {
 	TeamName          string `json:"team_name"`
+	ParentTeam        string `json:"parent_team,omitempty"`
 	MemberCount       int    `json:"member_count"`
 	ActiveMemberCount int    `json:"active_member_count"`
 	PRCount           int    `json:"pr_count"`
+
+	// Subtree - показатели команды вместе со всеми дочерними командами.
+	// Пользователь из нескольких команд поддерева учитывается один раз.
+	Subtree TeamSubtreeStats `json:"subtree"`
+}
+
+// PairingStats - сколько PR автора AuthorID ревьюил ReviewerID.
+type PairingStats struct {
+	AuthorID     string    `json:"author_id"`
+	ReviewerID   string    `json:"reviewer_id"`
+	Count        int       `json:"count"`
+	LastPairedAt time.Time `json:"last_paired_at"`
+}
+
+// AuthorPairings - распределение ревью PR автора между ревьюверами. TopReviewerShare - доля
+// самого частого ревьювера: чем она ближе к 1, тем сильнее знания о коде автора сосредоточены у одного человека.
+type AuthorPairings struct {
+	AuthorID          string         `json:"author_id"`
+	ReviewCount       int            `json:"review_count"`
+	DistinctReviewers int            `json:"distinct_reviewers"`
+	TopReviewerShare  float64        `json:"top_reviewer_share"`
+	Pairings          []PairingStats `json:"pairings"`
+}
+
+// FairnessReport показывает, как ревью PR авторов распределяются между ревьюверами.
+type FairnessReport struct {
+	TeamName string           `json:"team_name,omitempty"`
+	Since    *time.Time       `json:"since,omitempty"`
+	Authors  []AuthorPairings `json:"authors"`
+}
+
+type TeamSubtreeStats struct {
+	TeamCount         int `json:"team_count"`
+	MemberCount       int `json:"member_count"`
+	ActiveMemberCount int `json:"active_member_count"`
+	PRCount           int `json:"pr_count"`
+}
+
+// Event - событие жизненного цикла назначений, записанное в outbox в одной транзакции с
+// изменением PR. ID монотонно растёт.
+type Event struct {
+	ID        int64     `json:"id"`
+	Type      string    `json:"type"`
+	Data      EventData `json:"data"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EventData - PR, к которому относится событие. Reviewers - ревьюверы PR после события.
+type EventData struct {
+	Repository      string   `json:"repository"`
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	Reviewers       []string `json:"reviewers"`
+	OldReviewerID   string   `json:"old_reviewer_id,omitempty"`
+	NewReviewerID   string   `json:"new_reviewer_id,omitempty"`
+	ReviewerID      string   `json:"reviewer_id,omitempty"`
+	Verdict         string   `json:"verdict,omitempty"`
+}
+
+// Типы событий.
+const (
+	EventReviewersAssigned  = "reviewers.assigned"
+	EventReviewerReassigned = "reviewer.reassigned"
+	EventPullRequestMerged  = "pull_request.merged"
+	EventReviewSubmitted    = "review.submitted"
+)
+
+// EventTypes - все типы событий, на которые можно подписаться.
+var EventTypes = []string{EventReviewersAssigned, EventReviewerReassigned, EventReviewSubmitted, EventPullRequestMerged}
+
+// Вердикты ревьювера по PR.
+const (
+	ReviewVerdictApproved         = "approved"
+	ReviewVerdictChangesRequested = "changes_requested"
+	ReviewVerdictCommented        = "commented"
+)
+
+// EventStreamFilter - отбор событий потока. TeamName оставляет события PR, автор или ревьюверы
+// которых состоят в команде, UserID - события, где пользователь автор или ревьювер.
+// Пустые поля не ограничивают поток.
+type EventStreamFilter struct {
+	TeamName string
+	UserID   string
+}
+
+// WebhookSubscription - подписка на события. Пустой Events - все типы событий.
+// Secret подписывает тела запросов и не возвращается в ответах.
+type WebhookSubscription struct {
+	ID        int64     `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookDelivery - ожидающая доставка события подписчику.
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	URL            string
+	Secret         string
+	Event          Event
+	Attempts       int
+}
+
+// WebhookDeadLetter - доставка, исчерпавшая попытки.
+type WebhookDeadLetter struct {
+	ID             int64     `json:"id"`
+	SubscriptionID int64     `json:"subscription_id"`
+	EventID        int64     `json:"event_id"`
+	EventType      string    `json:"event_type"`
+	Attempts       int       `json:"attempts"`
+	LastError      string    `json:"last_error"`
+	FailedAt       time.Time `json:"failed_at"`
+}
+
+// Режимы доставки уведомлений: сразу или в ежедневном дайджесте.
+const (
+	NotificationModeImmediate = "immediate"
+	NotificationModeDigest    = "digest"
+)
+
+// NotificationPreferences - как пользователь получает уведомления. Email нужен каналу email.
+type NotificationPreferences struct {
+	UserID    string    `json:"user_id"`
+	Mode      string    `json:"mode"`
+	Channel   string    `json:"channel"`
+	Email     string    `json:"email,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DigestItem - уведомление, отложенное до дайджеста.
+type DigestItem struct {
+	ID        int64
+	UserID    string
+	Kind      string
+	Subject   string
+	Body      string
+	CreatedAt time.Time
+}
+
+// TeamRoleLead - роль участника команды, который получает в сводке ревью нагрузку команды
+// и её просроченные ревью.
+const TeamRoleLead = "lead"
+
+// MemberLoad - число открытых PR, на которые назначен участник команды.
+type MemberLoad struct {
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	IsActive    bool   `json:"is_active"`
+	OpenReviews int    `json:"open_reviews"`
+}
+
+// TeamLoad - нагрузка участников команды ревью, от самых загруженных.
+type TeamLoad struct {
+	TeamName string       `json:"team_name"`
+	Members  []MemberLoad `json:"members"`
+}
+
+// PendingReview - открытый PR, на котором ревьювер ещё не вынес вердикт.
+// WaitingHours отсчитываются от назначения ревьювера.
+type PendingReview struct {
+	Repository      string    `json:"repository"`
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	AssignedAt      time.Time `json:"assigned_at"`
+	WaitingHours    int       `json:"waiting_hours"`
+	Overdue         bool      `json:"overdue"`
+}
+
+// TeamDigest - раздел сводки ревью о команде для её руководителя.
+type TeamDigest struct {
+	TeamName       string             `json:"team_name"`
+	Members        []MemberLoad       `json:"members"`
+	OverdueReviews []ReviewAssignment `json:"overdue_reviews"`
+}
+
+// ReviewDigest - сводка ревью пользователя: его ожидающие ревью от самых давних и разделы
+// о командах, где он руководитель.
+type ReviewDigest struct {
+	UserID         string          `json:"user_id"`
+	Username       string          `json:"username"`
+	GeneratedAt    time.Time       `json:"generated_at"`
+	PendingReviews []PendingReview `json:"pending_reviews"`
+	Teams          []TeamDigest    `json:"teams"`
+}
+
+// Empty сообщает, что в сводке нечего отправлять.
+func (d *ReviewDigest) Empty() bool {
+	return len(d.PendingReviews) == 0 && len(d.Teams) == 0
+}
+
+// Git-хостинги, события которых принимает сервис.
+const (
+	GitProviderGitHub = "github"
+	GitProviderGitLab = "gitlab"
+)
+
+// GitIdentity связывает логин на Git-хостинге с пользователем сервиса.
+type GitIdentity struct {
+	Provider  string    `json:"provider"`
+	Username  string    `json:"username"`
+	UserID    string    `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Действия, выполненные по событию Git-хостинга.
+const (
+	GitHostActionCreated        = "created"
+	GitHostActionMerged         = "merged"
+	GitHostActionReadyForReview = "ready_for_review"
+	GitHostActionIgnored        = "ignored"
+)
+
+// GitHostResult - результат обработки события Git-хостинга. Для пропущенных событий
+// Reason объясняет, почему событие не изменило PR.
+type GitHostResult struct {
+	Action      string       `json:"action"`
+	Reason      string       `json:"reason,omitempty"`
+	PullRequest *PullRequest `json:"pr,omitempty"`
 }
@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ChatNotifier отправляет уведомления во входящий вебхук чата (Slack, Mattermost и
+// совместимые): POST с телом {"text": "..."}, где получатель упомянут по имени.
+type ChatNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewChatNotifier(url string, client *http.Client) *ChatNotifier {
+	return &ChatNotifier{url: url, client: client}
+}
+
+func (n *ChatNotifier) Notify(ctx context.Context, msg Message) error {
+	payload, err := json.Marshal(map[string]string{
+		"text": fmt.Sprintf("@%s *%s*\n%s", msg.Username, msg.Subject, msg.Body),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("chat webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
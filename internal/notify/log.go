@@ -0,0 +1,24 @@
+package notify
+
+import (
+	"context"
+	"log"
+)
+
+// LogNotifier пишет уведомления в лог сервиса. Канал по умолчанию для пользователей без настроек.
+type LogNotifier struct {
+	logger *log.Logger
+}
+
+// NewLogNotifier создаёт канал поверх logger; nil - стандартный логгер.
+func NewLogNotifier(logger *log.Logger) *LogNotifier {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogNotifier{logger: logger}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, msg Message) error {
+	n.logger.Printf("Notification for %s: %s\n%s", msg.UserID, msg.Subject, msg.Body)
+	return nil
+}
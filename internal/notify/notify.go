@@ -0,0 +1,33 @@
+// Package notify доставляет уведомления пользователям: по почте, в чат или в лог сервиса.
+package notify
+
+import (
+	"context"
+	"errors"
+)
+
+// Каналы доставки уведомлений.
+const (
+	ChannelEmail = "email"
+	ChannelChat  = "chat"
+	ChannelLog   = "log"
+)
+
+// ErrNoAddress возвращается, если у получателя нет адреса для канала.
+var ErrNoAddress = errors.New("recipient has no address for the channel")
+
+// Message - уведомление одному пользователю. Email нужен только почтовому каналу,
+// необязательный HTML - HTML-версия Body; её использует только почта.
+type Message struct {
+	UserID   string
+	Username string
+	Email    string
+	Subject  string
+	Body     string
+	HTML     string
+}
+
+// Notifier - канал доставки уведомлений.
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
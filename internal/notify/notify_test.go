@@ -0,0 +1,194 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receivedMail - письмо, принятое fakeSMTPServer.
+type receivedMail struct {
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTPServer принимает письма по минимальному подмножеству SMTP без TLS и аутентификации.
+func fakeSMTPServer(t *testing.T) (host string, port int, mails <-chan receivedMail) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan receivedMail, 1)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, received)
+		}
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, received
+}
+
+func serveSMTP(conn net.Conn, received chan<- receivedMail) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+
+	var current receivedMail
+	tp.PrintfLine("220 fake smtp ready")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			tp.PrintfLine("250 fake")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			current.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			tp.PrintfLine("250 ok")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			current.to = append(current.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			tp.PrintfLine("250 ok")
+		case command == "DATA":
+			tp.PrintfLine("354 send data")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			current.data = string(data)
+			received <- current
+			current = receivedMail{}
+			tp.PrintfLine("250 queued")
+		case command == "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	host, port, mails := fakeSMTPServer(t)
+	notifier := NewSMTPNotifier(SMTPConfig{Host: host, Port: port, From: "reviews@example.com"})
+
+	err := notifier.Notify(context.Background(), Message{
+		UserID:  "u2",
+		Email:   "bob@example.com",
+		Subject: "Review requested: Ускорить поиск",
+		Body:    "You were assigned to review pull request pr-1.\n",
+	})
+	require.NoError(t, err)
+
+	received := <-mails
+	assert.Equal(t, "reviews@example.com", received.from)
+	assert.Equal(t, []string{"bob@example.com"}, received.to)
+
+	msg, err := mail.ReadMessage(strings.NewReader(received.data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Review requested: Ускорить поиск", subject)
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+	assert.Equal(t, "You were assigned to review pull request pr-1.\n", strings.ReplaceAll(string(body), "\r\n", "\n"))
+
+	t.Run("html alternative", func(t *testing.T) {
+		err := notifier.Notify(context.Background(), Message{
+			UserID:  "u2",
+			Email:   "bob@example.com",
+			Subject: "Digest",
+			Body:    "Plain text\n",
+			HTML:    "<p>Rich text</p>\n",
+		})
+		require.NoError(t, err)
+
+		msg, err := mail.ReadMessage(strings.NewReader((<-mails).data))
+		require.NoError(t, err)
+		mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		parts := multipart.NewReader(msg.Body, params["boundary"])
+		for _, want := range []struct{ contentType, content string }{
+			{"text/plain; charset=utf-8", "Plain text\n"},
+			{"text/html; charset=utf-8", "<p>Rich text</p>\n"},
+		} {
+			part, err := parts.NextRawPart()
+			require.NoError(t, err)
+			assert.Equal(t, want.contentType, part.Header.Get("Content-Type"))
+			content, err := io.ReadAll(quotedprintable.NewReader(part))
+			require.NoError(t, err)
+			assert.Equal(t, want.content, strings.ReplaceAll(string(content), "\r\n", "\n"))
+		}
+	})
+
+	t.Run("address with display name", func(t *testing.T) {
+		err := notifier.Notify(context.Background(), Message{UserID: "u2", Username: "Bob", Email: "Bob <bob@example.com>", Subject: "Digest", Body: "Text\n"})
+		require.NoError(t, err)
+
+		received := <-mails
+		assert.Equal(t, []string{"bob@example.com"}, received.to)
+		msg, err := mail.ReadMessage(strings.NewReader(received.data))
+		require.NoError(t, err)
+		assert.Equal(t, `"Bob" <bob@example.com>`, msg.Header.Get("To"))
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		assert.Error(t, notifier.Notify(context.Background(), Message{UserID: "u2", Email: "bob"}))
+	})
+
+	t.Run("recipient without email", func(t *testing.T) {
+		assert.ErrorIs(t, notifier.Notify(context.Background(), Message{UserID: "u3"}), ErrNoAddress)
+	})
+}
+
+func TestChatNotifier_Notify(t *testing.T) {
+	var payload map[string]string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	notifier := NewChatNotifier(server.URL, server.Client())
+	msg := Message{UserID: "u2", Username: "bob", Subject: "Review requested: Search", Body: "Details"}
+
+	require.NoError(t, notifier.Notify(context.Background(), msg))
+	assert.Equal(t, "@bob *Review requested: Search*\nDetails", payload["text"])
+
+	status = http.StatusBadGateway
+	assert.Error(t, notifier.Notify(context.Background(), msg))
+}
+
+func TestLogNotifier_Notify(t *testing.T) {
+	var buf bytes.Buffer
+	notifier := NewLogNotifier(log.New(&buf, "", 0))
+
+	require.NoError(t, notifier.Notify(context.Background(), Message{UserID: "u2", Subject: "Subject", Body: "Body"}))
+	assert.Equal(t, "Notification for u2: Subject\nBody\n", buf.String())
+}
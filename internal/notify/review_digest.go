@@ -0,0 +1,81 @@
+package notify
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+var reviewDigestFuncs = map[string]any{
+	"overdueCount": func(teams []models.TeamDigest) int {
+		count := 0
+		for _, team := range teams {
+			count += len(team.OverdueReviews)
+		}
+		return count
+	},
+}
+
+var reviewDigestSubject = template.Must(template.New("review_digest.subject").Funcs(reviewDigestFuncs).Parse(
+	`Review digest: {{len .PendingReviews}} pending review(s){{if .Teams}}, {{overdueCount .Teams}} overdue in your teams{{end}}`))
+
+var reviewDigestText = template.Must(template.New("review_digest.text").Funcs(reviewDigestFuncs).Parse(
+	`Hi {{.Username}},
+
+here is your review digest for {{.GeneratedAt.UTC.Format "2006-01-02"}}.
+
+{{if .PendingReviews}}Pending reviews, oldest first:
+{{range .PendingReviews}}  - {{if .Overdue}}[OVERDUE] {{end}}{{.Repository}}/{{.PullRequestID}} "{{.PullRequestName}}" by {{.AuthorID}}, waiting {{.WaitingHours}} h
+{{end}}{{else}}You have no pending reviews.
+{{end}}{{range .Teams}}
+Team {{.TeamName}}
+  Open reviews per member:
+{{range .Members}}    - {{.Username}} ({{.UserID}}): {{.OpenReviews}}{{if not .IsActive}}, inactive{{end}}
+{{end}}{{if .OverdueReviews}}  Overdue reviews:
+{{range .OverdueReviews}}    - {{.Repository}}/{{.PullRequestID}} "{{.PullRequestName}}" by {{.AuthorID}}, reviewer {{.ReviewerID}}, assigned {{.AssignedAt.UTC.Format "2006-01-02 15:04"}}
+{{end}}{{else}}  No overdue reviews.
+{{end}}{{end}}`))
+
+var reviewDigestHTML = htmltemplate.Must(htmltemplate.New("review_digest.html").Funcs(reviewDigestFuncs).Parse(
+	`<!DOCTYPE html>
+<html>
+<body>
+<p>Hi {{.Username}},</p>
+<p>here is your review digest for {{.GeneratedAt.UTC.Format "2006-01-02"}}.</p>
+{{if .PendingReviews}}<h2>Pending reviews</h2>
+<table>
+<tr><th>Pull request</th><th>Author</th><th>Waiting</th></tr>
+{{range .PendingReviews}}<tr{{if .Overdue}} style="color: #b00020"{{end}}><td>{{.Repository}}/{{.PullRequestID}} {{.PullRequestName}}{{if .Overdue}} (overdue){{end}}</td><td>{{.AuthorID}}</td><td>{{.WaitingHours}} h</td></tr>
+{{end}}</table>
+{{else}}<p>You have no pending reviews.</p>
+{{end}}{{range .Teams}}<h2>Team {{.TeamName}}</h2>
+<table>
+<tr><th>Member</th><th>Open reviews</th></tr>
+{{range .Members}}<tr><td>{{.Username}} ({{.UserID}}){{if not .IsActive}}, inactive{{end}}</td><td>{{.OpenReviews}}</td></tr>
+{{end}}</table>
+{{if .OverdueReviews}}<h3>Overdue reviews</h3>
+<table>
+<tr><th>Pull request</th><th>Author</th><th>Reviewer</th><th>Assigned</th></tr>
+{{range .OverdueReviews}}<tr><td>{{.Repository}}/{{.PullRequestID}} {{.PullRequestName}}</td><td>{{.AuthorID}}</td><td>{{.ReviewerID}}</td><td>{{.AssignedAt.UTC.Format "2006-01-02 15:04"}}</td></tr>
+{{end}}</table>
+{{else}}<p>No overdue reviews.</p>
+{{end}}{{end}}</body>
+</html>
+`))
+
+// RenderReviewDigest возвращает тему, текстовую и HTML-версии сводки ревью.
+func RenderReviewDigest(digest *models.ReviewDigest) (subject, text, html string, err error) {
+	var subjectBuf, textBuf, htmlBuf strings.Builder
+	if err := reviewDigestSubject.Execute(&subjectBuf, digest); err != nil {
+		return "", "", "", err
+	}
+	if err := reviewDigestText.Execute(&textBuf, digest); err != nil {
+		return "", "", "", err
+	}
+	if err := reviewDigestHTML.Execute(&htmlBuf, digest); err != nil {
+		return "", "", "", err
+	}
+	return subjectBuf.String(), textBuf.String(), htmlBuf.String(), nil
+}
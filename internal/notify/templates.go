@@ -0,0 +1,100 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Виды уведомлений.
+const (
+	KindAssignment   = "assignment"
+	KindReminder     = "reminder"
+	KindReassignment = "reassignment"
+)
+
+// TemplateData - данные шаблона уведомления. Unassigned отличает в reassignment снятого
+// ревьювера от назначенного вместо него, WaitingHours - время ожидания ответа в reminder.
+type TemplateData struct {
+	Repository      string
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	OldReviewerID   string
+	NewReviewerID   string
+	Unassigned      bool
+	WaitingHours    int
+}
+
+// DigestEntry - уведомление, отложенное до дайджеста.
+type DigestEntry struct {
+	Subject   string
+	Body      string
+	CreatedAt time.Time
+}
+
+type messageTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+func newMessageTemplate(name, subject, body string) messageTemplate {
+	return messageTemplate{
+		subject: template.Must(template.New(name + ".subject").Parse(subject)),
+		body:    template.Must(template.New(name + ".body").Parse(body)),
+	}
+}
+
+var templates = map[string]messageTemplate{
+	KindAssignment: newMessageTemplate(KindAssignment,
+		`Review requested: {{.PullRequestName}}`,
+		`You were assigned to review pull request {{.PullRequestID}} "{{.PullRequestName}}" in {{.Repository}} by {{.AuthorID}}.
+`),
+	KindReminder: newMessageTemplate(KindReminder,
+		`Reminder: {{.PullRequestName}} is waiting for your review`,
+		`Pull request {{.PullRequestID}} "{{.PullRequestName}}" in {{.Repository}} by {{.AuthorID}} has been waiting for your review for {{.WaitingHours}} h.
+`),
+	KindReassignment: newMessageTemplate(KindReassignment,
+		`{{if .Unassigned}}Review reassigned{{else}}Review requested{{end}}: {{.PullRequestName}}`,
+		`{{if .Unassigned}}You were removed from the review of pull request {{.PullRequestID}} "{{.PullRequestName}}" in {{.Repository}}; {{.NewReviewerID}} reviews it instead.
+{{else}}You were assigned to review pull request {{.PullRequestID}} "{{.PullRequestName}}" in {{.Repository}} by {{.AuthorID}} instead of {{.OldReviewerID}}.
+{{end}}`),
+}
+
+var digestTemplate = newMessageTemplate("digest",
+	`Review digest: {{len .Entries}} update(s)`,
+	`Hi {{.Username}},
+
+here is what happened since your last digest:
+{{range .Entries}}
+[{{.CreatedAt.Format "2006-01-02 15:04"}}] {{.Subject}}
+{{.Body}}{{end}}`)
+
+// Render возвращает тему и текст уведомления вида kind.
+func Render(kind string, data TemplateData) (subject, body string, err error) {
+	tmpl, ok := templates[kind]
+	if !ok {
+		return "", "", fmt.Errorf("unknown notification kind %q", kind)
+	}
+	return tmpl.render(data)
+}
+
+// RenderDigest собирает отложенные уведомления пользователя в одно сообщение.
+func RenderDigest(username string, entries []DigestEntry) (subject, body string, err error) {
+	return digestTemplate.render(struct {
+		Username string
+		Entries  []DigestEntry
+	}{username, entries})
+}
+
+func (t messageTemplate) render(data any) (string, string, error) {
+	var subject, body strings.Builder
+	if err := t.subject.Execute(&subject, data); err != nil {
+		return "", "", err
+	}
+	if err := t.body.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+	return subject.String(), body.String(), nil
+}
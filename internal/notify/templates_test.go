@@ -0,0 +1,106 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	data := TemplateData{
+		Repository:      "payments",
+		PullRequestID:   "pr-7",
+		PullRequestName: "Retry captures",
+		AuthorID:        "u1",
+		OldReviewerID:   "u2",
+		NewReviewerID:   "u3",
+		WaitingHours:    26,
+	}
+
+	subject, body, err := Render(KindAssignment, data)
+	require.NoError(t, err)
+	assert.Equal(t, "Review requested: Retry captures", subject)
+	assert.Equal(t, "You were assigned to review pull request pr-7 \"Retry captures\" in payments by u1.\n", body)
+
+	subject, body, err = Render(KindReminder, data)
+	require.NoError(t, err)
+	assert.Equal(t, "Reminder: Retry captures is waiting for your review", subject)
+	assert.Contains(t, body, "waiting for your review for 26 h")
+
+	subject, body, err = Render(KindReassignment, data)
+	require.NoError(t, err)
+	assert.Equal(t, "Review requested: Retry captures", subject)
+	assert.Contains(t, body, "instead of u2")
+
+	data.Unassigned = true
+	subject, body, err = Render(KindReassignment, data)
+	require.NoError(t, err)
+	assert.Equal(t, "Review reassigned: Retry captures", subject)
+	assert.Contains(t, body, "u3 reviews it instead")
+
+	_, _, err = Render("farewell", data)
+	assert.Error(t, err)
+}
+
+func TestRenderDigest(t *testing.T) {
+	at := time.Date(2025, 10, 20, 9, 30, 0, 0, time.UTC)
+
+	subject, body, err := RenderDigest("bob", []DigestEntry{
+		{Subject: "Review requested: A", Body: "Assigned to A.\n", CreatedAt: at},
+		{Subject: "Review requested: B", Body: "Assigned to B.\n", CreatedAt: at.Add(time.Hour)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Review digest: 2 update(s)", subject)
+	assert.Equal(t, "Hi bob,\n\nhere is what happened since your last digest:\n"+
+		"\n[2025-10-20 09:30] Review requested: A\nAssigned to A.\n"+
+		"\n[2025-10-20 10:30] Review requested: B\nAssigned to B.\n", body)
+}
+
+func TestRenderReviewDigest(t *testing.T) {
+	assignedAt := time.Date(2026, 10, 15, 9, 30, 0, 0, time.UTC)
+	digest := &models.ReviewDigest{
+		UserID:      "u2",
+		Username:    "bob",
+		GeneratedAt: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+		PendingReviews: []models.PendingReview{
+			{Repository: "payments", PullRequestID: "pr-7", PullRequestName: "Retry <captures>", AuthorID: "u1", WaitingHours: 96, Overdue: true},
+			{Repository: "payments", PullRequestID: "pr-9", PullRequestName: "Refunds", AuthorID: "u3", WaitingHours: 2},
+		},
+		Teams: []models.TeamDigest{{
+			TeamName: "backend",
+			Members: []models.MemberLoad{
+				{UserID: "u2", Username: "bob", IsActive: true, OpenReviews: 2},
+				{UserID: "u4", Username: "eve", OpenReviews: 0},
+			},
+			OverdueReviews: []models.ReviewAssignment{
+				{Repository: "payments", PullRequestID: "pr-7", PullRequestName: "Retry <captures>", AuthorID: "u1", ReviewerID: "u2", AssignedAt: assignedAt},
+			},
+		}},
+	}
+
+	subject, text, html, err := RenderReviewDigest(digest)
+	require.NoError(t, err)
+	assert.Equal(t, "Review digest: 2 pending review(s), 1 overdue in your teams", subject)
+
+	assert.Contains(t, text, "here is your review digest for 2026-10-19.")
+	assert.Contains(t, text, "  - [OVERDUE] payments/pr-7 \"Retry <captures>\" by u1, waiting 96 h\n  - payments/pr-9 \"Refunds\" by u3, waiting 2 h\n")
+	assert.Contains(t, text, "    - eve (u4): 0, inactive\n")
+	assert.Contains(t, text, "reviewer u2, assigned 2026-10-15 09:30\n")
+
+	assert.Contains(t, html, "<h2>Team backend</h2>")
+	assert.Contains(t, html, "Retry &lt;captures&gt; (overdue)")
+	assert.NotContains(t, html, "<captures>")
+
+	t.Run("nothing pending", func(t *testing.T) {
+		subject, text, html, err := RenderReviewDigest(&models.ReviewDigest{Username: "bob"})
+		require.NoError(t, err)
+		assert.Equal(t, "Review digest: 0 pending review(s)", subject)
+		assert.Contains(t, text, "You have no pending reviews.")
+		assert.Contains(t, html, "<p>You have no pending reviews.</p>")
+	})
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresCodeOwnersRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresCodeOwnersRepository(db *pgxpool.Pool) *PostgresCodeOwnersRepository {
+	return &PostgresCodeOwnersRepository{db: db}
+}
+
+// CreateCodeOwnersFile сохраняет файл как следующую версию для репозитория и заполняет
+// file.Version и file.CreatedAt. Загрузки в один репозиторий сериализуются advisory-блокировкой.
+func (r *PostgresCodeOwnersRepository) CreateCodeOwnersFile(ctx context.Context, file *models.CodeOwnersFile) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('code_owners:' || $1))`, file.Repository)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO code_owners_files (repository, version, content)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2
+		FROM code_owners_files
+		WHERE repository = $1
+		RETURNING version, created_at
+	`
+
+	err = tx.QueryRow(ctx, query, file.Repository, file.Content).Scan(&file.Version, &file.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetCodeOwnersFile возвращает версию version файла репозитория; version == 0 - последнюю.
+func (r *PostgresCodeOwnersRepository) GetCodeOwnersFile(ctx context.Context, repository string, version int) (*models.CodeOwnersFile, error) {
+	query := `
+		SELECT repository, version, content, created_at
+		FROM code_owners_files
+		WHERE repository = $1 AND ($2 = 0 OR version = $2)
+		ORDER BY version DESC
+		LIMIT 1
+	`
+
+	var file models.CodeOwnersFile
+	err := r.db.QueryRow(ctx, query, repository, version).Scan(&file.Repository, &file.Version, &file.Content, &file.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &file, nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresEventRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresEventRepository(db *pgxpool.Pool) *PostgresEventRepository {
+	return &PostgresEventRepository{db: db}
+}
+
+func (r *PostgresEventRepository) AddEvents(ctx context.Context, events []*models.Event) error {
+	query := `
+		INSERT INTO events (type, data)
+		VALUES ($1, $2)
+		RETURNING id, created_at
+	`
+
+	for _, event := range events {
+		data, err := json.Marshal(event.Data)
+		if err != nil {
+			return fmt.Errorf("failed to encode event data: %w", err)
+		}
+		err = conn(ctx, r.db).QueryRow(ctx, query, event.Type, data).Scan(&event.ID, &event.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetEventsAfter читает события в порядке фиксации: по (xid, id) и только из транзакций старше
+// самой старой незавершённой, поэтому событие не может появиться позади уже прочитанных.
+func (r *PostgresEventRepository) GetEventsAfter(ctx context.Context, afterID int64, limit int) ([]*models.Event, error) {
+	query := `
+		SELECT id, type, data, created_at
+		FROM events
+		WHERE xid < pg_snapshot_xmin(pg_current_snapshot())
+			AND (xid, id) > (COALESCE((SELECT xid FROM events WHERE id = $1), '0'::xid8), $1)
+		ORDER BY xid, id
+		LIMIT $2
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.Event
+	for rows.Next() {
+		var event models.Event
+		var data []byte
+		if err := rows.Scan(&event.ID, &event.Type, &data, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &event.Data); err != nil {
+			return nil, fmt.Errorf("failed to decode event data: %w", err)
+		}
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *PostgresEventRepository) GetLastEventID(ctx context.Context) (int64, error) {
+	query := `
+		SELECT COALESCE((
+			SELECT id
+			FROM events
+			WHERE xid < pg_snapshot_xmin(pg_current_snapshot())
+			ORDER BY xid DESC, id DESC
+			LIMIT 1
+		), 0)
+	`
+
+	var id int64
+	err := conn(ctx, r.db).QueryRow(ctx, query).Scan(&id)
+	return id, err
+}
+
+func (r *PostgresEventRepository) GetEventCursor(ctx context.Context, name string) (*int64, error) {
+	var id int64
+	err := conn(ctx, r.db).QueryRow(ctx, `SELECT last_event_id FROM event_cursors WHERE name = $1`, name).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (r *PostgresEventRepository) SetEventCursor(ctx context.Context, name string, lastEventID int64) error {
+	query := `
+		INSERT INTO event_cursors (name, last_event_id)
+		VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET last_event_id = EXCLUDED.last_event_id, updated_at = CURRENT_TIMESTAMP
+	`
+
+	_, err := conn(ctx, r.db).Exec(ctx, query, name, lastEventID)
+	return err
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresGitIdentityRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresGitIdentityRepository(db *pgxpool.Pool) *PostgresGitIdentityRepository {
+	return &PostgresGitIdentityRepository{db: db}
+}
+
+func (r *PostgresGitIdentityRepository) SetGitIdentity(ctx context.Context, identity *models.GitIdentity) error {
+	query := `
+		INSERT INTO git_identities (provider, username, user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (provider, username) DO UPDATE SET
+			user_id = EXCLUDED.user_id,
+			created_at = CURRENT_TIMESTAMP
+		RETURNING created_at
+	`
+
+	identity.Username = strings.ToLower(identity.Username)
+	return r.db.QueryRow(ctx, query, identity.Provider, identity.Username, identity.UserID).Scan(&identity.CreatedAt)
+}
+
+func (r *PostgresGitIdentityRepository) DeleteGitIdentity(ctx context.Context, provider, username string) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM git_identities WHERE provider = $1 AND username = $2`, provider, strings.ToLower(username))
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *PostgresGitIdentityRepository) GetGitIdentities(ctx context.Context, provider string) ([]*models.GitIdentity, error) {
+	query := `
+		SELECT provider, username, user_id, created_at
+		FROM git_identities
+		WHERE $1::text = '' OR provider = $1
+		ORDER BY provider, username
+	`
+
+	rows, err := r.db.Query(ctx, query, provider)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var identities []*models.GitIdentity
+	for rows.Next() {
+		var identity models.GitIdentity
+		if err := rows.Scan(&identity.Provider, &identity.Username, &identity.UserID, &identity.CreatedAt); err != nil {
+			return nil, err
+		}
+		identities = append(identities, &identity)
+	}
+
+	return identities, rows.Err()
+}
+
+func (r *PostgresGitIdentityRepository) GetUserIDByGitUsername(ctx context.Context, provider, username string) (string, error) {
+	query := `SELECT user_id FROM git_identities WHERE provider = $1 AND username = $2`
+
+	var userID string
+	err := r.db.QueryRow(ctx, query, provider, strings.ToLower(username)).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return userID, nil
+}
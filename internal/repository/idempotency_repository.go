@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// idempotencyReservationLease - сколько держится незавершённая резервация ключа. Если процесс
+// упал, не успев сохранить ответ, повтор с тем же ключом сможет занять его после аренды, а не после TTL.
+const idempotencyReservationLease = 5 * time.Minute
+
+type PostgresIdempotencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresIdempotencyRepository(db *pgxpool.Pool) *PostgresIdempotencyRepository {
+	return &PostgresIdempotencyRepository{db: db}
+}
+
+func (r *PostgresIdempotencyRepository) ReserveIdempotencyKey(ctx context.Context, record *models.IdempotencyRecord) (bool, error) {
+	// Истёкшая запись перезаписывается, поэтому ключ можно переиспользовать после TTL.
+	// Брошенная резервация (status_code = 0) перехватывается по истечении аренды.
+	query := `
+		INSERT INTO idempotency_keys (idempotency_key, request_path, request_hash, status_code, response_body, created_at, expires_at)
+		VALUES ($1, $2, $3, 0, NULL, $4, $5)
+		ON CONFLICT (idempotency_key, request_path) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = 0,
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+			OR (idempotency_keys.status_code = 0 AND idempotency_keys.created_at <= $6)
+		RETURNING idempotency_key
+	`
+
+	now := time.Now()
+	record.CreatedAt = now
+
+	var key string
+	err := r.db.QueryRow(ctx, query, record.Key, record.RequestPath, record.RequestHash, record.CreatedAt, record.ExpiresAt,
+		now.Add(-idempotencyReservationLease)).Scan(&key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *PostgresIdempotencyRepository) GetIdempotencyRecord(ctx context.Context, key, requestPath string) (*models.IdempotencyRecord, error) {
+	query := `
+		SELECT idempotency_key, request_path, request_hash, status_code, response_body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE idempotency_key = $1 AND request_path = $2
+	`
+
+	var record models.IdempotencyRecord
+	err := r.db.QueryRow(ctx, query, key, requestPath).Scan(
+		&record.Key, &record.RequestPath, &record.RequestHash, &record.StatusCode,
+		&record.ResponseBody, &record.CreatedAt, &record.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (r *PostgresIdempotencyRepository) CompleteIdempotencyKey(ctx context.Context, record *models.IdempotencyRecord) error {
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $3, response_body = $4
+		WHERE idempotency_key = $1 AND request_path = $2
+	`
+
+	result, err := r.db.Exec(ctx, query, record.Key, record.RequestPath, record.StatusCode, record.ResponseBody)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *PostgresIdempotencyRepository) DeleteIdempotencyKey(ctx context.Context, key, requestPath string) error {
+	query := `DELETE FROM idempotency_keys WHERE idempotency_key = $1 AND request_path = $2`
+
+	_, err := r.db.Exec(ctx, query, key, requestPath)
+	return err
+}
+
+func (r *PostgresIdempotencyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+
+	result, err := r.db.Exec(ctx, query, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresNotificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresNotificationRepository(db *pgxpool.Pool) *PostgresNotificationRepository {
+	return &PostgresNotificationRepository{db: db}
+}
+
+func (r *PostgresNotificationRepository) SetNotificationPreferences(ctx context.Context, prefs *models.NotificationPreferences) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, mode, channel, email)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		ON CONFLICT (user_id) DO UPDATE
+		SET mode = EXCLUDED.mode, channel = EXCLUDED.channel, email = EXCLUDED.email, updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at
+	`
+
+	return r.db.QueryRow(ctx, query, prefs.UserID, prefs.Mode, prefs.Channel, prefs.Email).Scan(&prefs.UpdatedAt)
+}
+
+func (r *PostgresNotificationRepository) GetNotificationPreferences(ctx context.Context, userID string) (*models.NotificationPreferences, error) {
+	query := `
+		SELECT user_id, mode, channel, COALESCE(email, ''), updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+
+	var prefs models.NotificationPreferences
+	err := r.db.QueryRow(ctx, query, userID).Scan(&prefs.UserID, &prefs.Mode, &prefs.Channel, &prefs.Email, &prefs.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &prefs, nil
+}
+
+func (r *PostgresNotificationRepository) AddDigestItem(ctx context.Context, item *models.DigestItem) error {
+	query := `
+		INSERT INTO notification_digest_items (user_id, kind, subject, body)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRow(ctx, query, item.UserID, item.Kind, item.Subject, item.Body).Scan(&item.ID, &item.CreatedAt)
+}
+
+func (r *PostgresNotificationRepository) GetDigestItems(ctx context.Context) ([]*models.DigestItem, error) {
+	query := `
+		SELECT id, user_id, kind, subject, body, created_at
+		FROM notification_digest_items
+		ORDER BY user_id, id
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*models.DigestItem
+	for rows.Next() {
+		var item models.DigestItem
+		err := rows.Scan(&item.ID, &item.UserID, &item.Kind, &item.Subject, &item.Body, &item.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+
+	return items, rows.Err()
+}
+
+func (r *PostgresNotificationRepository) DeleteDigestItems(ctx context.Context, ids []int64) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM notification_digest_items WHERE id = ANY($1)`, ids)
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"pr-reviewer-assignment-service/internal/models"
@@ -21,15 +22,17 @@ func NewPostgresPullRequestRepository(db *pgxpool.Pool) *PostgresPullRequestRepo
 }
 
 func (r *PostgresPullRequestRepository) CreatePullRequest(ctx context.Context, pr *models.PullRequest) error {
-	tx, err := r.db.Begin(ctx)
+	tx, err := conn(ctx, r.db).Begin(ctx)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
 	prQuery := `
-		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, merged_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, merged_at, skill_match, repository,
+			url, description, lines_added, lines_removed, files_changed, priority, draft)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE(NULLIF($7, ''), 'prefer'), $8,
+			NULLIF($9, ''), NULLIF($10, ''), $11, $12, $13, COALESCE(NULLIF($14, ''), 'normal'), $15)
 	`
 
 	now := time.Now()
@@ -37,13 +40,58 @@ func (r *PostgresPullRequestRepository) CreatePullRequest(ctx context.Context, p
 		pr.CreatedAt = &now
 	}
 
-	_, err = tx.Exec(ctx, prQuery, pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, pr.CreatedAt, pr.MergedAt)
+	_, err = tx.Exec(ctx, prQuery, pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, pr.CreatedAt, pr.MergedAt, pr.SkillMatch, pr.Repository,
+		pr.URL, pr.Description, pr.LinesAdded, pr.LinesRemoved, pr.FilesChanged, pr.Priority, pr.Draft)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
 		return err
 	}
 
+	if len(pr.RequiredSkills) > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO pr_required_skills (repository, pull_request_id, skill)
+			SELECT $1, $2, unnest($3::text[])
+		`, pr.Repository, pr.PullRequestID, pr.RequiredSkills)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(pr.Labels) > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO pr_labels (repository, pull_request_id, label)
+			SELECT $1, $2, unnest($3::text[])
+		`, pr.Repository, pr.PullRequestID, pr.Labels)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(pr.ExcludedReviewers) > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO pr_excluded_reviewers (repository, pull_request_id, user_id)
+			SELECT $1, $2, unnest($3::text[])
+		`, pr.Repository, pr.PullRequestID, pr.ExcludedReviewers)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(pr.ChangedFiles) > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO pr_changed_files (repository, pull_request_id, path)
+			SELECT $1, $2, unnest($3::text[])
+			ON CONFLICT DO NOTHING
+		`, pr.Repository, pr.PullRequestID, pr.ChangedFiles)
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(pr.AssignedReviewers) > 0 {
-		err = r.setAssignedReviewersInTx(ctx, tx, pr.PullRequestID, pr.AssignedReviewers)
+		err = r.setAssignedReviewersInTx(ctx, tx, pr.Repository, pr.PullRequestID, pr.AssignedReviewers)
 		if err != nil {
 			return err
 		}
@@ -52,18 +100,14 @@ func (r *PostgresPullRequestRepository) CreatePullRequest(ctx context.Context, p
 	return tx.Commit(ctx)
 }
 
-func (r *PostgresPullRequestRepository) GetPullRequestByID(ctx context.Context, prID string) (*models.PullRequest, error) {
+func (r *PostgresPullRequestRepository) GetPullRequestByID(ctx context.Context, repository, prID string) (*models.PullRequest, error) {
 	query := `
-		SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+		SELECT ` + pullRequestColumns + `
 		FROM pull_requests pr
-		WHERE pr.pull_request_id = $1
+		WHERE pr.repository = $1 AND pr.pull_request_id = $2
 	`
 
-	var pr models.PullRequest
-	var createdAt, mergedAt sql.NullTime
-
-	err := r.db.QueryRow(ctx, query, prID).Scan(
-		&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &createdAt, &mergedAt)
+	pr, err := scanPullRequest(conn(ctx, r.db).QueryRow(ctx, query, repository, prID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -71,30 +115,23 @@ func (r *PostgresPullRequestRepository) GetPullRequestByID(ctx context.Context,
 		return nil, err
 	}
 
-	if createdAt.Valid {
-		pr.CreatedAt = &createdAt.Time
-	}
-	if mergedAt.Valid {
-		pr.MergedAt = &mergedAt.Time
-	}
-
-	reviewers, err := r.GetAssignedReviewers(ctx, prID)
+	reviewers, err := r.GetAssignedReviewers(ctx, repository, prID)
 	if err != nil {
 		return nil, err
 	}
 	pr.AssignedReviewers = reviewers
 
-	return &pr, nil
+	return pr, nil
 }
 
 func (r *PostgresPullRequestRepository) UpdatePullRequest(ctx context.Context, pr *models.PullRequest) error {
 	query := `
 		UPDATE pull_requests
-		SET pull_request_name = $2, author_id = $3, status = $4, merged_at = $5
-		WHERE pull_request_id = $1
+		SET pull_request_name = $3, author_id = $4, status = $5, merged_at = $6
+		WHERE repository = $1 AND pull_request_id = $2
 	`
 
-	result, err := r.db.Exec(ctx, query, pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, pr.MergedAt)
+	result, err := conn(ctx, r.db).Exec(ctx, query, pr.Repository, pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, pr.MergedAt)
 	if err != nil {
 		return err
 	}
@@ -106,10 +143,10 @@ func (r *PostgresPullRequestRepository) UpdatePullRequest(ctx context.Context, p
 	return nil
 }
 
-func (r *PostgresPullRequestRepository) DeletePullRequest(ctx context.Context, prID string) error {
-	query := `DELETE FROM pull_requests WHERE pull_request_id = $1`
+func (r *PostgresPullRequestRepository) DeletePullRequest(ctx context.Context, repository, prID string) error {
+	query := `DELETE FROM pull_requests WHERE repository = $1 AND pull_request_id = $2`
 
-	result, err := r.db.Exec(ctx, query, prID)
+	result, err := conn(ctx, r.db).Exec(ctx, query, repository, prID)
 	if err != nil {
 		return err
 	}
@@ -121,16 +158,30 @@ func (r *PostgresPullRequestRepository) DeletePullRequest(ctx context.Context, p
 	return nil
 }
 
-func (r *PostgresPullRequestRepository) GetPullRequestsByReviewer(ctx context.Context, userID string) ([]*models.PullRequestShort, error) {
-	query := `
-		SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
-		FROM pull_requests pr
-		JOIN pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id
-		WHERE prr.user_id = $1
-		ORDER BY pr.created_at DESC
-	`
+// GetPullRequestsByReviewer возвращает PR ревьювера в порядке (created_at, pull_request_id, repository) по убыванию.
+func (r *PostgresPullRequestRepository) GetPullRequestsByReviewer(ctx context.Context, userID string, filter models.ReviewFilter) ([]*models.PullRequestShort, error) {
+	var where whereBuilder
+	where.add("prr.user_id = ?", userID)
+	if filter.Status != "" && filter.Status != models.PRStatusAll {
+		where.add("pr.status = ?", filter.Status)
+	}
+	if filter.Cursor != nil {
+		where.add("(pr.created_at, pr.pull_request_id, pr.repository) < (?, ?, ?)", filter.Cursor.CreatedAt, filter.Cursor.ID, filter.Cursor.Repository)
+	}
 
-	rows, err := r.db.Query(ctx, query, userID)
+	query := fmt.Sprintf(`
+		SELECT pr.repository, pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at,
+			prr.assigned_at, prr.responded_at, COALESCE(prr.verdict, ''), t.sla_first_response_hours, t.sla_completion_hours
+		FROM pull_requests pr
+		JOIN pr_reviewers prr ON prr.repository = pr.repository AND prr.pull_request_id = pr.pull_request_id
+		LEFT JOIN users author ON author.user_id = pr.author_id
+		LEFT JOIN teams t ON t.team_name = author.team_name
+		%s
+		ORDER BY pr.created_at DESC, pr.pull_request_id DESC, pr.repository DESC
+		LIMIT %s
+	`, where.sql(), where.arg(filter.Limit))
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, where.args...)
 	if err != nil {
 		return nil, err
 	}
@@ -139,58 +190,376 @@ func (r *PostgresPullRequestRepository) GetPullRequestsByReviewer(ctx context.Co
 	var prs []*models.PullRequestShort
 	for rows.Next() {
 		var pr models.PullRequestShort
-		err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status)
+		var createdAt sql.NullTime
+		err := rows.Scan(&pr.Repository, &pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &createdAt,
+			&pr.AssignedAt, &pr.RespondedAt, &pr.Verdict, &pr.TeamSLA.FirstResponseHours, &pr.TeamSLA.CompletionHours)
 		if err != nil {
 			return nil, err
 		}
+		if createdAt.Valid {
+			pr.CreatedAt = &createdAt.Time
+		}
 		prs = append(prs, &pr)
 	}
 
 	return prs, rows.Err()
 }
 
-func (r *PostgresPullRequestRepository) MergePullRequest(ctx context.Context, prID string) error {
+func (r *PostgresPullRequestRepository) CountPullRequestsByReviewer(ctx context.Context, userID string, status string) (int, error) {
+	var where whereBuilder
+	where.add("prr.user_id = ?", userID)
+	if status != "" && status != models.PRStatusAll {
+		where.add("pr.status = ?", status)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM pull_requests pr
+		JOIN pr_reviewers prr ON prr.repository = pr.repository AND prr.pull_request_id = pr.pull_request_id
+		%s
+	`, where.sql())
+
+	var count int
+	err := conn(ctx, r.db).QueryRow(ctx, query, where.args...).Scan(&count)
+	return count, err
+}
+
+func (r *PostgresPullRequestRepository) GetOpenPullRequestRefsByReviewer(ctx context.Context, userID string) ([]models.PullRequestRef, error) {
+	query := `
+		SELECT pr.repository, pr.pull_request_id
+		FROM pull_requests pr
+		JOIN pr_reviewers prr ON prr.repository = pr.repository AND prr.pull_request_id = pr.pull_request_id
+		WHERE prr.user_id = $1 AND pr.status = 'OPEN'
+		ORDER BY pr.created_at, pr.pull_request_id, pr.repository
+	`
+
+	return r.queryPullRequestRefs(ctx, query, userID)
+}
+
+// GetOpenPullRequestRefsByTeam возвращает открытые PR, автором или ревьювером которых является участник команды.
+func (r *PostgresPullRequestRepository) GetOpenPullRequestRefsByTeam(ctx context.Context, teamName string) ([]models.PullRequestRef, error) {
+	query := `
+		SELECT pr.repository, pr.pull_request_id
+		FROM pull_requests pr
+		WHERE pr.status = 'OPEN' AND (
+			EXISTS (SELECT 1 FROM user_teams ut WHERE ut.user_id = pr.author_id AND ut.team_name = $1)
+			OR EXISTS (
+				SELECT 1 FROM pr_reviewers prr
+				JOIN user_teams ut ON ut.user_id = prr.user_id
+				WHERE prr.repository = pr.repository AND prr.pull_request_id = pr.pull_request_id AND ut.team_name = $1
+			)
+		)
+		ORDER BY pr.created_at, pr.pull_request_id, pr.repository
+	`
+
+	return r.queryPullRequestRefs(ctx, query, teamName)
+}
+
+func (r *PostgresPullRequestRepository) queryPullRequestRefs(ctx context.Context, query string, args ...any) ([]models.PullRequestRef, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var refs []models.PullRequestRef
+	for rows.Next() {
+		var ref models.PullRequestRef
+		if err := rows.Scan(&ref.Repository, &ref.PullRequestID); err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+
+	return refs, rows.Err()
+}
+
+func (r *PostgresPullRequestRepository) GetOpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	query := `
+		SELECT prr.user_id, COUNT(*)
+		FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.repository = prr.repository AND pr.pull_request_id = prr.pull_request_id
+		WHERE prr.user_id = ANY($1) AND pr.status = 'OPEN'
+		GROUP BY prr.user_id
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, err
+		}
+		counts[userID] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// GetRecentPairings возвращает ревьюверов последних limit PR автора, созданных не раньше since.
+func (r *PostgresPullRequestRepository) GetRecentPairings(ctx context.Context, authorID string, limit int, since time.Time) ([]models.ReviewPairing, error) {
+	query := `
+		SELECT recent.author_id, prr.user_id, recent.created_at
+		FROM (
+			SELECT repository, pull_request_id, author_id, created_at
+			FROM pull_requests
+			WHERE author_id = $1 AND created_at >= $3
+			ORDER BY created_at DESC, pull_request_id DESC, repository DESC
+			LIMIT $2
+		) recent
+		JOIN pr_reviewers prr ON prr.repository = recent.repository AND prr.pull_request_id = recent.pull_request_id
+		ORDER BY recent.created_at DESC, prr.user_id
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, authorID, limit, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pairings []models.ReviewPairing
+	for rows.Next() {
+		var pairing models.ReviewPairing
+		if err := rows.Scan(&pairing.AuthorID, &pairing.ReviewerID, &pairing.CreatedAt); err != nil {
+			return nil, err
+		}
+		pairings = append(pairings, pairing)
+	}
+
+	return pairings, rows.Err()
+}
+
+// ListPullRequests возвращает до filter.Limit PR, подходящих под фильтр, начиная после filter.Cursor.
+func (r *PostgresPullRequestRepository) ListPullRequests(ctx context.Context, filter models.PullRequestFilter) ([]*models.PullRequest, error) {
+	var where whereBuilder
+
+	if filter.Repository != "" {
+		where.add("pr.repository = ?", filter.Repository)
+	}
+	if filter.Status != "" {
+		where.add("pr.status = ?", filter.Status)
+	}
+	if filter.AuthorID != "" {
+		where.add("pr.author_id = ?", filter.AuthorID)
+	}
+	if filter.TeamName != "" {
+		where.add("author.team_name = ?", filter.TeamName)
+	}
+	if filter.ReviewerID != "" {
+		where.add("EXISTS (SELECT 1 FROM pr_reviewers prr WHERE prr.repository = pr.repository AND prr.pull_request_id = pr.pull_request_id AND prr.user_id = ?)", filter.ReviewerID)
+	}
+	if filter.Label != "" {
+		where.add("EXISTS (SELECT 1 FROM pr_labels l WHERE l.repository = pr.repository AND l.pull_request_id = pr.pull_request_id AND l.label = ?)", filter.Label)
+	}
+	if filter.Priority != "" {
+		where.add("pr.priority = ?", filter.Priority)
+	}
+	if filter.CreatedFrom != nil {
+		where.add("pr.created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		where.add("pr.created_at < ?", *filter.CreatedTo)
+	}
+	if filter.MergedFrom != nil {
+		where.add("pr.merged_at >= ?", *filter.MergedFrom)
+	}
+	if filter.MergedTo != nil {
+		where.add("pr.merged_at < ?", *filter.MergedTo)
+	}
+
+	direction, cmp := "ASC", ">"
+	if filter.SortOrder == models.SortOrderDesc {
+		direction, cmp = "DESC", "<"
+	}
+
+	orderBy := fmt.Sprintf("pr.created_at %[1]s, pr.pull_request_id %[1]s, pr.repository %[1]s", direction)
+	if filter.SortBy == models.PRSortByID {
+		orderBy = fmt.Sprintf("pr.pull_request_id %[1]s, pr.repository %[1]s", direction)
+	}
+
+	if filter.Cursor != nil {
+		if filter.SortBy == models.PRSortByID {
+			where.add("(pr.pull_request_id, pr.repository) "+cmp+" (?, ?)", filter.Cursor.ID, filter.Cursor.Repository)
+		} else {
+			where.add("(pr.created_at, pr.pull_request_id, pr.repository) "+cmp+" (?, ?, ?)", filter.Cursor.CreatedAt, filter.Cursor.ID, filter.Cursor.Repository)
+		}
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM pull_requests pr
+		JOIN users author ON author.user_id = pr.author_id
+		%s
+		ORDER BY %s
+		LIMIT %s
+	`, pullRequestColumns, where.sql(), orderBy, where.arg(filter.Limit))
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []*models.PullRequest
+	for rows.Next() {
+		pr, err := scanPullRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		prs = append(prs, pr)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	reviewers, err := r.getReviewersForPullRequests(ctx, prs)
+	if err != nil {
+		return nil, err
+	}
+	for _, pr := range prs {
+		pr.AssignedReviewers = reviewers[models.PullRequestRef{Repository: pr.Repository, PullRequestID: pr.PullRequestID}]
+	}
+
+	return prs, nil
+}
+
+// pullRequestColumns - колонки PR в порядке, который ожидает scanPullRequest.
+const pullRequestColumns = `pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at,
+	pr.skill_match, ARRAY(SELECT s.skill FROM pr_required_skills s WHERE s.repository = pr.repository AND s.pull_request_id = pr.pull_request_id ORDER BY s.skill),
+	pr.repository, ARRAY(SELECT f.path FROM pr_changed_files f WHERE f.repository = pr.repository AND f.pull_request_id = pr.pull_request_id ORDER BY f.path),
+	COALESCE(pr.url, ''), COALESCE(pr.description, ''), pr.lines_added, pr.lines_removed, pr.files_changed, pr.priority,
+	ARRAY(SELECT l.label FROM pr_labels l WHERE l.repository = pr.repository AND l.pull_request_id = pr.pull_request_id ORDER BY l.label),
+	ARRAY(SELECT e.user_id FROM pr_excluded_reviewers e WHERE e.repository = pr.repository AND e.pull_request_id = pr.pull_request_id ORDER BY e.user_id),
+	(SELECT t.sla_completion_hours FROM users u JOIN teams t ON t.team_name = u.team_name WHERE u.user_id = pr.author_id),
+	pr.draft`
+
+func scanPullRequest(row pgx.Row) (*models.PullRequest, error) {
+	var pr models.PullRequest
+	var createdAt, mergedAt sql.NullTime
+
+	err := row.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &createdAt, &mergedAt,
+		&pr.SkillMatch, &pr.RequiredSkills, &pr.Repository, &pr.ChangedFiles,
+		&pr.URL, &pr.Description, &pr.LinesAdded, &pr.LinesRemoved, &pr.FilesChanged, &pr.Priority, &pr.Labels, &pr.ExcludedReviewers,
+		&pr.CompletionSLAHours, &pr.Draft)
+	if err != nil {
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		pr.CreatedAt = &createdAt.Time
+	}
+	if mergedAt.Valid {
+		pr.MergedAt = &mergedAt.Time
+	}
+
+	return &pr, nil
+}
+
+func (r *PostgresPullRequestRepository) getReviewersForPullRequests(ctx context.Context, prs []*models.PullRequest) (map[models.PullRequestRef][]string, error) {
+	reviewers := make(map[models.PullRequestRef][]string, len(prs))
+	if len(prs) == 0 {
+		return reviewers, nil
+	}
+
+	repositories := make([]string, len(prs))
+	prIDs := make([]string, len(prs))
+	for i, pr := range prs {
+		repositories[i], prIDs[i] = pr.Repository, pr.PullRequestID
+	}
+
+	query := `
+		SELECT prr.repository, prr.pull_request_id, prr.user_id
+		FROM pr_reviewers prr
+		JOIN unnest($1::text[], $2::text[]) AS k(repository, pull_request_id)
+			ON k.repository = prr.repository AND k.pull_request_id = prr.pull_request_id
+		ORDER BY prr.assigned_at
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, repositories, prIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ref models.PullRequestRef
+		var userID string
+		err := rows.Scan(&ref.Repository, &ref.PullRequestID, &userID)
+		if err != nil {
+			return nil, err
+		}
+		reviewers[ref] = append(reviewers[ref], userID)
+	}
+
+	return reviewers, rows.Err()
+}
+
+// SetPullRequestReady снимает с PR отметку черновика. Возвращает false, если PR не черновик.
+func (r *PostgresPullRequestRepository) SetPullRequestReady(ctx context.Context, repository, prID string) (bool, error) {
 	query := `
 		UPDATE pull_requests
-		SET status = 'MERGED', merged_at = $2
-		WHERE pull_request_id = $1 AND status = 'OPEN'
+		SET draft = FALSE
+		WHERE repository = $1 AND pull_request_id = $2 AND draft
 	`
 
-	result, err := r.db.Exec(ctx, query, prID, time.Now())
+	result, err := conn(ctx, r.db).Exec(ctx, query, repository, prID)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// MergePullRequest переводит открытый PR в MERGED. Возвращает false, если PR уже был смержен.
+func (r *PostgresPullRequestRepository) MergePullRequest(ctx context.Context, repository, prID string) (bool, error) {
+	query := `
+		UPDATE pull_requests
+		SET status = 'MERGED', merged_at = $3
+		WHERE repository = $1 AND pull_request_id = $2 AND status = 'OPEN'
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, repository, prID, time.Now())
+	if err != nil {
+		return false, err
 	}
 
 	if result.RowsAffected() == 0 {
-		exists, err := r.PullRequestExists(ctx, prID)
+		exists, err := r.PullRequestExists(ctx, repository, prID)
 		if err != nil {
-			return err
+			return false, err
 		}
 		if !exists {
-			return sql.ErrNoRows
+			return false, sql.ErrNoRows
 		}
+		return false, nil
 	}
 
-	return nil
+	return true, nil
 }
 
-func (r *PostgresPullRequestRepository) PullRequestExists(ctx context.Context, prID string) (bool, error) {
-	query := `SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id = $1)`
+func (r *PostgresPullRequestRepository) PullRequestExists(ctx context.Context, repository, prID string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM pull_requests WHERE repository = $1 AND pull_request_id = $2)`
 
 	var exists bool
-	err := r.db.QueryRow(ctx, query, prID).Scan(&exists)
+	err := conn(ctx, r.db).QueryRow(ctx, query, repository, prID).Scan(&exists)
 	return exists, err
 }
 
-func (r *PostgresPullRequestRepository) GetAssignedReviewers(ctx context.Context, prID string) ([]string, error) {
+func (r *PostgresPullRequestRepository) GetAssignedReviewers(ctx context.Context, repository, prID string) ([]string, error) {
 	query := `
 		SELECT user_id
 		FROM pr_reviewers
-		WHERE pull_request_id = $1
+		WHERE repository = $1 AND pull_request_id = $2
 		ORDER BY assigned_at
 	`
 
-	rows, err := r.db.Query(ctx, query, prID)
+	rows, err := conn(ctx, r.db).Query(ctx, query, repository, prID)
 	if err != nil {
 		return nil, err
 	}
@@ -209,14 +578,14 @@ func (r *PostgresPullRequestRepository) GetAssignedReviewers(ctx context.Context
 	return reviewers, rows.Err()
 }
 
-func (r *PostgresPullRequestRepository) SetAssignedReviewers(ctx context.Context, prID string, reviewers []string) error {
-	tx, err := r.db.Begin(ctx)
+func (r *PostgresPullRequestRepository) SetAssignedReviewers(ctx context.Context, repository, prID string, reviewers []string) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
-	err = r.setAssignedReviewersInTx(ctx, tx, prID, reviewers)
+	err = r.setAssignedReviewersInTx(ctx, tx, repository, prID, reviewers)
 	if err != nil {
 		return err
 	}
@@ -224,22 +593,26 @@ func (r *PostgresPullRequestRepository) SetAssignedReviewers(ctx context.Context
 	return tx.Commit(ctx)
 }
 
-func (r *PostgresPullRequestRepository) setAssignedReviewersInTx(ctx context.Context, tx pgx.Tx, prID string, reviewers []string) error {
-	deleteQuery := `DELETE FROM pr_reviewers WHERE pull_request_id = $1`
-	_, err := tx.Exec(ctx, deleteQuery, prID)
+func (r *PostgresPullRequestRepository) setAssignedReviewersInTx(ctx context.Context, tx pgx.Tx, repository, prID string, reviewers []string) error {
+	deleteQuery := `
+		DELETE FROM pr_reviewers
+		WHERE repository = $1 AND pull_request_id = $2 AND user_id <> ALL(COALESCE($3::text[], '{}'))
+	`
+	_, err := tx.Exec(ctx, deleteQuery, repository, prID, reviewers)
 	if err != nil {
 		return err
 	}
 
 	if len(reviewers) > 0 {
 		insertQuery := `
-			INSERT INTO pr_reviewers (pull_request_id, user_id, assigned_at)
-			VALUES ($1, $2, $3)
+			INSERT INTO pr_reviewers (repository, pull_request_id, user_id, assigned_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (repository, pull_request_id, user_id) DO NOTHING
 		`
 
 		now := time.Now()
 		for _, reviewerID := range reviewers {
-			_, err = tx.Exec(ctx, insertQuery, prID, reviewerID, now)
+			_, err = tx.Exec(ctx, insertQuery, repository, prID, reviewerID, now)
 			if err != nil {
 				return err
 			}
@@ -249,6 +622,143 @@ func (r *PostgresPullRequestRepository) setAssignedReviewersInTx(ctx context.Con
 	return nil
 }
 
+func (r *PostgresPullRequestRepository) SetReviewResponded(ctx context.Context, repository, prID, userID string, at time.Time, verdict string) error {
+	query := `
+		UPDATE pr_reviewers
+		SET responded_at = COALESCE(responded_at, $4),
+			verdict = COALESCE(NULLIF($5, ''), verdict)
+		WHERE repository = $1 AND pull_request_id = $2 AND user_id = $3
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, repository, prID, userID, at, verdict)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *PostgresPullRequestRepository) SetReviewReminded(ctx context.Context, repository, prID, userID string, at time.Time) error {
+	query := `
+		UPDATE pr_reviewers
+		SET reminded_at = $4
+		WHERE repository = $1 AND pull_request_id = $2 AND user_id = $3
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, repository, prID, userID, at)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetReviewAssignments возвращает назначения в порядке (assigned_at, repository, pull_request_id, user_id).
+// filter.TeamName ограничивает выборку PR авторов с этой основной командой.
+func (r *PostgresPullRequestRepository) GetReviewAssignments(ctx context.Context, filter models.ReviewAssignmentFilter) ([]*models.ReviewAssignment, error) {
+	var where whereBuilder
+	if filter.TeamName != "" {
+		where.add("author.team_name = ?", filter.TeamName)
+	}
+	if filter.ReviewerID != "" {
+		where.add("prr.user_id = ?", filter.ReviewerID)
+	}
+	if filter.ReviewerIDs != nil {
+		where.add("prr.user_id = ANY(?)", filter.ReviewerIDs)
+	}
+	if filter.Repository != "" {
+		where.add("pr.repository = ?", filter.Repository)
+	}
+	if filter.PullRequestID != "" {
+		where.add("pr.pull_request_id = ?", filter.PullRequestID)
+	}
+	if filter.OpenOnly {
+		where.add("pr.status = ?", models.PRStatusOpen)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT pr.repository, pr.pull_request_id, pr.pull_request_name, pr.author_id, prr.user_id, COALESCE(author.team_name, ''),
+			pr.status, COALESCE(prr.assigned_at, pr.created_at), prr.responded_at, COALESCE(prr.verdict, ''), t.sla_first_response_hours, t.sla_completion_hours,
+			prr.reminded_at
+		FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.repository = prr.repository AND pr.pull_request_id = prr.pull_request_id
+		LEFT JOIN users author ON author.user_id = pr.author_id
+		LEFT JOIN teams t ON t.team_name = author.team_name
+		%s
+		ORDER BY prr.assigned_at, pr.repository, pr.pull_request_id, prr.user_id
+	`, where.sql())
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*models.ReviewAssignment
+	for rows.Next() {
+		var a models.ReviewAssignment
+		err := rows.Scan(&a.Repository, &a.PullRequestID, &a.PullRequestName, &a.AuthorID, &a.ReviewerID, &a.TeamName,
+			&a.Status, &a.AssignedAt, &a.RespondedAt, &a.Verdict, &a.TeamSLA.FirstResponseHours, &a.TeamSLA.CompletionHours, &a.RemindedAt)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, &a)
+	}
+
+	return assignments, rows.Err()
+}
+
+func (r *PostgresPullRequestRepository) CreateAutoReassignment(ctx context.Context, record *models.AutoReassignment) error {
+	query := `
+		INSERT INTO auto_reassignments (repository, pull_request_id, old_reviewer_id, new_reviewer_id, team_name, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now()
+	}
+
+	return conn(ctx, r.db).QueryRow(ctx, query, record.Repository, record.PullRequestID, record.OldReviewerID, record.NewReviewerID,
+		record.TeamName, record.Reason, record.CreatedAt).Scan(&record.ID)
+}
+
+func (r *PostgresPullRequestRepository) GetAutoReassignments(ctx context.Context, repository, prID string) ([]*models.AutoReassignment, error) {
+	query := `
+		SELECT id, repository, pull_request_id, old_reviewer_id, new_reviewer_id, team_name, reason, created_at
+		FROM auto_reassignments
+		WHERE repository = $1 AND pull_request_id = $2
+		ORDER BY created_at, id
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, repository, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []*models.AutoReassignment
+	for rows.Next() {
+		var record models.AutoReassignment
+		err := rows.Scan(&record.ID, &record.Repository, &record.PullRequestID, &record.OldReviewerID, &record.NewReviewerID,
+			&record.TeamName, &record.Reason, &record.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, &record)
+	}
+
+	return records, rows.Err()
+}
+
 // GetPRCountByStatus возвращает количество PR по статусам
 func (r *PostgresPullRequestRepository) GetPRCountByStatus(ctx context.Context) (map[string]int, error) {
 	query := `
@@ -257,7 +767,7 @@ func (r *PostgresPullRequestRepository) GetPRCountByStatus(ctx context.Context)
 		GROUP BY status
 	`
 
-	rows, err := r.db.Query(ctx, query)
+	rows, err := conn(ctx, r.db).Query(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +797,7 @@ func (r *PostgresPullRequestRepository) GetAssignmentsByUsers(ctx context.Contex
 		ORDER BY assignment_count DESC
 	`
 
-	rows, err := r.db.Query(ctx, query)
+	rows, err := conn(ctx, r.db).Query(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -307,16 +817,71 @@ func (r *PostgresPullRequestRepository) GetAssignmentsByUsers(ctx context.Contex
 	return stats, rows.Err()
 }
 
-// GetTeamPRCount возвращает количество PR для команды
-func (r *PostgresPullRequestRepository) GetTeamPRCount(ctx context.Context, teamName string) (int, error) {
+// GetPRCountsByTeam возвращает количество PR по основным командам авторов
+func (r *PostgresPullRequestRepository) GetPRCountsByTeam(ctx context.Context) (map[string]int, error) {
 	query := `
-		SELECT COUNT(DISTINCT pr.pull_request_id)
+		SELECT u.team_name, COUNT(*)
 		FROM pull_requests pr
 		JOIN users u ON pr.author_id = u.user_id
-		WHERE u.team_name = $1
+		WHERE u.team_name IS NOT NULL
+		GROUP BY u.team_name
 	`
 
-	var count int
-	err := r.db.QueryRow(ctx, query, teamName).Scan(&count)
-	return count, err
+	rows, err := conn(ctx, r.db).Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var teamName string
+		var count int
+		if err := rows.Scan(&teamName, &count); err != nil {
+			return nil, err
+		}
+		counts[teamName] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// GetPairingCounts возвращает число ревью по парам автор → ревьювер в порядке автора и
+// убывания числа ревью. teamName ограничивает авторов основной командой, since - время создания PR.
+func (r *PostgresPullRequestRepository) GetPairingCounts(ctx context.Context, teamName string, since *time.Time) ([]models.PairingStats, error) {
+	var where whereBuilder
+	if teamName != "" {
+		where.add("author.team_name = ?", teamName)
+	}
+	if since != nil {
+		where.add("pr.created_at >= ?", *since)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT pr.author_id, prr.user_id, COUNT(*), MAX(pr.created_at)
+		FROM pull_requests pr
+		JOIN pr_reviewers prr ON prr.repository = pr.repository AND prr.pull_request_id = pr.pull_request_id
+		JOIN users author ON author.user_id = pr.author_id
+		%s
+		GROUP BY pr.author_id, prr.user_id
+		ORDER BY pr.author_id, COUNT(*) DESC, prr.user_id
+	`, where.sql())
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []models.PairingStats
+	for rows.Next() {
+		var pairing models.PairingStats
+		err := rows.Scan(&pairing.AuthorID, &pairing.ReviewerID, &pairing.Count, &pairing.LastPairedAt)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, pairing)
+	}
+
+	return stats, rows.Err()
 }
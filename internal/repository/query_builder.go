@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+)
+
+// whereBuilder собирает условия WHERE с позиционными параметрами.
+// Плейсхолдер "?" в условии заменяется на очередной $N.
+type whereBuilder struct {
+	conditions []string
+	args       []interface{}
+}
+
+func (b *whereBuilder) add(condition string, args ...interface{}) {
+	for _, arg := range args {
+		b.args = append(b.args, arg)
+		condition = strings.Replace(condition, "?", fmt.Sprintf("$%d", len(b.args)), 1)
+	}
+	b.conditions = append(b.conditions, condition)
+}
+
+func (b *whereBuilder) arg(value interface{}) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func (b *whereBuilder) sql() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(b.conditions, " AND ")
+}
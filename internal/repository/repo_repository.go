@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresRepoRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresRepoRepository(db *pgxpool.Pool) *PostgresRepoRepository {
+	return &PostgresRepoRepository{db: db}
+}
+
+// CreateRepo создаёт репозиторий с настройками. Если репозиторий уже есть, возвращает ErrDuplicateKey.
+func (r *PostgresRepoRepository) CreateRepo(ctx context.Context, repo *models.Repo) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO repositories (repository, owner_team, reviewer_count, strategy, created_at, updated_at)
+		VALUES ($1, NULLIF($2, ''), $3, NULLIF($4, ''), $5, $6)
+	`
+
+	now := time.Now()
+	repo.CreatedAt = now
+	repo.UpdatedAt = now
+
+	_, err = tx.Exec(ctx, query, repo.Name, repo.OwnerTeam, repo.ReviewerCount, repo.Strategy, repo.CreatedAt, repo.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrDuplicateKey
+		}
+		return err
+	}
+
+	err = setRepoTeamsInTx(ctx, tx, repo.Name, repo.EligibleTeams)
+	if err != nil {
+		return err
+	}
+
+	err = setRepoRulesInTx(ctx, tx, repo.Name, repo.Rules)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresRepoRepository) GetRepo(ctx context.Context, name string) (*models.Repo, error) {
+	query := `
+		SELECT r.repository, COALESCE(r.owner_team, ''), r.reviewer_count, COALESCE(r.strategy, ''), r.created_at, r.updated_at,
+			ARRAY(SELECT rt.team_name FROM repository_teams rt WHERE rt.repository = r.repository ORDER BY rt.team_name)
+		FROM repositories r
+		WHERE r.repository = $1
+	`
+
+	var repo models.Repo
+	err := r.db.QueryRow(ctx, query, name).Scan(
+		&repo.Name, &repo.OwnerTeam, &repo.ReviewerCount, &repo.Strategy, &repo.CreatedAt, &repo.UpdatedAt, &repo.EligibleTeams)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rules, err := r.getRepoRules(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	repo.Rules = rules
+
+	return &repo, nil
+}
+
+func (r *PostgresRepoRepository) getRepoRules(ctx context.Context, name string) ([]models.AssignmentRule, error) {
+	query := `
+		SELECT COALESCE(label, ''), COALESCE(priority, ''), COALESCE(min_changed_lines, 0), extra_reviewers, COALESCE(min_level, '')
+		FROM repository_rules
+		WHERE repository = $1
+		ORDER BY position
+	`
+
+	rows, err := r.db.Query(ctx, query, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []models.AssignmentRule{}
+	for rows.Next() {
+		var rule models.AssignmentRule
+		if err := rows.Scan(&rule.Label, &rule.Priority, &rule.MinChangedLines, &rule.ExtraReviewers, &rule.MinLevel); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// UpdateRepo заменяет настройки репозитория, включая список допустимых команд.
+func (r *PostgresRepoRepository) UpdateRepo(ctx context.Context, repo *models.Repo) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE repositories
+		SET owner_team = NULLIF($2, ''), reviewer_count = $3, strategy = NULLIF($4, ''), updated_at = $5
+		WHERE repository = $1
+		RETURNING created_at
+	`
+
+	repo.UpdatedAt = time.Now()
+
+	err = tx.QueryRow(ctx, query, repo.Name, repo.OwnerTeam, repo.ReviewerCount, repo.Strategy, repo.UpdatedAt).Scan(&repo.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM repository_teams WHERE repository = $1`, repo.Name)
+	if err != nil {
+		return err
+	}
+
+	err = setRepoTeamsInTx(ctx, tx, repo.Name, repo.EligibleTeams)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM repository_rules WHERE repository = $1`, repo.Name)
+	if err != nil {
+		return err
+	}
+
+	err = setRepoRulesInTx(ctx, tx, repo.Name, repo.Rules)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func setRepoTeamsInTx(ctx context.Context, tx pgx.Tx, name string, teams []string) error {
+	if len(teams) == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO repository_teams (repository, team_name)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT DO NOTHING
+	`, name, teams)
+	return err
+}
+
+func setRepoRulesInTx(ctx context.Context, tx pgx.Tx, name string, rules []models.AssignmentRule) error {
+	query := `
+		INSERT INTO repository_rules (repository, position, label, priority, min_changed_lines, extra_reviewers, min_level)
+		VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, 0), $6, NULLIF($7, ''))
+	`
+
+	for i, rule := range rules {
+		_, err := tx.Exec(ctx, query, name, i+1, rule.Label, rule.Priority, rule.MinChangedLines, rule.ExtraReviewers, rule.MinLevel)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PostgresRepoRepository) RepoExists(ctx context.Context, name string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM repositories WHERE repository = $1)`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, name).Scan(&exists)
+	return exists, err
+}
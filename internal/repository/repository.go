@@ -2,20 +2,47 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// ErrDuplicateKey возвращается при нарушении ограничения уникальности.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+const pgUniqueViolation = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
+
 type UserRepository interface {
 	CreateUser(ctx context.Context, user *models.User) error
 	GetUserByID(ctx context.Context, userID string) (*models.User, error)
+	// GetUsersByIDs возвращает пользователей по ID одним запросом; несуществующих ID нет в результате.
+	GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*models.User, error)
 	UpdateUser(ctx context.Context, user *models.User) error
 	DeleteUser(ctx context.Context, userID string) error
 
 	GetUsersByTeam(ctx context.Context, teamName string) ([]*models.User, error)
 	GetActiveUsersByTeam(ctx context.Context, teamName string) ([]*models.User, error)
 	SetUserActiveStatus(ctx context.Context, userID string, isActive bool) error
+	SetUserTeam(ctx context.Context, userID string, teamName string) error
+	SetUserSkills(ctx context.Context, userID string, skills []string) error
+	SetUserLevel(ctx context.Context, userID string, level string) error
 	UserExists(ctx context.Context, userID string) (bool, error)
+
+	// CreateBlockedPair добавляет запрет пары или обновляет причину и срок существующего.
+	CreateBlockedPair(ctx context.Context, pair *models.BlockedPair) error
+	DeleteBlockedPair(ctx context.Context, authorID, reviewerID string) error
+	// GetBlockedPairs возвращает запреты, где пользователь - автор или ревьювер, включая истёкшие.
+	GetBlockedPairs(ctx context.Context, userID string) ([]*models.BlockedPair, error)
+	// GetBlockedReviewers возвращает действующие запреты для PR автора authorID.
+	GetBlockedReviewers(ctx context.Context, authorID string) ([]*models.BlockedPair, error)
 }
 
 type TeamRepository interface {
@@ -23,9 +50,26 @@ type TeamRepository interface {
 	GetTeamByName(ctx context.Context, teamName string) (*models.Team, error)
 	UpdateTeam(ctx context.Context, team *models.Team) error
 	DeleteTeam(ctx context.Context, teamName string) error
+	SetTeamArchived(ctx context.Context, teamName string, archived bool) (*time.Time, error)
+	SetTeamParent(ctx context.Context, teamName string, parentTeam string) error
+	GetTeamSubtree(ctx context.Context, rootTeam string) ([]*models.Team, error)
+	GetTeamPolicies(ctx context.Context, teamName string) ([]string, error)
+	SetTeamPolicies(ctx context.Context, teamName string, policies []string) error
+	GetTeamRotationPenalty(ctx context.Context, teamName string) (int, error)
+	SetTeamRotationPenalty(ctx context.Context, teamName string, penalty int) error
+	SetTeamSLA(ctx context.Context, teamName string, sla models.ReviewSLA) error
+	SetTeamAutoReassign(ctx context.Context, teamName string, settings models.AutoReassignSettings) error
+
+	AddTeamMember(ctx context.Context, teamName string, member models.TeamMember) error
+	RemoveTeamMember(ctx context.Context, teamName string, userID string) error
+	IsTeamMember(ctx context.Context, teamName string, userID string) (bool, error)
 
 	TeamExists(ctx context.Context, teamName string) (bool, error)
 	GetTeamWithMembers(ctx context.Context, teamName string) (*models.Team, error)
+	// GetMembersByTeams возвращает участников нескольких команд одним запросом, по командам.
+	GetMembersByTeams(ctx context.Context, teamNames []string) (map[string][]models.TeamMember, error)
+	// GetTeamsByMemberRole возвращает неархивные команды, где пользователь состоит с ролью role, по пользователям.
+	GetTeamsByMemberRole(ctx context.Context, role string) (map[string][]string, error)
 
 	// Методы для статистики
 	GetAllTeams(ctx context.Context) ([]*models.Team, error)
@@ -33,18 +77,123 @@ type TeamRepository interface {
 
 type PullRequestRepository interface {
 	CreatePullRequest(ctx context.Context, pr *models.PullRequest) error
-	GetPullRequestByID(ctx context.Context, prID string) (*models.PullRequest, error)
+	GetPullRequestByID(ctx context.Context, repository, prID string) (*models.PullRequest, error)
 	UpdatePullRequest(ctx context.Context, pr *models.PullRequest) error
-	DeletePullRequest(ctx context.Context, prID string) error
+	DeletePullRequest(ctx context.Context, repository, prID string) error
 
-	GetPullRequestsByReviewer(ctx context.Context, userID string) ([]*models.PullRequestShort, error)
-	MergePullRequest(ctx context.Context, prID string) error
-	PullRequestExists(ctx context.Context, prID string) (bool, error)
-	GetAssignedReviewers(ctx context.Context, prID string) ([]string, error)
-	SetAssignedReviewers(ctx context.Context, prID string, reviewers []string) error
+	GetPullRequestsByReviewer(ctx context.Context, userID string, filter models.ReviewFilter) ([]*models.PullRequestShort, error)
+	CountPullRequestsByReviewer(ctx context.Context, userID string, status string) (int, error)
+	GetOpenPullRequestRefsByReviewer(ctx context.Context, userID string) ([]models.PullRequestRef, error)
+	GetOpenPullRequestRefsByTeam(ctx context.Context, teamName string) ([]models.PullRequestRef, error)
+	// GetOpenReviewCounts возвращает число открытых PR на ревью у каждого из пользователей.
+	GetOpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error)
+	// GetRecentPairings возвращает ревьюверов последних limit PR автора, созданных не раньше since.
+	GetRecentPairings(ctx context.Context, authorID string, limit int, since time.Time) ([]models.ReviewPairing, error)
+	ListPullRequests(ctx context.Context, filter models.PullRequestFilter) ([]*models.PullRequest, error)
+	MergePullRequest(ctx context.Context, repository, prID string) (bool, error)
+	SetPullRequestReady(ctx context.Context, repository, prID string) (bool, error)
+	PullRequestExists(ctx context.Context, repository, prID string) (bool, error)
+	GetAssignedReviewers(ctx context.Context, repository, prID string) ([]string, error)
+	// SetAssignedReviewers заменяет ревьюверов PR; у оставшихся сохраняются время назначения и ответа.
+	SetAssignedReviewers(ctx context.Context, repository, prID string, reviewers []string) error
+	// SetReviewResponded отмечает первый ответ ревьювера на PR; повторный ответ время не меняет.
+	// Непустой verdict заменяет прежний вердикт ревьювера.
+	SetReviewResponded(ctx context.Context, repository, prID, userID string, at time.Time, verdict string) error
+	// SetReviewReminded запоминает время напоминания ревьюверу о PR.
+	SetReviewReminded(ctx context.Context, repository, prID, userID string, at time.Time) error
+	// GetReviewAssignments возвращает назначения ревьюверов вместе с SLA основной команды автора PR.
+	GetReviewAssignments(ctx context.Context, filter models.ReviewAssignmentFilter) ([]*models.ReviewAssignment, error)
+	CreateAutoReassignment(ctx context.Context, record *models.AutoReassignment) error
+	// GetAutoReassignments возвращает аудит автоматических переназначений PR в порядке их выполнения.
+	GetAutoReassignments(ctx context.Context, repository, prID string) ([]*models.AutoReassignment, error)
 
 	// Методы для статистики
 	GetPRCountByStatus(ctx context.Context) (map[string]int, error)
 	GetAssignmentsByUsers(ctx context.Context) (map[string]int, error)
-	GetTeamPRCount(ctx context.Context, teamName string) (int, error)
+	// GetPRCountsByTeam возвращает число PR по основным командам авторов.
+	GetPRCountsByTeam(ctx context.Context) (map[string]int, error)
+	// GetPairingCounts возвращает число ревью по парам автор → ревьювер. Пустой teamName -
+	// авторы всех команд, nil since - PR за всё время.
+	GetPairingCounts(ctx context.Context, teamName string, since *time.Time) ([]models.PairingStats, error)
+}
+
+type RepoRepository interface {
+	CreateRepo(ctx context.Context, repo *models.Repo) error
+	GetRepo(ctx context.Context, name string) (*models.Repo, error)
+	UpdateRepo(ctx context.Context, repo *models.Repo) error
+	RepoExists(ctx context.Context, name string) (bool, error)
+}
+
+type CodeOwnersRepository interface {
+	CreateCodeOwnersFile(ctx context.Context, file *models.CodeOwnersFile) error
+	GetCodeOwnersFile(ctx context.Context, repository string, version int) (*models.CodeOwnersFile, error)
+}
+
+type IdempotencyRepository interface {
+	// ReserveIdempotencyKey резервирует ключ под выполняемый запрос. Возвращает false,
+	// если по ключу уже есть неистёкшая запись.
+	ReserveIdempotencyKey(ctx context.Context, record *models.IdempotencyRecord) (bool, error)
+	GetIdempotencyRecord(ctx context.Context, key, requestPath string) (*models.IdempotencyRecord, error)
+	CompleteIdempotencyKey(ctx context.Context, record *models.IdempotencyRecord) error
+	DeleteIdempotencyKey(ctx context.Context, key, requestPath string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+type Transactor interface {
+	// WithinTransaction выполняет fn в одной транзакции; репозитории берут её из переданного контекста.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type EventRepository interface {
+	// AddEvents сохраняет события в outbox, заполняя ID и CreatedAt.
+	AddEvents(ctx context.Context, events []*models.Event) error
+	// GetEventsAfter возвращает до limit зафиксированных событий, следующих за событием afterID
+	// в порядке фиксации; afterID 0 - с начала журнала.
+	GetEventsAfter(ctx context.Context, afterID int64, limit int) ([]*models.Event, error)
+	// GetLastEventID возвращает ID последнего зафиксированного события или 0, если событий нет.
+	GetLastEventID(ctx context.Context) (int64, error)
+	// GetEventCursor возвращает последнее событие, обработанное потребителем name, или nil,
+	// если потребитель ещё не сохранял позицию.
+	GetEventCursor(ctx context.Context, name string) (*int64, error)
+	SetEventCursor(ctx context.Context, name string, lastEventID int64) error
+}
+
+type NotificationRepository interface {
+	// SetNotificationPreferences сохраняет настройки, заменяя прежние, и заполняет UpdatedAt.
+	SetNotificationPreferences(ctx context.Context, prefs *models.NotificationPreferences) error
+	GetNotificationPreferences(ctx context.Context, userID string) (*models.NotificationPreferences, error)
+	AddDigestItem(ctx context.Context, item *models.DigestItem) error
+	// GetDigestItems возвращает отложенные уведомления в порядке (user_id, id).
+	GetDigestItems(ctx context.Context) ([]*models.DigestItem, error)
+	DeleteDigestItems(ctx context.Context, ids []int64) error
+}
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	GetSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+
+	// EnqueueDeliveries создаёт доставки для не более чем limit неразосланных событий
+	// и отмечает события разосланными. Возвращает число обработанных событий.
+	EnqueueDeliveries(ctx context.Context, limit int) (int, error)
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, id int64) error
+	ScheduleRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastError string) error
+	MoveToDeadLetter(ctx context.Context, id int64, attempts int, lastError string) error
+
+	// GetDeadLetters возвращает недоставленные события; subscriptionID = 0 - всех подписок.
+	GetDeadLetters(ctx context.Context, subscriptionID int64) ([]*models.WebhookDeadLetter, error)
+	// ReplayDeadLetters возвращает недоставленные события в очередь доставки. Пустой ids -
+	// все события подписки, subscriptionID = 0 - всех подписок.
+	ReplayDeadLetters(ctx context.Context, subscriptionID int64, ids []int64) (int, error)
+}
+
+type GitIdentityRepository interface {
+	// SetGitIdentity связывает логин с пользователем, заменяя прежнюю связь логина.
+	SetGitIdentity(ctx context.Context, identity *models.GitIdentity) error
+	DeleteGitIdentity(ctx context.Context, provider, username string) error
+	// GetGitIdentities возвращает связи логинов; пустой provider - всех Git-хостингов.
+	GetGitIdentities(ctx context.Context, provider string) ([]*models.GitIdentity, error)
+	// GetUserIDByGitUsername возвращает пустую строку, если логин не связан с пользователем.
+	GetUserIDByGitUsername(ctx context.Context, provider, username string) (string, error)
 }
@@ -31,19 +31,21 @@ func (r *PostgresTeamRepository) CreateTeam(ctx context.Context, team *models.Te
 	team.CreatedAt = now
 	team.UpdatedAt = now
 
-	_, err := r.db.Exec(ctx, query, team.TeamName, team.CreatedAt, team.UpdatedAt)
+	_, err := conn(ctx, r.db).Exec(ctx, query, team.TeamName, team.CreatedAt, team.UpdatedAt)
 	return err
 }
 
 func (r *PostgresTeamRepository) GetTeamByName(ctx context.Context, teamName string) (*models.Team, error) {
 	query := `
-		SELECT team_name, created_at, updated_at
+		SELECT team_name, created_at, updated_at, archived_at, COALESCE(parent_team, ''), rotation_penalty,
+			sla_first_response_hours, sla_completion_hours, auto_reassign_enabled, auto_reassign_max_per_pr
 		FROM teams
 		WHERE team_name = $1
 	`
 
 	var team models.Team
-	err := r.db.QueryRow(ctx, query, teamName).Scan(&team.TeamName, &team.CreatedAt, &team.UpdatedAt)
+	err := conn(ctx, r.db).QueryRow(ctx, query, teamName).Scan(&team.TeamName, &team.CreatedAt, &team.UpdatedAt, &team.ArchivedAt, &team.ParentTeam, &team.RotationPenalty,
+		&team.SLA.FirstResponseHours, &team.SLA.CompletionHours, &team.AutoReassign.Enabled, &team.AutoReassign.MaxPerPR)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -63,7 +65,7 @@ func (r *PostgresTeamRepository) UpdateTeam(ctx context.Context, team *models.Te
 
 	team.UpdatedAt = time.Now()
 
-	result, err := r.db.Exec(ctx, query, team.TeamName, team.UpdatedAt)
+	result, err := conn(ctx, r.db).Exec(ctx, query, team.TeamName, team.UpdatedAt)
 	if err != nil {
 		return err
 	}
@@ -75,10 +77,289 @@ func (r *PostgresTeamRepository) UpdateTeam(ctx context.Context, team *models.Te
 	return nil
 }
 
+// DeleteTeam удаляет команду. Пользователям, для которых она была основной, основной
+// становится самая ранняя из оставшихся у них команд.
 func (r *PostgresTeamRepository) DeleteTeam(ctx context.Context, teamName string) error {
-	query := `DELETE FROM teams WHERE team_name = $1`
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `DELETE FROM user_teams WHERE team_name = $1`, teamName)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, resetPrimaryTeamQuery+` WHERE u.team_name = $1`, teamName)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(ctx, `DELETE FROM teams WHERE team_name = $1`, teamName)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return tx.Commit(ctx)
+}
+
+// AddTeamMember добавляет пользователя в команду или обновляет роль и вес существующего членства.
+func (r *PostgresTeamRepository) AddTeamMember(ctx context.Context, teamName string, member models.TeamMember) error {
+	query := `
+		INSERT INTO user_teams (user_id, team_name, role, weight)
+		VALUES ($1, $2, NULLIF($3, ''), $4)
+		ON CONFLICT (user_id, team_name) DO UPDATE SET
+			role = EXCLUDED.role,
+			weight = EXCLUDED.weight
+	`
+
+	weight := member.Weight
+	if weight <= 0 {
+		weight = models.DefaultMemberWeight
+	}
+
+	_, err := conn(ctx, r.db).Exec(ctx, query, member.UserID, teamName, member.Role, weight)
+	return err
+}
+
+// RemoveTeamMember исключает пользователя из команды. Если команда была для него основной,
+// основной становится самая ранняя из оставшихся команд.
+func (r *PostgresTeamRepository) RemoveTeamMember(ctx context.Context, teamName string, userID string) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM user_teams WHERE team_name = $1 AND user_id = $2`, teamName, userID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	_, err = tx.Exec(ctx, resetPrimaryTeamQuery+` WHERE u.team_name = $1 AND u.user_id = $2`, teamName, userID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresTeamRepository) IsTeamMember(ctx context.Context, teamName string, userID string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM user_teams WHERE team_name = $1 AND user_id = $2)`
+
+	var exists bool
+	err := conn(ctx, r.db).QueryRow(ctx, query, teamName, userID).Scan(&exists)
+	return exists, err
+}
+
+// resetPrimaryTeamQuery назначает основной командой пользователя самую раннюю из его
+// команд (или NULL). Условие WHERE дописывается вызывающим кодом.
+const resetPrimaryTeamQuery = `
+	UPDATE users u
+	SET team_name = (
+		SELECT ut.team_name FROM user_teams ut
+		WHERE ut.user_id = u.user_id
+		ORDER BY ut.created_at, ut.team_name
+		LIMIT 1
+	), updated_at = now()
+`
+
+// SetTeamArchived архивирует команду (archived == true) или возвращает её из архива.
+func (r *PostgresTeamRepository) SetTeamArchived(ctx context.Context, teamName string, archived bool) (*time.Time, error) {
+	query := `
+		UPDATE teams
+		SET archived_at = CASE WHEN $2 THEN COALESCE(archived_at, $3) ELSE NULL END, updated_at = $3
+		WHERE team_name = $1
+		RETURNING archived_at
+	`
+
+	var archivedAt *time.Time
+	err := conn(ctx, r.db).QueryRow(ctx, query, teamName, archived, time.Now()).Scan(&archivedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return archivedAt, nil
+}
+
+// SetTeamParent задаёт родительскую команду; пустая строка делает команду корневой.
+func (r *PostgresTeamRepository) SetTeamParent(ctx context.Context, teamName string, parentTeam string) error {
+	query := `
+		UPDATE teams
+		SET parent_team = NULLIF($2, ''), updated_at = $3
+		WHERE team_name = $1
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, teamName, parentTeam, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetTeamSubtree возвращает команду rootTeam и всех её потомков без участников.
+func (r *PostgresTeamRepository) GetTeamSubtree(ctx context.Context, rootTeam string) ([]*models.Team, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT team_name FROM teams WHERE team_name = $1
+			UNION
+			SELECT t.team_name FROM teams t JOIN subtree s ON t.parent_team = s.team_name
+		)
+		SELECT t.team_name, t.created_at, t.updated_at, t.archived_at, COALESCE(t.parent_team, '')
+		FROM teams t
+		JOIN subtree s ON s.team_name = t.team_name
+		ORDER BY t.team_name
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, rootTeam)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*models.Team
+	for rows.Next() {
+		var team models.Team
+		err := rows.Scan(&team.TeamName, &team.CreatedAt, &team.UpdatedAt, &team.ArchivedAt, &team.ParentTeam)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, &team)
+	}
+
+	return teams, rows.Err()
+}
+
+// GetTeamPolicies возвращает политики наставничества команды в алфавитном порядке.
+func (r *PostgresTeamRepository) GetTeamPolicies(ctx context.Context, teamName string) ([]string, error) {
+	query := `SELECT policy FROM team_policies WHERE team_name = $1 ORDER BY policy`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []string
+	for rows.Next() {
+		var policy string
+		if err := rows.Scan(&policy); err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, rows.Err()
+}
+
+// SetTeamPolicies заменяет набор политик наставничества команды.
+func (r *PostgresTeamRepository) SetTeamPolicies(ctx context.Context, teamName string, policies []string) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `UPDATE teams SET updated_at = $2 WHERE team_name = $1`, teamName, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM team_policies WHERE team_name = $1`, teamName)
+	if err != nil {
+		return err
+	}
+
+	if len(policies) > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO team_policies (team_name, policy)
+			SELECT $1, unnest($2::text[])
+		`, teamName, policies)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
 
-	result, err := r.db.Exec(ctx, query, teamName)
+// GetTeamRotationPenalty возвращает силу штрафа ротации ревьюверов; для несуществующей команды - 0.
+func (r *PostgresTeamRepository) GetTeamRotationPenalty(ctx context.Context, teamName string) (int, error) {
+	query := `SELECT rotation_penalty FROM teams WHERE team_name = $1`
+
+	var penalty int
+	err := conn(ctx, r.db).QueryRow(ctx, query, teamName).Scan(&penalty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return penalty, err
+}
+
+func (r *PostgresTeamRepository) SetTeamRotationPenalty(ctx context.Context, teamName string, penalty int) error {
+	query := `
+		UPDATE teams
+		SET rotation_penalty = $2, updated_at = $3
+		WHERE team_name = $1
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, teamName, penalty, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// SetTeamSLA заменяет SLA ревью команды; nil-поля снимают соответствующий срок.
+func (r *PostgresTeamRepository) SetTeamSLA(ctx context.Context, teamName string, sla models.ReviewSLA) error {
+	query := `
+		UPDATE teams
+		SET sla_first_response_hours = $2, sla_completion_hours = $3, updated_at = $4
+		WHERE team_name = $1
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, teamName, sla.FirstResponseHours, sla.CompletionHours, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *PostgresTeamRepository) SetTeamAutoReassign(ctx context.Context, teamName string, settings models.AutoReassignSettings) error {
+	query := `
+		UPDATE teams
+		SET auto_reassign_enabled = $2, auto_reassign_max_per_pr = $3, updated_at = $4
+		WHERE team_name = $1
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, teamName, settings.Enabled, settings.MaxPerPR, time.Now())
 	if err != nil {
 		return err
 	}
@@ -94,7 +375,7 @@ func (r *PostgresTeamRepository) TeamExists(ctx context.Context, teamName string
 	query := `SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)`
 
 	var exists bool
-	err := r.db.QueryRow(ctx, query, teamName).Scan(&exists)
+	err := conn(ctx, r.db).QueryRow(ctx, query, teamName).Scan(&exists)
 	return exists, err
 }
 
@@ -108,13 +389,14 @@ func (r *PostgresTeamRepository) GetTeamWithMembers(ctx context.Context, teamNam
 	}
 
 	membersQuery := `
-		SELECT user_id, username, is_active
-		FROM users
-		WHERE team_name = $1
-		ORDER BY username
+		SELECT u.user_id, u.username, u.is_active, COALESCE(ut.role, ''), ut.weight
+		FROM user_teams ut
+		JOIN users u ON u.user_id = ut.user_id
+		WHERE ut.team_name = $1
+		ORDER BY u.username
 	`
 
-	rows, err := r.db.Query(ctx, membersQuery, teamName)
+	rows, err := conn(ctx, r.db).Query(ctx, membersQuery, teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +405,7 @@ func (r *PostgresTeamRepository) GetTeamWithMembers(ctx context.Context, teamNam
 	var members []models.TeamMember
 	for rows.Next() {
 		var member models.TeamMember
-		err := rows.Scan(&member.UserID, &member.Username, &member.IsActive)
+		err := rows.Scan(&member.UserID, &member.Username, &member.IsActive, &member.Role, &member.Weight)
 		if err != nil {
 			return nil, err
 		}
@@ -135,17 +417,79 @@ func (r *PostgresTeamRepository) GetTeamWithMembers(ctx context.Context, teamNam
 	}
 
 	team.Members = members
+
+	team.Policies, err = r.GetTeamPolicies(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
 	return team, nil
 }
 
+func (r *PostgresTeamRepository) GetMembersByTeams(ctx context.Context, teamNames []string) (map[string][]models.TeamMember, error) {
+	query := `
+		SELECT ut.team_name, u.user_id, u.username, u.is_active, COALESCE(ut.role, ''), ut.weight
+		FROM user_teams ut
+		JOIN users u ON u.user_id = ut.user_id
+		WHERE ut.team_name = ANY($1)
+		ORDER BY ut.team_name, u.username
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, teamNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make(map[string][]models.TeamMember, len(teamNames))
+	for rows.Next() {
+		var teamName string
+		var member models.TeamMember
+		err := rows.Scan(&teamName, &member.UserID, &member.Username, &member.IsActive, &member.Role, &member.Weight)
+		if err != nil {
+			return nil, err
+		}
+		members[teamName] = append(members[teamName], member)
+	}
+
+	return members, rows.Err()
+}
+
+func (r *PostgresTeamRepository) GetTeamsByMemberRole(ctx context.Context, role string) (map[string][]string, error) {
+	query := `
+		SELECT ut.user_id, ut.team_name
+		FROM user_teams ut
+		JOIN teams t ON t.team_name = ut.team_name
+		WHERE ut.role = $1 AND t.archived_at IS NULL
+		ORDER BY ut.user_id, ut.team_name
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make(map[string][]string)
+	for rows.Next() {
+		var userID, teamName string
+		if err := rows.Scan(&userID, &teamName); err != nil {
+			return nil, err
+		}
+		teams[userID] = append(teams[userID], teamName)
+	}
+
+	return teams, rows.Err()
+}
+
 func (r *PostgresTeamRepository) GetAllTeams(ctx context.Context) ([]*models.Team, error) {
 	query := `
-		SELECT team_name, created_at, updated_at
+		SELECT team_name, created_at, updated_at, archived_at, COALESCE(parent_team, '')
 		FROM teams
 		ORDER BY team_name
 	`
 
-	rows, err := r.db.Query(ctx, query)
+	rows, err := conn(ctx, r.db).Query(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +498,7 @@ func (r *PostgresTeamRepository) GetAllTeams(ctx context.Context) ([]*models.Tea
 	var teams []*models.Team
 	for rows.Next() {
 		var team models.Team
-		err := rows.Scan(&team.TeamName, &team.CreatedAt, &team.UpdatedAt)
+		err := rows.Scan(&team.TeamName, &team.CreatedAt, &team.UpdatedAt, &team.ArchivedAt, &team.ParentTeam)
 		if err != nil {
 			return nil, err
 		}
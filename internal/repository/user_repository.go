@@ -11,6 +11,9 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// userSkillsColumn выбирает навыки пользователя u одним массивом.
+const userSkillsColumn = `ARRAY(SELECT us.skill FROM user_skills us WHERE us.user_id = u.user_id ORDER BY us.skill)`
+
 type PostgresUserRepository struct {
 	db *pgxpool.Pool
 }
@@ -22,10 +25,10 @@ func NewPostgresUserRepository(db *pgxpool.Pool) *PostgresUserRepository {
 func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *models.User) error {
 	query := `
 		INSERT INTO users (user_id, username, team_name, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6)
 		ON CONFLICT (user_id) DO UPDATE SET
 			username = EXCLUDED.username,
-			team_name = EXCLUDED.team_name,
+			team_name = COALESCE(users.team_name, EXCLUDED.team_name),
 			is_active = EXCLUDED.is_active,
 			updated_at = EXCLUDED.updated_at
 	`
@@ -34,21 +37,21 @@ func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *models.Us
 	user.CreatedAt = now
 	user.UpdatedAt = now
 
-	_, err := r.db.Exec(ctx, query,
+	_, err := conn(ctx, r.db).Exec(ctx, query,
 		user.UserID, user.Username, user.TeamName, user.IsActive, user.CreatedAt, user.UpdatedAt)
 	return err
 }
 
 func (r *PostgresUserRepository) GetUserByID(ctx context.Context, userID string) (*models.User, error) {
 	query := `
-		SELECT user_id, username, team_name, is_active, created_at, updated_at
-		FROM users
-		WHERE user_id = $1
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, COALESCE(u.level, ''), u.created_at, u.updated_at, ` + userSkillsColumn + `
+		FROM users u
+		WHERE u.user_id = $1
 	`
 
 	var user models.User
-	err := r.db.QueryRow(ctx, query, userID).Scan(
-		&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.CreatedAt, &user.UpdatedAt)
+	err := conn(ctx, r.db).QueryRow(ctx, query, userID).Scan(
+		&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Level, &user.CreatedAt, &user.UpdatedAt, &user.Skills)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -59,16 +62,42 @@ func (r *PostgresUserRepository) GetUserByID(ctx context.Context, userID string)
 	return &user, nil
 }
 
+func (r *PostgresUserRepository) GetUsersByIDs(ctx context.Context, userIDs []string) (map[string]*models.User, error) {
+	query := `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, COALESCE(u.level, ''), u.created_at, u.updated_at, ` + userSkillsColumn + `
+		FROM users u
+		WHERE u.user_id = ANY($1)
+	`
+
+	rows, err := conn(ctx, r.db).Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make(map[string]*models.User, len(userIDs))
+	for rows.Next() {
+		var user models.User
+		err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Level, &user.CreatedAt, &user.UpdatedAt, &user.Skills)
+		if err != nil {
+			return nil, err
+		}
+		users[user.UserID] = &user
+	}
+
+	return users, rows.Err()
+}
+
 func (r *PostgresUserRepository) UpdateUser(ctx context.Context, user *models.User) error {
 	query := `
 		UPDATE users
-		SET username = $2, team_name = $3, is_active = $4, updated_at = $5
+		SET username = $2, team_name = NULLIF($3, ''), is_active = $4, updated_at = $5
 		WHERE user_id = $1
 	`
 
 	user.UpdatedAt = time.Now()
 
-	result, err := r.db.Exec(ctx, query, user.UserID, user.Username, user.TeamName, user.IsActive, user.UpdatedAt)
+	result, err := conn(ctx, r.db).Exec(ctx, query, user.UserID, user.Username, user.TeamName, user.IsActive, user.UpdatedAt)
 	if err != nil {
 		return err
 	}
@@ -83,7 +112,7 @@ func (r *PostgresUserRepository) UpdateUser(ctx context.Context, user *models.Us
 func (r *PostgresUserRepository) DeleteUser(ctx context.Context, userID string) error {
 	query := `DELETE FROM users WHERE user_id = $1`
 
-	result, err := r.db.Exec(ctx, query, userID)
+	result, err := conn(ctx, r.db).Exec(ctx, query, userID)
 	if err != nil {
 		return err
 	}
@@ -97,13 +126,14 @@ func (r *PostgresUserRepository) DeleteUser(ctx context.Context, userID string)
 
 func (r *PostgresUserRepository) GetUsersByTeam(ctx context.Context, teamName string) ([]*models.User, error) {
 	query := `
-		SELECT user_id, username, team_name, is_active, created_at, updated_at
-		FROM users
-		WHERE team_name = $1
-		ORDER BY username
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, COALESCE(u.level, ''), u.created_at, u.updated_at, ut.weight, ` + userSkillsColumn + `
+		FROM user_teams ut
+		JOIN users u ON u.user_id = ut.user_id
+		WHERE ut.team_name = $1
+		ORDER BY u.username
 	`
 
-	rows, err := r.db.Query(ctx, query, teamName)
+	rows, err := conn(ctx, r.db).Query(ctx, query, teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +142,7 @@ func (r *PostgresUserRepository) GetUsersByTeam(ctx context.Context, teamName st
 	var users []*models.User
 	for rows.Next() {
 		var user models.User
-		err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.CreatedAt, &user.UpdatedAt)
+		err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Level, &user.CreatedAt, &user.UpdatedAt, &user.ReviewWeight, &user.Skills)
 		if err != nil {
 			return nil, err
 		}
@@ -124,13 +154,15 @@ func (r *PostgresUserRepository) GetUsersByTeam(ctx context.Context, teamName st
 
 func (r *PostgresUserRepository) GetActiveUsersByTeam(ctx context.Context, teamName string) ([]*models.User, error) {
 	query := `
-		SELECT user_id, username, team_name, is_active, created_at, updated_at
-		FROM users
-		WHERE team_name = $1 AND is_active = true
-		ORDER BY username
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, COALESCE(u.level, ''), u.created_at, u.updated_at, ut.weight, ` + userSkillsColumn + `
+		FROM user_teams ut
+		JOIN users u ON u.user_id = ut.user_id
+		JOIN teams t ON t.team_name = ut.team_name
+		WHERE ut.team_name = $1 AND u.is_active = true AND t.archived_at IS NULL
+		ORDER BY u.username
 	`
 
-	rows, err := r.db.Query(ctx, query, teamName)
+	rows, err := conn(ctx, r.db).Query(ctx, query, teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +171,7 @@ func (r *PostgresUserRepository) GetActiveUsersByTeam(ctx context.Context, teamN
 	var users []*models.User
 	for rows.Next() {
 		var user models.User
-		err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.CreatedAt, &user.UpdatedAt)
+		err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Level, &user.CreatedAt, &user.UpdatedAt, &user.ReviewWeight, &user.Skills)
 		if err != nil {
 			return nil, err
 		}
@@ -156,7 +188,101 @@ func (r *PostgresUserRepository) SetUserActiveStatus(ctx context.Context, userID
 		WHERE user_id = $1
 	`
 
-	result, err := r.db.Exec(ctx, query, userID, isActive, time.Now())
+	result, err := conn(ctx, r.db).Exec(ctx, query, userID, isActive, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// SetUserTeam переводит пользователя из основной команды в teamName: членство в прежней
+// основной команде снимается, в новой - добавляется, если его ещё нет.
+func (r *PostgresUserRepository) SetUserTeam(ctx context.Context, userID string, teamName string) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var previousTeam *string
+	err = tx.QueryRow(ctx, `SELECT team_name FROM users WHERE user_id = $1 FOR UPDATE`, userID).Scan(&previousTeam)
+	if err != nil {
+		return err
+	}
+
+	if previousTeam != nil {
+		_, err = tx.Exec(ctx, `DELETE FROM user_teams WHERE user_id = $1 AND team_name = $2`, userID, *previousTeam)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO user_teams (user_id, team_name)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, team_name) DO NOTHING
+	`, userID, teamName)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE users SET team_name = $2, updated_at = $3 WHERE user_id = $1`, userID, teamName, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// SetUserSkills заменяет набор навыков пользователя.
+func (r *PostgresUserRepository) SetUserSkills(ctx context.Context, userID string, skills []string) error {
+	tx, err := conn(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `UPDATE users SET updated_at = $2 WHERE user_id = $1`, userID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM user_skills WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+
+	if len(skills) > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO user_skills (user_id, skill)
+			SELECT $1, unnest($2::text[])
+		`, userID, skills)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// SetUserLevel задаёт уровень пользователя, пустая строка сбрасывает его.
+func (r *PostgresUserRepository) SetUserLevel(ctx context.Context, userID string, level string) error {
+	query := `
+		UPDATE users
+		SET level = NULLIF($2, ''), updated_at = $3
+		WHERE user_id = $1
+	`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, userID, level, time.Now())
 	if err != nil {
 		return err
 	}
@@ -172,6 +298,79 @@ func (r *PostgresUserRepository) UserExists(ctx context.Context, userID string)
 	query := `SELECT EXISTS(SELECT 1 FROM users WHERE user_id = $1)`
 
 	var exists bool
-	err := r.db.QueryRow(ctx, query, userID).Scan(&exists)
+	err := conn(ctx, r.db).QueryRow(ctx, query, userID).Scan(&exists)
 	return exists, err
 }
+
+// CreateBlockedPair добавляет запрет пары или обновляет причину и срок существующего.
+func (r *PostgresUserRepository) CreateBlockedPair(ctx context.Context, pair *models.BlockedPair) error {
+	query := `
+		INSERT INTO blocked_pairs (author_id, reviewer_id, reason, expires_at, created_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5)
+		ON CONFLICT (author_id, reviewer_id) DO UPDATE SET
+			reason = EXCLUDED.reason,
+			expires_at = EXCLUDED.expires_at
+		RETURNING created_at
+	`
+
+	return conn(ctx, r.db).QueryRow(ctx, query, pair.AuthorID, pair.ReviewerID, pair.Reason, pair.ExpiresAt, time.Now()).Scan(&pair.CreatedAt)
+}
+
+func (r *PostgresUserRepository) DeleteBlockedPair(ctx context.Context, authorID, reviewerID string) error {
+	query := `DELETE FROM blocked_pairs WHERE author_id = $1 AND reviewer_id = $2`
+
+	result, err := conn(ctx, r.db).Exec(ctx, query, authorID, reviewerID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetBlockedPairs возвращает запреты, где пользователь - автор или ревьювер, включая истёкшие.
+func (r *PostgresUserRepository) GetBlockedPairs(ctx context.Context, userID string) ([]*models.BlockedPair, error) {
+	query := `
+		SELECT author_id, reviewer_id, COALESCE(reason, ''), expires_at, created_at
+		FROM blocked_pairs
+		WHERE author_id = $1 OR reviewer_id = $1
+		ORDER BY author_id, reviewer_id
+	`
+
+	return r.queryBlockedPairs(ctx, query, userID)
+}
+
+// GetBlockedReviewers возвращает действующие запреты для PR автора authorID.
+func (r *PostgresUserRepository) GetBlockedReviewers(ctx context.Context, authorID string) ([]*models.BlockedPair, error) {
+	query := `
+		SELECT author_id, reviewer_id, COALESCE(reason, ''), expires_at, created_at
+		FROM blocked_pairs
+		WHERE author_id = $1 AND (expires_at IS NULL OR expires_at > $2)
+		ORDER BY reviewer_id
+	`
+
+	return r.queryBlockedPairs(ctx, query, authorID, time.Now())
+}
+
+func (r *PostgresUserRepository) queryBlockedPairs(ctx context.Context, query string, args ...any) ([]*models.BlockedPair, error) {
+	rows, err := conn(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pairs []*models.BlockedPair
+	for rows.Next() {
+		var pair models.BlockedPair
+		err := rows.Scan(&pair.AuthorID, &pair.ReviewerID, &pair.Reason, &pair.ExpiresAt, &pair.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, &pair)
+	}
+
+	return pairs, rows.Err()
+}
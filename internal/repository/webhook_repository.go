@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresWebhookRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresWebhookRepository(db *pgxpool.Pool) *PostgresWebhookRepository {
+	return &PostgresWebhookRepository{db: db}
+}
+
+func (r *PostgresWebhookRepository) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (url, events, secret)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	events := sub.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	return r.db.QueryRow(ctx, query, sub.URL, events, sub.Secret).Scan(&sub.ID, &sub.CreatedAt)
+}
+
+func (r *PostgresWebhookRepository) GetSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	query := `
+		SELECT id, url, events, secret, created_at
+		FROM webhook_subscriptions
+		ORDER BY id
+	`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []*models.WebhookSubscription
+	for rows.Next() {
+		var sub models.WebhookSubscription
+		if err := rows.Scan(&sub.ID, &sub.URL, &sub.Events, &sub.Secret, &sub.CreatedAt); err != nil {
+			return nil, err
+		}
+		subs = append(subs, &sub)
+	}
+
+	return subs, rows.Err()
+}
+
+func (r *PostgresWebhookRepository) DeleteSubscription(ctx context.Context, id int64) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *PostgresWebhookRepository) EnqueueDeliveries(ctx context.Context, limit int) (int, error) {
+	// SKIP LOCKED не даёт двум диспетчерам разослать одно событие дважды.
+	query := `
+		WITH batch AS (
+			SELECT id, type
+			FROM events
+			WHERE dispatched_at IS NULL
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), queued AS (
+			INSERT INTO webhook_deliveries (subscription_id, event_id)
+			SELECT s.id, b.id
+			FROM batch b
+			JOIN webhook_subscriptions s ON cardinality(s.events) = 0 OR b.type = ANY(s.events)
+		)
+		UPDATE events SET dispatched_at = CURRENT_TIMESTAMP
+		FROM batch
+		WHERE events.id = batch.id
+	`
+
+	result, err := r.db.Exec(ctx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+func (r *PostgresWebhookRepository) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	query := `
+		SELECT d.id, d.subscription_id, s.url, s.secret, d.attempts,
+			e.id, e.type, e.data, e.created_at
+		FROM webhook_deliveries d
+		JOIN webhook_subscriptions s ON s.id = d.subscription_id
+		JOIN events e ON e.id = d.event_id
+		WHERE d.next_attempt_at <= $1
+		ORDER BY d.next_attempt_at, d.id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		var delivery models.WebhookDelivery
+		var data []byte
+		err := rows.Scan(
+			&delivery.ID, &delivery.SubscriptionID, &delivery.URL, &delivery.Secret, &delivery.Attempts,
+			&delivery.Event.ID, &delivery.Event.Type, &data, &delivery.Event.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &delivery.Event.Data); err != nil {
+			return nil, fmt.Errorf("failed to decode event %d: %w", delivery.Event.ID, err)
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (r *PostgresWebhookRepository) MarkDelivered(ctx context.Context, id int64) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM webhook_deliveries WHERE id = $1`, id)
+	return err
+}
+
+func (r *PostgresWebhookRepository) ScheduleRetry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastError string) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET attempts = $2, next_attempt_at = $3, last_error = $4
+		WHERE id = $1
+	`
+
+	_, err := r.db.Exec(ctx, query, id, attempts, nextAttemptAt, lastError)
+	return err
+}
+
+func (r *PostgresWebhookRepository) MoveToDeadLetter(ctx context.Context, id int64, attempts int, lastError string) error {
+	query := `
+		WITH moved AS (
+			DELETE FROM webhook_deliveries WHERE id = $1
+			RETURNING subscription_id, event_id
+		)
+		INSERT INTO webhook_dead_letters (subscription_id, event_id, attempts, last_error)
+		SELECT subscription_id, event_id, $2, $3
+		FROM moved
+	`
+
+	_, err := r.db.Exec(ctx, query, id, attempts, lastError)
+	return err
+}
+
+func (r *PostgresWebhookRepository) GetDeadLetters(ctx context.Context, subscriptionID int64) ([]*models.WebhookDeadLetter, error) {
+	query := `
+		SELECT l.id, l.subscription_id, l.event_id, e.type, l.attempts, l.last_error, l.failed_at
+		FROM webhook_dead_letters l
+		JOIN events e ON e.id = l.event_id
+		WHERE $1::bigint = 0 OR l.subscription_id = $1
+		ORDER BY l.id
+	`
+
+	rows, err := r.db.Query(ctx, query, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var letters []*models.WebhookDeadLetter
+	for rows.Next() {
+		var letter models.WebhookDeadLetter
+		err := rows.Scan(
+			&letter.ID, &letter.SubscriptionID, &letter.EventID, &letter.EventType,
+			&letter.Attempts, &letter.LastError, &letter.FailedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		letters = append(letters, &letter)
+	}
+
+	return letters, rows.Err()
+}
+
+func (r *PostgresWebhookRepository) ReplayDeadLetters(ctx context.Context, subscriptionID int64, ids []int64) (int, error) {
+	// Переотправка начинается с новым счётчиком попыток.
+	query := `
+		WITH replayed AS (
+			DELETE FROM webhook_dead_letters
+			WHERE ($1::bigint = 0 OR subscription_id = $1)
+				AND (cardinality($2::bigint[]) = 0 OR id = ANY($2::bigint[]))
+			RETURNING subscription_id, event_id
+		)
+		INSERT INTO webhook_deliveries (subscription_id, event_id)
+		SELECT subscription_id, event_id
+		FROM replayed
+	`
+
+	if ids == nil {
+		ids = []int64{}
+	}
+
+	result, err := r.db.Exec(ctx, query, subscriptionID, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
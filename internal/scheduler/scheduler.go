@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Locker - блокировка лидера. При нескольких репликах задачи выполняет только та, что её удерживает.
+type Locker interface {
+	// TryLock захватывает блокировку или подтверждает, что она всё ещё удерживается.
+	TryLock(ctx context.Context) (bool, error)
+	Unlock(ctx context.Context) error
+}
+
+// Job - задача, выполняемая раз в Interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler выполняет фоновые задачи внутри процесса сервиса.
+type Scheduler struct {
+	locker Locker
+	jobs   []Job
+}
+
+// New создаёт планировщик. Без locker задачи выполняются в каждой реплике.
+func New(locker Locker) *Scheduler {
+	return &Scheduler{locker: locker}
+}
+
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Run запускает задачи и блокируется до отмены ctx, после чего отпускает блокировку лидера.
+func (s *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, job := range s.jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.loop(ctx, job)
+		}()
+	}
+	wg.Wait()
+
+	if s.locker != nil {
+		if err := s.locker.Unlock(context.Background()); err != nil {
+			log.Printf("Scheduler: failed to release leader lock: %v", err)
+		}
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runOnce(ctx, job)
+		}
+	}
+}
+
+// runOnce выполняет задачу, если реплика - лидер. Ошибки задачи не останавливают планировщик.
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	if s.locker != nil {
+		leader, err := s.locker.TryLock(ctx)
+		if err != nil {
+			log.Printf("Scheduler: leader election failed: %v", err)
+			return
+		}
+		if !leader {
+			return
+		}
+	}
+
+	if err := job.Run(ctx); err != nil {
+		log.Printf("Scheduler: job %s failed: %v", job.Name, err)
+	}
+}
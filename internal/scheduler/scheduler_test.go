@@ -0,0 +1,100 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeLocker struct {
+	mu       sync.Mutex
+	leader   bool
+	err      error
+	unlocked bool
+}
+
+func (l *fakeLocker) TryLock(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.leader, l.err
+}
+
+func (l *fakeLocker) Unlock(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.unlocked = true
+	return nil
+}
+
+func runFor(s *Scheduler, d time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	s.Run(ctx)
+}
+
+func TestScheduler_Run(t *testing.T) {
+	countingJob := func(runs *atomic.Int32) Job {
+		return Job{
+			Name:     "count",
+			Interval: 5 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				runs.Add(1)
+				return nil
+			},
+		}
+	}
+
+	t.Run("leader runs jobs and releases lock", func(t *testing.T) {
+		var runs atomic.Int32
+		locker := &fakeLocker{leader: true}
+		s := New(locker)
+		s.Add(countingJob(&runs))
+
+		runFor(s, 50*time.Millisecond)
+
+		assert.Greater(t, runs.Load(), int32(1))
+		assert.True(t, locker.unlocked)
+	})
+
+	t.Run("follower skips jobs", func(t *testing.T) {
+		var runs atomic.Int32
+		s := New(&fakeLocker{leader: false})
+		s.Add(countingJob(&runs))
+
+		runFor(s, 30*time.Millisecond)
+
+		assert.Zero(t, runs.Load())
+	})
+
+	t.Run("election error skips jobs", func(t *testing.T) {
+		var runs atomic.Int32
+		s := New(&fakeLocker{leader: true, err: errors.New("connection refused")})
+		s.Add(countingJob(&runs))
+
+		runFor(s, 30*time.Millisecond)
+
+		assert.Zero(t, runs.Load())
+	})
+
+	t.Run("failing job keeps running", func(t *testing.T) {
+		var runs atomic.Int32
+		s := New(nil)
+		s.Add(Job{
+			Name:     "failing",
+			Interval: 5 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				runs.Add(1)
+				return errors.New("boom")
+			},
+		})
+
+		runFor(s, 50*time.Millisecond)
+
+		assert.Greater(t, runs.Load(), int32(1))
+	})
+}
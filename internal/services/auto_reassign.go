@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+// maxAutoReassignPerPR ограничивает настройку лимита автоматических переназначений одного PR.
+const maxAutoReassignPerPR = 10
+
+type AutoReassignServiceImpl struct {
+	prRepo   repository.PullRequestRepository
+	teamRepo repository.TeamRepository
+	prSvc    PullRequestService
+	hours    BusinessHours
+}
+
+func NewAutoReassignService(
+	prRepo repository.PullRequestRepository,
+	teamRepo repository.TeamRepository,
+	prSvc PullRequestService,
+	hours BusinessHours,
+) *AutoReassignServiceImpl {
+	return &AutoReassignServiceImpl{
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		prSvc:    prSvc,
+		hours:    hours,
+	}
+}
+
+// ReassignStaleReviews переназначает ревьюверов, не ответивших к сроку SLA на открытые PR авторов
+// команд с включённым автопереназначением, так же как ReassignReviewer. Ответившие ревьюверы не
+// трогаются. Ревью без подходящей замены остаются за прежним ревьювером. Возвращает записи аудита
+// выполненных переназначений.
+func (s *AutoReassignServiceImpl) ReassignStaleReviews(ctx context.Context) ([]*models.AutoReassignment, error) {
+	assignments, err := s.prRepo.GetReviewAssignments(ctx, models.ReviewAssignmentFilter{OpenOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review assignments: %w", err)
+	}
+
+	teams := make(map[string]*models.Team)
+	counts := make(map[models.PullRequestRef]int)
+	records := []*models.AutoReassignment{}
+	now := time.Now()
+
+	for _, assignment := range assignments {
+		if assignment.RespondedAt != nil || assignment.TeamName == "" {
+			continue
+		}
+
+		state := s.hours.reviewSLAState(assignment.TeamSLA, assignment.Status, assignment.AssignedAt, nil, now)
+		if !state.Overdue {
+			continue
+		}
+
+		team, ok := teams[assignment.TeamName]
+		if !ok {
+			team, err = s.teamRepo.GetTeamByName(ctx, assignment.TeamName)
+			if err != nil {
+				return records, fmt.Errorf("failed to get team %s: %w", assignment.TeamName, err)
+			}
+			teams[assignment.TeamName] = team
+		}
+		if team == nil || !team.AutoReassign.Enabled {
+			continue
+		}
+
+		ref := models.PullRequestRef{Repository: assignment.Repository, PullRequestID: assignment.PullRequestID}
+		count, ok := counts[ref]
+		if !ok {
+			history, err := s.prRepo.GetAutoReassignments(ctx, ref.Repository, ref.PullRequestID)
+			if err != nil {
+				return records, fmt.Errorf("failed to get auto reassignments of %s/%s: %w", ref.Repository, ref.PullRequestID, err)
+			}
+			count = len(history)
+		}
+		counts[ref] = count
+		if count >= team.AutoReassign.MaxPerPR {
+			continue
+		}
+
+		_, replacedBy, err := s.prSvc.ReassignReviewer(ctx, ref.Repository, ref.PullRequestID, assignment.ReviewerID)
+		// PR мог измениться после выборки, а замены может не найтись - такие ревью пропускаются.
+		if errors.Is(err, ErrNoCandidate) || errors.Is(err, ErrPolicyViolation) || errors.Is(err, ErrUserWithoutTeam) ||
+			errors.Is(err, ErrNotAssigned) || errors.Is(err, ErrPRMerged) || errors.Is(err, ErrPRNotFound) {
+			continue
+		}
+		if err != nil {
+			return records, fmt.Errorf("failed to reassign review of %s/%s: %w", ref.Repository, ref.PullRequestID, err)
+		}
+
+		record := &models.AutoReassignment{
+			Repository:    ref.Repository,
+			PullRequestID: ref.PullRequestID,
+			OldReviewerID: assignment.ReviewerID,
+			NewReviewerID: replacedBy,
+			TeamName:      assignment.TeamName,
+			Reason:        autoReassignReason(state, now),
+			CreatedAt:     now,
+		}
+		err = s.prRepo.CreateAutoReassignment(ctx, record)
+		if err != nil {
+			return records, fmt.Errorf("failed to record auto reassignment of %s/%s: %w", ref.Repository, ref.PullRequestID, err)
+		}
+
+		counts[ref] = count + 1
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetAutoReassignments возвращает аудит автоматических переназначений PR.
+func (s *AutoReassignServiceImpl) GetAutoReassignments(ctx context.Context, repo, prID string) ([]*models.AutoReassignment, error) {
+	repo = repositoryOrDefault(repo)
+
+	exists, err := s.prRepo.PullRequestExists(ctx, repo, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check PR existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrPRNotFound
+	}
+
+	records, err := s.prRepo.GetAutoReassignments(ctx, repo, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auto reassignments: %w", err)
+	}
+	if records == nil {
+		records = []*models.AutoReassignment{}
+	}
+
+	return records, nil
+}
+
+// autoReassignReason - нарушенный срок: первый ответ, если он уже прошёл, иначе завершение ревью.
+func autoReassignReason(state models.SLAState, now time.Time) string {
+	if state.FirstResponseDueAt != nil && now.After(*state.FirstResponseDueAt) {
+		return models.AutoReassignFirstResponseOverdue
+	}
+	return models.AutoReassignCompletionOverdue
+}
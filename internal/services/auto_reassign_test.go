@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+// reassignFunc подменяет ReassignReviewer сервиса PR.
+type reassignFunc struct {
+	PullRequestService
+	reassign func(repo, prID, oldReviewerID string) (string, error)
+}
+
+func (f reassignFunc) ReassignReviewer(ctx context.Context, repo, prID string, oldReviewerID string) (*models.PullRequest, string, error) {
+	replacedBy, err := f.reassign(repo, prID, oldReviewerID)
+	return nil, replacedBy, err
+}
+
+func TestAutoReassignServiceImpl_ReassignStaleReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	ctx := context.Background()
+	hoursSLA := 1
+	sla := models.ReviewSLA{FirstResponseHours: &hoursSLA}
+	longAgo := time.Now().AddDate(0, 0, -7)
+	responded := time.Now()
+
+	stale := func(prID, reviewerID, team string) *models.ReviewAssignment {
+		return &models.ReviewAssignment{
+			Repository:    models.DefaultRepository,
+			PullRequestID: prID,
+			ReviewerID:    reviewerID,
+			TeamName:      team,
+			Status:        models.PRStatusOpen,
+			AssignedAt:    longAgo,
+			TeamSLA:       sla,
+		}
+	}
+
+	t.Run("reassigns stale reviews within limit", func(t *testing.T) {
+		answered := stale("pr1", "u4", "backend")
+		answered.RespondedAt = &responded
+		fresh := stale("pr3", "u2", "backend")
+		fresh.AssignedAt = time.Now()
+
+		mockPRRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{OpenOnly: true}).Return([]*models.ReviewAssignment{
+			stale("pr1", "u2", "backend"),
+			stale("pr1", "u3", "backend"),
+			answered,
+			fresh,
+			stale("pr2", "u2", "frontend"),
+		}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "backend").Return(&models.Team{
+			TeamName:     "backend",
+			AutoReassign: models.AutoReassignSettings{Enabled: true, MaxPerPR: 1},
+		}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "frontend").Return(&models.Team{
+			TeamName:     "frontend",
+			AutoReassign: models.AutoReassignSettings{MaxPerPR: 1},
+		}, nil)
+		mockPRRepo.EXPECT().GetAutoReassignments(ctx, models.DefaultRepository, "pr1").Return(nil, nil)
+		mockPRRepo.EXPECT().CreateAutoReassignment(ctx, gomock.Any()).Return(nil)
+
+		var reassigned []string
+		svc := NewAutoReassignService(mockPRRepo, mockTeamRepo, reassignFunc{reassign: func(repo, prID, oldReviewerID string) (string, error) {
+			reassigned = append(reassigned, prID+"/"+oldReviewerID)
+			return "u5", nil
+		}}, DefaultBusinessHours())
+
+		records, err := svc.ReassignStaleReviews(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pr1/u2"}, reassigned)
+		require.Len(t, records, 1)
+		assert.Equal(t, "u2", records[0].OldReviewerID)
+		assert.Equal(t, "u5", records[0].NewReviewerID)
+		assert.Equal(t, models.AutoReassignFirstResponseOverdue, records[0].Reason)
+	})
+
+	t.Run("limit reached and missing candidates are skipped", func(t *testing.T) {
+		mockPRRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{OpenOnly: true}).Return([]*models.ReviewAssignment{
+			stale("pr1", "u2", "backend"),
+			stale("pr2", "u2", "backend"),
+		}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "backend").Return(&models.Team{
+			TeamName:     "backend",
+			AutoReassign: models.AutoReassignSettings{Enabled: true, MaxPerPR: 2},
+		}, nil)
+		mockPRRepo.EXPECT().GetAutoReassignments(ctx, models.DefaultRepository, "pr1").Return([]*models.AutoReassignment{{ID: 1}, {ID: 2}}, nil)
+		mockPRRepo.EXPECT().GetAutoReassignments(ctx, models.DefaultRepository, "pr2").Return(nil, nil)
+
+		svc := NewAutoReassignService(mockPRRepo, mockTeamRepo, reassignFunc{reassign: func(repo, prID, oldReviewerID string) (string, error) {
+			assert.Equal(t, "pr2", prID)
+			return "", ErrNoCandidate
+		}}, DefaultBusinessHours())
+
+		records, err := svc.ReassignStaleReviews(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+}
+
+func TestAutoReassignServiceImpl_GetAutoReassignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	svc := NewAutoReassignService(mockPRRepo, mocks.NewMockTeamRepository(ctrl), nil, DefaultBusinessHours())
+
+	ctx := context.Background()
+
+	t.Run("empty history", func(t *testing.T) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(true, nil)
+		mockPRRepo.EXPECT().GetAutoReassignments(ctx, models.DefaultRepository, "pr1").Return(nil, nil)
+
+		records, err := svc.GetAutoReassignments(ctx, "", "pr1")
+
+		require.NoError(t, err)
+		assert.NotNil(t, records)
+		assert.Empty(t, records)
+	})
+
+	t.Run("pull request not found", func(t *testing.T) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "missing").Return(false, nil)
+
+		_, err := svc.GetAutoReassignments(ctx, "", "missing")
+
+		assert.ErrorIs(t, err, ErrPRNotFound)
+	})
+}
@@ -0,0 +1,368 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+type CodeOwnersServiceImpl struct {
+	ownersRepo repository.CodeOwnersRepository
+	repoRepo   repository.RepoRepository
+	userRepo   repository.UserRepository
+	teamRepo   repository.TeamRepository
+}
+
+func NewCodeOwnersService(
+	ownersRepo repository.CodeOwnersRepository,
+	repoRepo repository.RepoRepository,
+	userRepo repository.UserRepository,
+	teamRepo repository.TeamRepository,
+) *CodeOwnersServiceImpl {
+	return &CodeOwnersServiceImpl{
+		ownersRepo: ownersRepo,
+		repoRepo:   repoRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+	}
+}
+
+// UploadCodeOwners сохраняет новую версию файла владения для репозитория. Файл с
+// синтаксическими ошибками отклоняется; ссылки на неизвестных пользователей и команды
+// допускаются и выявляются ValidateCodeOwners.
+func (s *CodeOwnersServiceImpl) UploadCodeOwners(ctx context.Context, repo string, content string) (*models.CodeOwnersFile, error) {
+	repo = strings.TrimSpace(repo)
+	if repo == "" {
+		return nil, fmt.Errorf("%w: repository is required", ErrInvalidArgument)
+	}
+
+	rules, err := parseCodeOwners(content)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repoRepo.RepoExists(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check repository existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrRepoNotFound
+	}
+
+	file := &models.CodeOwnersFile{Repository: repo, Content: content}
+	err = s.ownersRepo.CreateCodeOwnersFile(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save code owners: %w", err)
+	}
+
+	file.Rules = ownershipRules(rules)
+	return file, nil
+}
+
+// GetCodeOwners возвращает версию version файла владения; version == 0 - последнюю.
+func (s *CodeOwnersServiceImpl) GetCodeOwners(ctx context.Context, repo string, version int) (*models.CodeOwnersFile, error) {
+	if version < 0 {
+		return nil, fmt.Errorf("%w: version must be positive", ErrInvalidArgument)
+	}
+
+	file, err := s.ownersRepo.GetCodeOwnersFile(ctx, repo, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code owners: %w", err)
+	}
+	if file == nil {
+		return nil, ErrCodeOwnersNotFound
+	}
+
+	rules, err := parseCodeOwners(file.Content)
+	if err != nil {
+		return nil, fmt.Errorf("stored code owners file is invalid: %v", err)
+	}
+
+	file.Rules = ownershipRules(rules)
+	return file, nil
+}
+
+// ValidateCodeOwners проверяет, что все владельцы из правил файла существуют.
+func (s *CodeOwnersServiceImpl) ValidateCodeOwners(ctx context.Context, repo string, version int) (*models.CodeOwnersValidation, error) {
+	file, err := s.GetCodeOwners(ctx, repo, version)
+	if err != nil {
+		return nil, err
+	}
+
+	validation := &models.CodeOwnersValidation{
+		Repository: file.Repository,
+		Version:    file.Version,
+		Issues:     []models.CodeOwnersIssue{},
+	}
+
+	known := make(map[string]bool)
+	for _, rule := range file.Rules {
+		for _, owner := range rule.Owners {
+			name, isTeam, _ := parseOwner(owner)
+			exists, checked := known[owner]
+			if !checked {
+				if isTeam {
+					exists, err = s.teamRepo.TeamExists(ctx, name)
+				} else {
+					exists, err = s.userRepo.UserExists(ctx, name)
+				}
+				if err != nil {
+					return nil, fmt.Errorf("failed to check owner %s: %w", owner, err)
+				}
+				known[owner] = exists
+			}
+			if exists {
+				continue
+			}
+
+			reason := models.CodeOwnersUnknownUser
+			if isTeam {
+				reason = models.CodeOwnersUnknownTeam
+			}
+			validation.Issues = append(validation.Issues, models.CodeOwnersIssue{Line: rule.Line, Owner: owner, Reason: reason})
+		}
+	}
+
+	validation.Valid = len(validation.Issues) == 0
+	return validation, nil
+}
+
+// codeOwnerReviewers возвращает обязательных ревьюверов PR - владельцев изменённых файлов
+// по последней версии файла владения репозитория. Владелец-пользователь назначается, если
+// он активен, не является автором и не исключён conflicts. За команду-владельца назначается один её
+// активный участник, если среди уже выбранных владельцев такого нет; rotation снижает шансы
+// недавних ревьюверов автора.
+func (s *PullRequestServiceImpl) codeOwnerReviewers(ctx context.Context, pr *models.PullRequest, conflicts reviewerConflicts, rotation rotationWeights) ([]*models.User, error) {
+	if len(pr.ChangedFiles) == 0 {
+		return nil, nil
+	}
+
+	file, err := s.ownersRepo.GetCodeOwnersFile(ctx, pr.Repository, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code owners: %w", err)
+	}
+	if file == nil {
+		return nil, nil
+	}
+
+	rules, err := parseCodeOwners(file.Content)
+	if err != nil {
+		return nil, fmt.Errorf("stored code owners file is invalid: %v", err)
+	}
+
+	var users, teams []string
+	seen := make(map[string]bool)
+	for _, path := range pr.ChangedFiles {
+		for _, owner := range matchOwners(rules, path) {
+			if seen[owner] {
+				continue
+			}
+			seen[owner] = true
+
+			name, isTeam, _ := parseOwner(owner)
+			if isTeam {
+				teams = append(teams, name)
+			} else {
+				users = append(users, name)
+			}
+		}
+	}
+
+	var selected []*models.User
+	taken := make(map[string]bool)
+	for _, userID := range users {
+		user, err := s.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get owner %s: %w", userID, err)
+		}
+		if user == nil || !user.IsActive || user.UserID == pr.AuthorID || !conflicts.allows(user.UserID) {
+			continue
+		}
+		selected = append(selected, user)
+		taken[user.UserID] = true
+	}
+
+	for _, team := range teams {
+		covered := false
+		for _, user := range selected {
+			covered, err = s.teamRepo.IsTeamMember(ctx, team, user.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check team membership: %w", err)
+			}
+			if covered {
+				break
+			}
+		}
+		if covered {
+			continue
+		}
+
+		candidates, err := s.reviewerCandidates(ctx, team, func(member *models.User) bool {
+			return member.UserID != pr.AuthorID && !taken[member.UserID] && conflicts.allows(member.UserID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		picked := candidates[s.pickWeighted(candidates, rotation)]
+		selected = append(selected, picked)
+		taken[picked.UserID] = true
+	}
+
+	return selected, nil
+}
+
+// ownershipRule - разобранное правило с путём, скомпилированным в регулярное выражение.
+type ownershipRule struct {
+	models.OwnershipRule
+	re *regexp.Regexp
+}
+
+// parseCodeOwners разбирает файл в формате CODEOWNERS: "шаблон владелец...". Пустые
+// строки и комментарии (#) пропускаются.
+func parseCodeOwners(content string) ([]ownershipRule, error) {
+	var rules []ownershipRule
+	for i, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		for j, field := range fields {
+			if strings.HasPrefix(field, "#") {
+				fields = fields[:j]
+				break
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		re, err := compileOwnershipPattern(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidArgument, i+1, err)
+		}
+
+		owners := fields[1:]
+		for _, owner := range owners {
+			if _, _, ok := parseOwner(owner); !ok {
+				return nil, fmt.Errorf("%w: line %d: invalid owner %q, expected @user_id or @org/team_name", ErrInvalidArgument, i+1, owner)
+			}
+		}
+
+		rules = append(rules, ownershipRule{
+			OwnershipRule: models.OwnershipRule{Line: i + 1, Pattern: fields[0], Owners: owners},
+			re:            re,
+		})
+	}
+
+	return rules, nil
+}
+
+func ownershipRules(rules []ownershipRule) []models.OwnershipRule {
+	result := make([]models.OwnershipRule, len(rules))
+	for i, rule := range rules {
+		result[i] = rule.OwnershipRule
+	}
+	return result
+}
+
+// parseOwner разбирает владельца: @user_id - пользователь, @org/team_name - команда.
+func parseOwner(owner string) (name string, isTeam bool, ok bool) {
+	name, found := strings.CutPrefix(owner, "@")
+	if !found || name == "" {
+		return "", false, false
+	}
+
+	if _, team, isTeam := strings.Cut(name, "/"); isTeam {
+		return team, true, team != ""
+	}
+	return name, false, true
+}
+
+// matchOwners возвращает владельцев пути по последнему подходящему правилу.
+func matchOwners(rules []ownershipRule, path string) []string {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].re.MatchString(path) {
+			return rules[i].Owners
+		}
+	}
+	return nil
+}
+
+// compileOwnershipPattern переводит шаблон в стиле gitignore в регулярное выражение:
+// "*" и "?" не пересекают границу каталога, "**" - пересекает. Шаблон без "/" (кроме
+// завершающего) совпадает на любой глубине, шаблон с "/" - от корня репозитория.
+// Совпадение с каталогом распространяется на всё его содержимое.
+func compileOwnershipPattern(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return nil, fmt.Errorf("negated pattern %q is not supported", pattern)
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	body := strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(body, "/")
+	body = strings.TrimPrefix(body, "/")
+	if body == "" {
+		return nil, fmt.Errorf("empty pattern %q", pattern)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	if !anchored {
+		sb.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(body); i++ {
+		switch {
+		case strings.HasPrefix(body[i:], "**/"):
+			sb.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(body[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case body[i] == '*':
+			sb.WriteString("[^/]*")
+		case body[i] == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(body[i : i+1]))
+		}
+	}
+
+	switch {
+	case dirOnly:
+		sb.WriteString("/.*")
+	case strings.HasSuffix(body, "/*") || body == "*":
+		// "dir/*" совпадает с файлами каталога, но не с вложенными каталогами.
+	default:
+		sb.WriteString("(?:/.*)?")
+	}
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
+
+// normalizeChangedFiles приводит пути изменённых файлов к виду относительно корня
+// репозитория, убирая повторы.
+func normalizeChangedFiles(files []string) ([]string, error) {
+	set := make(map[string]bool, len(files))
+	for _, file := range files {
+		path := strings.TrimLeft(strings.TrimPrefix(strings.TrimSpace(file), "./"), "/")
+		if path == "" {
+			return nil, fmt.Errorf("%w: changed file path must not be empty", ErrInvalidArgument)
+		}
+		set[path] = true
+	}
+
+	normalized := make([]string, 0, len(set))
+	for path := range set {
+		normalized = append(normalized, path)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestCompileOwnershipPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "internal/services/codeowners.go", true},
+		{"*.go", "README.md", false},
+		{"docs/", "docs/api/openapi.yml", true},
+		{"docs/", "internal/docs/notes.md", true},
+		{"docs/", "docs", false},
+		{"/migrations", "migrations/000001_initial_schema.up.sql", true},
+		{"/migrations", "tools/migrations/run.sh", false},
+		{"internal/*", "internal/main.go", true},
+		{"internal/*", "internal/services/codeowners.go", false},
+		{"internal/**/*_test.go", "internal/services/skills_test.go", true},
+		{"internal/**/*_test.go", "internal/skills_test.go", true},
+		{"cmd/server", "cmd/server/main.go", true},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file10.txt", false},
+	}
+
+	for _, tt := range tests {
+		re, err := compileOwnershipPattern(tt.pattern)
+		require.NoError(t, err, tt.pattern)
+		assert.Equal(t, tt.match, re.MatchString(tt.path), "%s ~ %s", tt.pattern, tt.path)
+	}
+}
+
+func TestParseCodeOwners(t *testing.T) {
+	rules, err := parseCodeOwners(`
+# default owners
+*           @lead
+/migrations/ @acme/dba   # inline comment
+docs/
+`)
+
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+	assert.Equal(t, models.OwnershipRule{Line: 3, Pattern: "*", Owners: []string{"@lead"}}, rules[0].OwnershipRule)
+	assert.Equal(t, []string{"@acme/dba"}, rules[1].Owners)
+	assert.Empty(t, rules[2].Owners)
+
+	assert.Equal(t, []string{"@acme/dba"}, matchOwners(rules, "migrations/000001_initial_schema.up.sql"))
+	assert.Equal(t, []string{"@lead"}, matchOwners(rules, "cmd/server/main.go"))
+	assert.Empty(t, matchOwners(rules, "docs/README.md"))
+
+	_, err = parseCodeOwners("*.go lead@example.com")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = parseCodeOwners("!vendor/ @lead")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestCodeOwnersServiceImpl_ValidateCodeOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOwnersRepo := mocks.NewMockCodeOwnersRepository(ctrl)
+	mockRepoRepo := mocks.NewMockRepoRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	svc := NewCodeOwnersService(mockOwnersRepo, mockRepoRepo, mockUserRepo, mockTeamRepo)
+	ctx := context.Background()
+
+	t.Run("reports unknown users and teams", func(t *testing.T) {
+		mockOwnersRepo.EXPECT().GetCodeOwnersFile(ctx, "backend", 2).Return(&models.CodeOwnersFile{
+			Repository: "backend",
+			Version:    2,
+			Content:    "*.go @u1 @ghost\n/migrations/ @acme/dba @acme/missing\n*.sql @ghost",
+		}, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "u1").Return(true, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "ghost").Return(false, nil)
+		mockTeamRepo.EXPECT().TeamExists(ctx, "dba").Return(true, nil)
+		mockTeamRepo.EXPECT().TeamExists(ctx, "missing").Return(false, nil)
+
+		validation, err := svc.ValidateCodeOwners(ctx, "backend", 2)
+
+		require.NoError(t, err)
+		assert.False(t, validation.Valid)
+		assert.Equal(t, []models.CodeOwnersIssue{
+			{Line: 1, Owner: "@ghost", Reason: models.CodeOwnersUnknownUser},
+			{Line: 2, Owner: "@acme/missing", Reason: models.CodeOwnersUnknownTeam},
+			{Line: 3, Owner: "@ghost", Reason: models.CodeOwnersUnknownUser},
+		}, validation.Issues)
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		mockOwnersRepo.EXPECT().GetCodeOwnersFile(ctx, "frontend", 0).Return(nil, nil)
+
+		_, err := svc.ValidateCodeOwners(ctx, "frontend", 0)
+
+		assert.ErrorIs(t, err, ErrCodeOwnersNotFound)
+	})
+
+	t.Run("upload rejects invalid file", func(t *testing.T) {
+		_, err := svc.UploadCodeOwners(ctx, "backend", "*.go owner-without-at")
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("upload to unknown repository", func(t *testing.T) {
+		mockRepoRepo.EXPECT().RepoExists(ctx, "mobile").Return(false, nil)
+
+		_, err := svc.UploadCodeOwners(ctx, "mobile", "*.go @u1")
+
+		assert.ErrorIs(t, err, ErrRepoNotFound)
+	})
+}
+
+func TestPullRequestServiceImpl_CodeOwnerReviewers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockTeamRepo.EXPECT().GetTeamPolicies(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockUserRepo.EXPECT().GetBlockedReviewers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+	mockOwnersRepo := mocks.NewMockCodeOwnersRepository(ctrl)
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), mockOwnersRepo, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+	author := &models.User{UserID: "author", TeamName: "team", IsActive: true}
+	ownersFile := &models.CodeOwnersFile{
+		Repository: "backend",
+		Version:    1,
+		Content:    "*.go @lead\n/migrations/ @acme/dba\n",
+	}
+
+	expectAuthor := func() {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, "backend", "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+	}
+
+	t.Run("owners of changed files are assigned", func(t *testing.T) {
+		pr := &models.PullRequest{
+			PullRequestID: "pr1",
+			AuthorID:      "author",
+			Repository:    "backend",
+			ChangedFiles:  []string{"./migrations/000008_code_owners.up.sql", "cmd/server/main.go", "README.md"},
+		}
+
+		expectAuthor()
+		mockOwnersRepo.EXPECT().GetCodeOwnersFile(ctx, "backend", 0).Return(ownersFile, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "lead").Return(&models.User{UserID: "lead", IsActive: true}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "dba", "lead").Return(false, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "dba").Return([]*models.User{{UserID: "dba1"}}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"lead", "dba1"}, created.AssignedReviewers)
+		assert.Equal(t, []string{"README.md", "cmd/server/main.go", "migrations/000008_code_owners.up.sql"}, created.ChangedFiles)
+	})
+
+	t.Run("author and inactive owners are skipped", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Repository: "backend", ChangedFiles: []string{"main.go"}}
+
+		expectAuthor()
+		mockOwnersRepo.EXPECT().GetCodeOwnersFile(ctx, "backend", 0).Return(&models.CodeOwnersFile{Content: "* @author @away"}, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "away").Return(&models.User{UserID: "away", IsActive: false}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{author, {UserID: "r1"}}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"r1"}, created.AssignedReviewers)
+	})
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+// reviewerConflicts - пользователи, которых нельзя назначать ревьюверами PR, с причиной исключения.
+type reviewerConflicts map[string]models.ReviewerExclusion
+
+func (c reviewerConflicts) allows(userID string) bool {
+	_, blocked := c[userID]
+	return !blocked
+}
+
+// exclusions возвращает исключения в порядке user_id.
+func (c reviewerConflicts) exclusions() []models.ReviewerExclusion {
+	exclusions := make([]models.ReviewerExclusion, 0, len(c))
+	for _, exclusion := range c {
+		exclusions = append(exclusions, exclusion)
+	}
+	sort.Slice(exclusions, func(i, j int) bool {
+		return exclusions[i].UserID < exclusions[j].UserID
+	})
+	return exclusions
+}
+
+// reviewerConflicts собирает действующие запреты пар для автора PR и исключения самого PR.
+func (s *PullRequestServiceImpl) reviewerConflicts(ctx context.Context, pr *models.PullRequest) (reviewerConflicts, error) {
+	pairs, err := s.userRepo.GetBlockedReviewers(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocked reviewers: %w", err)
+	}
+
+	conflicts := make(reviewerConflicts, len(pairs)+len(pr.ExcludedReviewers))
+	for _, pair := range pairs {
+		conflicts[pair.ReviewerID] = models.ReviewerExclusion{
+			UserID: pair.ReviewerID,
+			Reason: models.ExclusionBlockedPair,
+			Detail: pair.Reason,
+		}
+	}
+	for _, userID := range pr.ExcludedReviewers {
+		if _, ok := conflicts[userID]; !ok {
+			conflicts[userID] = models.ReviewerExclusion{UserID: userID, Reason: models.ExclusionExcluded}
+		}
+	}
+
+	return conflicts, nil
+}
+
+// normalizeExcludedReviewers убирает повторы из исключённых ревьюверов PR и проверяет,
+// что такие пользователи существуют.
+func (s *PullRequestServiceImpl) normalizeExcludedReviewers(ctx context.Context, pr *models.PullRequest) error {
+	set := make(map[string]bool, len(pr.ExcludedReviewers))
+	for _, userID := range pr.ExcludedReviewers {
+		userID = strings.TrimSpace(userID)
+		if userID == "" {
+			return fmt.Errorf("%w: excluded reviewer must not be empty", ErrInvalidArgument)
+		}
+		set[userID] = true
+	}
+
+	excluded := make([]string, 0, len(set))
+	for userID := range set {
+		if err := s.userSvc.ValidateUserExists(ctx, userID); err != nil {
+			return fmt.Errorf("invalid excluded reviewer %s: %w", userID, err)
+		}
+		excluded = append(excluded, userID)
+	}
+	sort.Strings(excluded)
+	pr.ExcludedReviewers = excluded
+
+	return nil
+}
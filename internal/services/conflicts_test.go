@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestPullRequestServiceImpl_ReviewerConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockTeamRepo.EXPECT().GetTeamPolicies(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+	author := &models.User{UserID: "author", TeamName: "team", IsActive: true}
+	members := []*models.User{author, {UserID: "manager"}, {UserID: "coauthor"}, {UserID: "r1"}, {UserID: "r2"}}
+	blocked := []*models.BlockedPair{{AuthorID: "author", ReviewerID: "manager", Reason: "performance review"}}
+
+	expectAssignment := func() {
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "coauthor").Return(true, nil)
+		mockUserRepo.EXPECT().GetBlockedReviewers(ctx, "author").Return(blocked, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return(members, nil)
+	}
+
+	t.Run("create skips blocked and excluded users", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", ExcludedReviewers: []string{"coauthor", " coauthor"}}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		expectAssignment()
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"r1", "r2"}, created.AssignedReviewers)
+		assert.Equal(t, []string{"coauthor"}, created.ExcludedReviewers)
+	})
+
+	t.Run("dry run cites exclusions", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", ExcludedReviewers: []string{"coauthor"}}
+
+		expectAssignment()
+
+		preview, err := prSvc.DryRunPullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"r1", "r2"}, preview.PullRequest.AssignedReviewers)
+		assert.Equal(t, []models.ReviewerExclusion{
+			{UserID: "coauthor", Reason: models.ExclusionExcluded},
+			{UserID: "manager", Reason: models.ExclusionBlockedPair, Detail: "performance review"},
+		}, preview.Excluded)
+	})
+
+	t.Run("unknown excluded reviewer", func(t *testing.T) {
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "ghost").Return(false, nil)
+
+		_, err := prSvc.DryRunPullRequest(ctx, &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", ExcludedReviewers: []string{"ghost"}})
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("reassign skips blocked and excluded users", func(t *testing.T) {
+		pr := &models.PullRequest{
+			PullRequestID:     "pr1",
+			AuthorID:          "author",
+			Status:            models.PRStatusOpen,
+			AssignedReviewers: []string{"r1", "r2"},
+			ExcludedReviewers: []string{"coauthor"},
+		}
+
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(pr, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "r1").Return(&models.User{UserID: "r1", TeamName: "team"}, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().GetBlockedReviewers(ctx, "author").Return(blocked, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return(members, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "team").Return(&models.Team{TeamName: "team"}, nil)
+
+		_, _, err := prSvc.ReassignReviewer(ctx, "", "pr1", "r1")
+
+		assert.ErrorIs(t, err, ErrNoCandidate)
+	})
+}
+
+func TestUserServiceImpl_BlockPair(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	userSvc := NewUserService(mockRepo)
+
+	ctx := context.Background()
+
+	t.Run("mutual pair", func(t *testing.T) {
+		mockRepo.EXPECT().UserExists(ctx, "u1").Return(true, nil).Times(2)
+		mockRepo.EXPECT().UserExists(ctx, "u2").Return(true, nil)
+		mockRepo.EXPECT().CreateBlockedPair(ctx, &models.BlockedPair{AuthorID: "u1", ReviewerID: "u2", Reason: "co-authors"}).Return(nil)
+		mockRepo.EXPECT().CreateBlockedPair(ctx, &models.BlockedPair{AuthorID: "u2", ReviewerID: "u1", Reason: "co-authors"}).Return(nil)
+		mockRepo.EXPECT().GetBlockedPairs(ctx, "u1").Return(nil, nil)
+
+		pairs, err := userSvc.BlockPair(ctx, &models.BlockedPair{AuthorID: "u1", ReviewerID: "u2", Reason: " co-authors "}, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, "u1", pairs.UserID)
+		assert.NotNil(t, pairs.BlockedPairs)
+	})
+
+	t.Run("invalid pairs", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+
+		_, err := userSvc.BlockPair(ctx, &models.BlockedPair{AuthorID: "u1", ReviewerID: "u1"}, false)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+
+		_, err = userSvc.BlockPair(ctx, &models.BlockedPair{AuthorID: "u1", ReviewerID: "u2", ExpiresAt: &past}, false)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("unblock missing pair", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteBlockedPair(ctx, "u1", "u3").Return(sql.ErrNoRows)
+
+		_, err := userSvc.UnblockPair(ctx, "u1", "u3", false)
+
+		assert.ErrorIs(t, err, ErrBlockedPairNotFound)
+	})
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+// encodeCursor превращает позицию последнего элемента страницы в непрозрачную строку.
+func encodeCursor(repository, id string, createdAt *time.Time) string {
+	cursor := models.PullRequestCursor{Repository: repository, ID: id}
+	if createdAt != nil {
+		cursor.CreatedAt = *createdAt
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value string) (*models.PullRequestCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidArgument)
+	}
+
+	var cursor models.PullRequestCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidArgument)
+	}
+
+	return &cursor, nil
+}
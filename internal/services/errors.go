@@ -0,0 +1,35 @@
+package services
+
+import "errors"
+
+// Доменные ошибки сервисного слоя. Хендлеры сопоставляют их с HTTP-статусами
+// и кодами ошибок из openapi.yml через errors.Is.
+var (
+	ErrTeamExists      = errors.New("team already exists")
+	ErrTeamNotFound    = errors.New("team not found")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrUserWithoutTeam = errors.New("user is not assigned to any team")
+	ErrPRExists        = errors.New("pull request already exists")
+	ErrPRNotFound      = errors.New("pull request not found")
+	ErrPRMerged        = errors.New("pull request is already merged")
+	ErrNotAssigned     = errors.New("reviewer is not assigned to this pull request")
+	ErrNoCandidate     = errors.New("no candidate reviewers available")
+	ErrInvalidArgument = errors.New("invalid argument")
+
+	ErrNotTeamMember  = errors.New("user is not a member of this team")
+	ErrTeamHasOpenPRs = errors.New("team has open pull requests")
+
+	ErrCodeOwnersNotFound = errors.New("code owners file not found")
+	ErrRepoExists         = errors.New("repository already exists")
+	ErrRepoNotFound       = errors.New("repository not found")
+
+	ErrPolicyViolation = errors.New("reviewer level requirement cannot be satisfied")
+
+	ErrBlockedPairNotFound = errors.New("blocked pair not found")
+
+	ErrWebhookNotFound = errors.New("webhook subscription not found")
+
+	ErrInvalidSignature    = errors.New("invalid webhook signature")
+	ErrGitUserNotMapped    = errors.New("git host user is not mapped to a user")
+	ErrGitIdentityNotFound = errors.New("git user mapping not found")
+)
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+const eventStreamBatchSize = 100
+
+// EventStreamServiceImpl отдаёт клиентам события из журнала outbox по мере их записи.
+// Журнал общий для всех экземпляров сервиса, поэтому поток видит события любого из них.
+type EventStreamServiceImpl struct {
+	eventRepo    repository.EventRepository
+	teamRepo     repository.TeamRepository
+	userRepo     repository.UserRepository
+	pollInterval time.Duration
+}
+
+func NewEventStreamService(
+	eventRepo repository.EventRepository,
+	teamRepo repository.TeamRepository,
+	userRepo repository.UserRepository,
+	pollInterval time.Duration,
+) *EventStreamServiceImpl {
+	return &EventStreamServiceImpl{
+		eventRepo:    eventRepo,
+		teamRepo:     teamRepo,
+		userRepo:     userRepo,
+		pollInterval: pollInterval,
+	}
+}
+
+// Stream передаёт в send подходящие под фильтр события, пока не отменён ctx. lastEventID
+// продолжает поток после этого события; nil - только события, записанные после подключения.
+// send вызывается после каждого опроса журнала, в том числе с пустым списком, и сразу
+// после проверки фильтра: ошибка до первого вызова send означает, что поток не открыт.
+func (s *EventStreamServiceImpl) Stream(ctx context.Context, filter models.EventStreamFilter, lastEventID *int64, send func([]*models.Event) error) error {
+	if err := s.validateFilter(ctx, filter); err != nil {
+		return err
+	}
+
+	var cursor int64
+	if lastEventID != nil {
+		cursor = *lastEventID
+	} else {
+		id, err := s.eventRepo.GetLastEventID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get last event: %w", err)
+		}
+		cursor = id
+	}
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			events, err := s.eventRepo.GetEventsAfter(ctx, cursor, eventStreamBatchSize)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("failed to get events: %w", err)
+			}
+
+			matched, err := s.match(ctx, filter, events)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			if err := send(matched); err != nil {
+				return err
+			}
+
+			// Курсор сдвигается и по отброшенным фильтром событиям, чтобы не читать их снова.
+			if len(events) > 0 {
+				cursor = events[len(events)-1].ID
+			}
+			if len(events) < eventStreamBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *EventStreamServiceImpl) validateFilter(ctx context.Context, filter models.EventStreamFilter) error {
+	if filter.TeamName != "" {
+		exists, err := s.teamRepo.TeamExists(ctx, filter.TeamName)
+		if err != nil {
+			return fmt.Errorf("failed to check team existence: %w", err)
+		}
+		if !exists {
+			return ErrTeamNotFound
+		}
+	}
+
+	if filter.UserID != "" {
+		exists, err := s.userRepo.UserExists(ctx, filter.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to check user existence: %w", err)
+		}
+		if !exists {
+			return ErrUserNotFound
+		}
+	}
+
+	return nil
+}
+
+// match отбирает события под фильтр. Состав команды читается заново для каждой пачки
+// событий, чтобы поток учитывал вступивших в команду и покинувших её.
+func (s *EventStreamServiceImpl) match(ctx context.Context, filter models.EventStreamFilter, events []*models.Event) ([]*models.Event, error) {
+	if len(events) == 0 || (filter.TeamName == "" && filter.UserID == "") {
+		return events, nil
+	}
+
+	var members map[string]bool
+	if filter.TeamName != "" {
+		team, err := s.teamRepo.GetTeamWithMembers(ctx, filter.TeamName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team members: %w", err)
+		}
+		if team == nil {
+			return nil, ErrTeamNotFound
+		}
+		members = make(map[string]bool, len(team.Members))
+		for _, member := range team.Members {
+			members[member.UserID] = true
+		}
+	}
+
+	var matched []*models.Event
+	for _, event := range events {
+		participants := eventParticipants(event)
+		if filter.UserID != "" && !participants[filter.UserID] {
+			continue
+		}
+		if members != nil && !intersects(participants, members) {
+			continue
+		}
+		matched = append(matched, event)
+	}
+
+	return matched, nil
+}
+
+// eventParticipants - автор PR и ревьюверы, которых касается событие, включая снятого ревьювера.
+func eventParticipants(event *models.Event) map[string]bool {
+	participants := map[string]bool{event.Data.AuthorID: true}
+	for _, reviewerID := range event.Data.Reviewers {
+		participants[reviewerID] = true
+	}
+	for _, userID := range []string{event.Data.OldReviewerID, event.Data.NewReviewerID, event.Data.ReviewerID} {
+		if userID != "" {
+			participants[userID] = true
+		}
+	}
+	return participants
+}
+
+func intersects(a, b map[string]bool) bool {
+	for key := range a {
+		if b[key] {
+			return true
+		}
+	}
+	return false
+}
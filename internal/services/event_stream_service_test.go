@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestEventStreamServiceImpl_Stream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	svc := NewEventStreamService(mockEventRepo, mockTeamRepo, mockUserRepo, time.Millisecond)
+
+	assigned := &models.Event{ID: 6, Type: models.EventReviewersAssigned, Data: models.EventData{AuthorID: "u1", Reviewers: []string{"u2"}}}
+	other := &models.Event{ID: 7, Type: models.EventReviewersAssigned, Data: models.EventData{AuthorID: "u5", Reviewers: []string{"u6"}}}
+	reassigned := &models.Event{ID: 8, Type: models.EventReviewerReassigned, Data: models.EventData{AuthorID: "u5", Reviewers: []string{"u3"}, OldReviewerID: "u2", NewReviewerID: "u3"}}
+
+	// collect читает поток, пока не получит want событий.
+	collect := func(filter models.EventStreamFilter, lastEventID *int64, want int) ([]*models.Event, error) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var received []*models.Event
+		err := svc.Stream(ctx, filter, lastEventID, func(events []*models.Event) error {
+			received = append(received, events...)
+			if len(received) >= want {
+				cancel()
+			}
+			return nil
+		})
+		return received, err
+	}
+
+	t.Run("resumes after last event id and filters by user", func(t *testing.T) {
+		lastEventID := int64(5)
+		mockUserRepo.EXPECT().UserExists(gomock.Any(), "u2").Return(true, nil)
+		gomock.InOrder(
+			mockEventRepo.EXPECT().GetEventsAfter(gomock.Any(), int64(5), eventStreamBatchSize).Return([]*models.Event{assigned, other}, nil),
+			mockEventRepo.EXPECT().GetEventsAfter(gomock.Any(), int64(7), eventStreamBatchSize).Return([]*models.Event{reassigned}, nil),
+		)
+
+		received, err := collect(models.EventStreamFilter{UserID: "u2"}, &lastEventID, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*models.Event{assigned, reassigned}, received)
+	})
+
+	t.Run("starts after latest event without last event id", func(t *testing.T) {
+		mockEventRepo.EXPECT().GetLastEventID(gomock.Any()).Return(int64(7), nil)
+		mockEventRepo.EXPECT().GetEventsAfter(gomock.Any(), int64(7), eventStreamBatchSize).Return([]*models.Event{reassigned}, nil)
+
+		received, err := collect(models.EventStreamFilter{}, nil, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*models.Event{reassigned}, received)
+	})
+
+	t.Run("filters by team members", func(t *testing.T) {
+		lastEventID := int64(5)
+		mockTeamRepo.EXPECT().TeamExists(gomock.Any(), "backend").Return(true, nil)
+		mockEventRepo.EXPECT().GetEventsAfter(gomock.Any(), int64(5), eventStreamBatchSize).Return([]*models.Event{assigned, other, reassigned}, nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(gomock.Any(), "backend").Return(&models.Team{
+			TeamName: "backend",
+			Members:  []models.TeamMember{{UserID: "u3"}, {UserID: "u6"}},
+		}, nil)
+
+		received, err := collect(models.EventStreamFilter{TeamName: "backend"}, &lastEventID, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*models.Event{other, reassigned}, received)
+	})
+
+	t.Run("unknown team is rejected before streaming", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(gomock.Any(), "ghosts").Return(false, nil)
+
+		err := svc.Stream(context.Background(), models.EventStreamFilter{TeamName: "ghosts"}, nil, func([]*models.Event) error {
+			t.Fatal("stream must not be opened")
+			return nil
+		})
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+// SetOutbox включает запись событий назначений: событие сохраняется в одной транзакции
+// с изменением PR и не теряется, если рассылка подписчикам отстаёт или падает.
+func (s *PullRequestServiceImpl) SetOutbox(tx repository.Transactor, events repository.EventRepository) {
+	s.tx, s.events = tx, events
+}
+
+// inTransaction выполняет fn в транзакции. Без outbox fn выполняется как есть.
+func (s *PullRequestServiceImpl) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.tx == nil {
+		return fn(ctx)
+	}
+	return s.tx.WithinTransaction(ctx, fn)
+}
+
+// enqueue сохраняет события в outbox. Без outbox события отбрасываются.
+func (s *PullRequestServiceImpl) enqueue(ctx context.Context, events ...*models.Event) error {
+	if s.events == nil {
+		return nil
+	}
+	if err := s.events.AddEvents(ctx, events); err != nil {
+		return fmt.Errorf("failed to save events: %w", err)
+	}
+	return nil
+}
+
+func newPullRequestEvent(eventType string, pr *models.PullRequest) *models.Event {
+	return &models.Event{
+		Type: eventType,
+		Data: models.EventData{
+			Repository:      pr.Repository,
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			Reviewers:       append([]string(nil), pr.AssignedReviewers...),
+		},
+	}
+}
+
+func newReassignedEvent(pr *models.PullRequest, oldReviewerID, newReviewerID string) *models.Event {
+	event := newPullRequestEvent(models.EventReviewerReassigned, pr)
+	event.Data.OldReviewerID, event.Data.NewReviewerID = oldReviewerID, newReviewerID
+	return event
+}
+
+func newReviewSubmittedEvent(pr *models.PullRequest, reviewerID, verdict string) *models.Event {
+	event := newPullRequestEvent(models.EventReviewSubmitted, pr)
+	event.Data.ReviewerID, event.Data.Verdict = reviewerID, verdict
+	return event
+}
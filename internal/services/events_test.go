@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestPullRequestServiceImpl_Outbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockTx := mocks.NewMockTransactor(ctrl)
+	mockEvents := mocks.NewMockEventRepository(ctrl)
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+	prSvc.SetOutbox(mockTx, mockEvents)
+
+	ctx := context.Background()
+	mockTx.EXPECT().WithinTransaction(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+
+	merged := &models.PullRequest{
+		Repository:        models.DefaultRepository,
+		PullRequestID:     "pr1",
+		AuthorID:          "u1",
+		Status:            models.PRStatusMerged,
+		AssignedReviewers: []string{"u2", "u3"},
+	}
+
+	t.Run("merge emits event only on transition", func(t *testing.T) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(true, nil).Times(2)
+		mockPRRepo.EXPECT().MergePullRequest(ctx, models.DefaultRepository, "pr1").Return(true, nil)
+		mockPRRepo.EXPECT().MergePullRequest(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(merged, nil).Times(2)
+		mockEvents.EXPECT().AddEvents(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []*models.Event) error {
+			require.Len(t, events, 1)
+			assert.Equal(t, models.EventPullRequestMerged, events[0].Type)
+			assert.Equal(t, "pr1", events[0].Data.PullRequestID)
+			assert.Equal(t, []string{"u2", "u3"}, events[0].Data.Reviewers)
+			return nil
+		})
+
+		_, err := prSvc.MergePullRequest(ctx, "", "pr1")
+		require.NoError(t, err)
+		_, err = prSvc.MergePullRequest(ctx, "", "pr1")
+		require.NoError(t, err)
+	})
+
+	t.Run("review verdict emits event", func(t *testing.T) {
+		openPR := &models.PullRequest{Repository: models.DefaultRepository, PullRequestID: "pr1", AuthorID: "u1", Status: models.PRStatusOpen, AssignedReviewers: []string{"u2"}}
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(openPR, nil)
+		mockPRRepo.EXPECT().SetReviewResponded(ctx, models.DefaultRepository, "pr1", "u2", gomock.Any(), models.ReviewVerdictChangesRequested).Return(nil)
+		mockEvents.EXPECT().AddEvents(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []*models.Event) error {
+			require.Len(t, events, 1)
+			assert.Equal(t, models.EventReviewSubmitted, events[0].Type)
+			assert.Equal(t, "u2", events[0].Data.ReviewerID)
+			assert.Equal(t, models.ReviewVerdictChangesRequested, events[0].Data.Verdict)
+			return nil
+		})
+		mockPRRepo.EXPECT().GetReviewAssignments(ctx, gomock.Any()).Return([]*models.ReviewAssignment{{PullRequestID: "pr1", ReviewerID: "u2", Verdict: models.ReviewVerdictChangesRequested}}, nil)
+
+		assignment, err := prSvc.RecordReviewResponse(ctx, "", "pr1", "u2", models.ReviewVerdictChangesRequested)
+		require.NoError(t, err)
+		assert.Equal(t, models.ReviewVerdictChangesRequested, assignment.Verdict)
+
+		_, err = prSvc.RecordReviewResponse(ctx, "", "pr1", "u2", "lgtm")
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("event write failure fails the merge", func(t *testing.T) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(true, nil)
+		mockPRRepo.EXPECT().MergePullRequest(ctx, models.DefaultRepository, "pr1").Return(true, nil)
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(merged, nil)
+		mockEvents.EXPECT().AddEvents(ctx, gomock.Any()).Return(errors.New("connection reset"))
+
+		pr, err := prSvc.MergePullRequest(ctx, "", "pr1")
+		assert.Error(t, err)
+		assert.Nil(t, pr)
+	})
+}
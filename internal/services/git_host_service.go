@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+// GitHostSecrets - секреты входящих вебхуков: GitHub подписывает тело HMAC-SHA256,
+// GitLab передаёт токен как есть. Пустой секрет отключает приём событий хостинга.
+type GitHostSecrets struct {
+	GitHub string
+	GitLab string
+}
+
+type GitHostServiceImpl struct {
+	prSvc        PullRequestService
+	identityRepo repository.GitIdentityRepository
+	userRepo     repository.UserRepository
+	secrets      GitHostSecrets
+}
+
+func NewGitHostService(
+	prSvc PullRequestService,
+	identityRepo repository.GitIdentityRepository,
+	userRepo repository.UserRepository,
+	secrets GitHostSecrets,
+) *GitHostServiceImpl {
+	return &GitHostServiceImpl{
+		prSvc:        prSvc,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		secrets:      secrets,
+	}
+}
+
+// githubPullRequestEvent - поля события pull_request GitHub, которые использует сервис.
+type githubPullRequestEvent struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Number       int    `json:"number"`
+		Title        string `json:"title"`
+		Body         string `json:"body"`
+		HTMLURL      string `json:"html_url"`
+		Draft        bool   `json:"draft"`
+		Merged       bool   `json:"merged"`
+		Additions    int    `json:"additions"`
+		Deletions    int    `json:"deletions"`
+		ChangedFiles int    `json:"changed_files"`
+		User         struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		Labels []struct {
+			Name string `json:"name"`
+		} `json:"labels"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// gitlabMergeRequestEvent - поля события Merge Request Hook GitLab, которые использует сервис.
+type gitlabMergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		Title          string `json:"title"`
+		Description    string `json:"description"`
+		URL            string `json:"url"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Labels []struct {
+		Title string `json:"title"`
+	} `json:"labels"`
+	Changes map[string]struct {
+		Previous any `json:"previous"`
+		Current  any `json:"current"`
+	} `json:"changes"`
+}
+
+// HandleGitHub обрабатывает вебхук GitHub: opened создаёт PR (черновик - без ревьюверов),
+// ready_for_review назначает ревьюверов черновику, closed со смерженным PR мержит его.
+// Остальные события пропускаются. Тело проверяется по заголовку X-Hub-Signature-256.
+func (s *GitHostServiceImpl) HandleGitHub(ctx context.Context, eventType, signature string, body []byte) (*models.GitHostResult, error) {
+	if s.secrets.GitHub == "" {
+		return nil, fmt.Errorf("%w: github webhooks are not configured", ErrInvalidSignature)
+	}
+	if !hmac.Equal([]byte(signature), []byte(SignWebhookPayload(s.secrets.GitHub, body))) {
+		return nil, ErrInvalidSignature
+	}
+	if eventType != "pull_request" {
+		return ignored(fmt.Sprintf("event %q is not handled", eventType)), nil
+	}
+
+	var event githubPullRequestEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("%w: malformed github payload: %v", ErrInvalidArgument, err)
+	}
+	repo, prID := event.Repository.FullName, strconv.Itoa(event.PullRequest.Number)
+
+	switch {
+	case event.Action == "opened":
+		authorID, err := s.resolveUser(ctx, models.GitProviderGitHub, event.PullRequest.User.Login)
+		if err != nil {
+			return nil, err
+		}
+		labels := make([]string, 0, len(event.PullRequest.Labels))
+		for _, label := range event.PullRequest.Labels {
+			labels = append(labels, label.Name)
+		}
+		return s.create(ctx, &models.PullRequest{
+			PullRequestID:   prID,
+			PullRequestName: event.PullRequest.Title,
+			AuthorID:        authorID,
+			Status:          models.PRStatusOpen,
+			Repository:      repo,
+			URL:             event.PullRequest.HTMLURL,
+			Description:     event.PullRequest.Body,
+			Labels:          labels,
+			LinesAdded:      event.PullRequest.Additions,
+			LinesRemoved:    event.PullRequest.Deletions,
+			FilesChanged:    event.PullRequest.ChangedFiles,
+			Draft:           event.PullRequest.Draft,
+		})
+	case event.Action == "ready_for_review":
+		return s.markReady(ctx, repo, prID)
+	case event.Action == "closed" && event.PullRequest.Merged:
+		return s.merge(ctx, repo, prID)
+	default:
+		return ignored(fmt.Sprintf("action %q is not handled", event.Action)), nil
+	}
+}
+
+// HandleGitLab обрабатывает вебхук GitLab так же, как HandleGitHub: open создаёт PR, merge
+// мержит его, update со снятой отметкой черновика назначает ревьюверов. Токен из
+// заголовка X-Gitlab-Token сравнивается с секретом.
+func (s *GitHostServiceImpl) HandleGitLab(ctx context.Context, eventType, token string, body []byte) (*models.GitHostResult, error) {
+	if s.secrets.GitLab == "" {
+		return nil, fmt.Errorf("%w: gitlab webhooks are not configured", ErrInvalidSignature)
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.secrets.GitLab)) != 1 {
+		return nil, ErrInvalidSignature
+	}
+	if eventType != "Merge Request Hook" {
+		return ignored(fmt.Sprintf("event %q is not handled", eventType)), nil
+	}
+
+	var event gitlabMergeRequestEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("%w: malformed gitlab payload: %v", ErrInvalidArgument, err)
+	}
+	attrs := event.ObjectAttributes
+	repo, prID := event.Project.PathWithNamespace, strconv.Itoa(attrs.IID)
+
+	switch attrs.Action {
+	case "open":
+		authorID, err := s.resolveUser(ctx, models.GitProviderGitLab, event.User.Username)
+		if err != nil {
+			return nil, err
+		}
+		labels := make([]string, 0, len(event.Labels))
+		for _, label := range event.Labels {
+			labels = append(labels, label.Title)
+		}
+		return s.create(ctx, &models.PullRequest{
+			PullRequestID:   prID,
+			PullRequestName: attrs.Title,
+			AuthorID:        authorID,
+			Status:          models.PRStatusOpen,
+			Repository:      repo,
+			URL:             attrs.URL,
+			Description:     attrs.Description,
+			Labels:          labels,
+			Draft:           attrs.Draft || attrs.WorkInProgress,
+		})
+	case "merge":
+		return s.merge(ctx, repo, prID)
+	case "update":
+		if event.undrafted() {
+			return s.markReady(ctx, repo, prID)
+		}
+		return ignored("merge request update is not handled"), nil
+	default:
+		return ignored(fmt.Sprintf("action %q is not handled", attrs.Action)), nil
+	}
+}
+
+// undrafted сообщает, что обновление снимает с merge request отметку черновика.
+// Старые версии GitLab присылают её как work_in_progress.
+func (e *gitlabMergeRequestEvent) undrafted() bool {
+	for _, key := range []string{"draft", "work_in_progress"} {
+		if change, ok := e.Changes[key]; ok && change.Previous == true && change.Current == false {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *GitHostServiceImpl) create(ctx context.Context, pr *models.PullRequest) (*models.GitHostResult, error) {
+	created, err := s.prSvc.CreatePullRequest(ctx, pr)
+	// Git-хостинг повторяет доставку события, если не дождался ответа.
+	if errors.Is(err, ErrPRExists) {
+		return &models.GitHostResult{Action: models.GitHostActionIgnored, Reason: "pull request already exists", PullRequest: created}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.GitHostResult{Action: models.GitHostActionCreated, PullRequest: created}, nil
+}
+
+func (s *GitHostServiceImpl) markReady(ctx context.Context, repo, prID string) (*models.GitHostResult, error) {
+	pr, err := s.prSvc.MarkReadyForReview(ctx, repo, prID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.GitHostResult{Action: models.GitHostActionReadyForReview, PullRequest: pr}, nil
+}
+
+func (s *GitHostServiceImpl) merge(ctx context.Context, repo, prID string) (*models.GitHostResult, error) {
+	pr, err := s.prSvc.MergePullRequest(ctx, repo, prID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.GitHostResult{Action: models.GitHostActionMerged, PullRequest: pr}, nil
+}
+
+func ignored(reason string) *models.GitHostResult {
+	return &models.GitHostResult{Action: models.GitHostActionIgnored, Reason: reason}
+}
+
+// resolveUser возвращает пользователя, связанного с логином автора на Git-хостинге.
+func (s *GitHostServiceImpl) resolveUser(ctx context.Context, provider, username string) (string, error) {
+	userID, err := s.identityRepo.GetUserIDByGitUsername(ctx, provider, username)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve git user: %w", err)
+	}
+	if userID == "" {
+		return "", fmt.Errorf("%w: %s user %q", ErrGitUserNotMapped, provider, username)
+	}
+	return userID, nil
+}
+
+// MapUser связывает логин на Git-хостинге с пользователем; прежняя связь логина заменяется.
+func (s *GitHostServiceImpl) MapUser(ctx context.Context, identity *models.GitIdentity) (*models.GitIdentity, error) {
+	if err := validateGitProvider(identity.Provider); err != nil {
+		return nil, err
+	}
+	identity.Username = strings.TrimSpace(identity.Username)
+	if identity.Username == "" {
+		return nil, fmt.Errorf("%w: username is required", ErrInvalidArgument)
+	}
+
+	exists, err := s.userRepo.UserExists(ctx, identity.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	err = s.identityRepo.SetGitIdentity(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map git user: %w", err)
+	}
+
+	return identity, nil
+}
+
+func (s *GitHostServiceImpl) UnmapUser(ctx context.Context, provider, username string) error {
+	if err := validateGitProvider(provider); err != nil {
+		return err
+	}
+
+	err := s.identityRepo.DeleteGitIdentity(ctx, provider, strings.TrimSpace(username))
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrGitIdentityNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unmap git user: %w", err)
+	}
+
+	return nil
+}
+
+// ListMappings возвращает связи логинов; пустой provider - всех Git-хостингов.
+func (s *GitHostServiceImpl) ListMappings(ctx context.Context, provider string) ([]*models.GitIdentity, error) {
+	if provider != "" {
+		if err := validateGitProvider(provider); err != nil {
+			return nil, err
+		}
+	}
+
+	identities, err := s.identityRepo.GetGitIdentities(ctx, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git users: %w", err)
+	}
+	if identities == nil {
+		identities = []*models.GitIdentity{}
+	}
+
+	return identities, nil
+}
+
+func validateGitProvider(provider string) error {
+	switch provider {
+	case models.GitProviderGitHub, models.GitProviderGitLab:
+		return nil
+	default:
+		return fmt.Errorf("%w: provider must be %q or %q", ErrInvalidArgument, models.GitProviderGitHub, models.GitProviderGitLab)
+	}
+}
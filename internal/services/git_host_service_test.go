@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+// gitHostPRService записывает вызовы сервиса PR из обработчиков Git-хостингов.
+type gitHostPRService struct {
+	PullRequestService
+	created *models.PullRequest
+	calls   []string
+	err     error
+}
+
+func (f *gitHostPRService) CreatePullRequest(ctx context.Context, pr *models.PullRequest) (*models.PullRequest, error) {
+	f.created = pr
+	f.calls = append(f.calls, "create "+pr.Repository+"#"+pr.PullRequestID)
+	return pr, f.err
+}
+
+func (f *gitHostPRService) MarkReadyForReview(ctx context.Context, repo, prID string) (*models.PullRequest, error) {
+	f.calls = append(f.calls, "ready "+repo+"#"+prID)
+	return &models.PullRequest{Repository: repo, PullRequestID: prID}, f.err
+}
+
+func (f *gitHostPRService) MergePullRequest(ctx context.Context, repo, prID string) (*models.PullRequest, error) {
+	f.calls = append(f.calls, "merge "+repo+"#"+prID)
+	return &models.PullRequest{Repository: repo, PullRequestID: prID, Status: models.PRStatusMerged}, f.err
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestGitHostServiceImpl_HandleGitHub(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIdentityRepo := mocks.NewMockGitIdentityRepository(ctrl)
+	ctx := context.Background()
+	secrets := GitHostSecrets{GitHub: "gh-secret"}
+
+	handle := func(prSvc *gitHostPRService, fixture string) (*models.GitHostResult, error) {
+		body := readFixture(t, fixture)
+		svc := NewGitHostService(prSvc, mockIdentityRepo, nil, secrets)
+		return svc.HandleGitHub(ctx, "pull_request", SignWebhookPayload(secrets.GitHub, body), body)
+	}
+
+	t.Run("opened creates pull request", func(t *testing.T) {
+		prSvc := &gitHostPRService{}
+		mockIdentityRepo.EXPECT().GetUserIDByGitUsername(ctx, models.GitProviderGitHub, "Octo-Dev").Return("u1", nil)
+
+		result, err := handle(prSvc, "github_pull_request_opened.json")
+		require.NoError(t, err)
+
+		assert.Equal(t, models.GitHostActionCreated, result.Action)
+		assert.Equal(t, &models.PullRequest{
+			PullRequestID:   "42",
+			PullRequestName: "Retry failed card captures",
+			AuthorID:        "u1",
+			Status:          models.PRStatusOpen,
+			Repository:      "acme/payments",
+			URL:             "https://github.com/acme/payments/pull/42",
+			Description:     "Adds exponential backoff to capture retries.",
+			Labels:          []string{"backend"},
+			LinesAdded:      120,
+			LinesRemoved:    14,
+			FilesChanged:    4,
+		}, prSvc.created)
+	})
+
+	t.Run("opened draft is created as draft", func(t *testing.T) {
+		prSvc := &gitHostPRService{}
+		mockIdentityRepo.EXPECT().GetUserIDByGitUsername(ctx, models.GitProviderGitHub, "Octo-Dev").Return("u1", nil)
+
+		_, err := handle(prSvc, "github_pull_request_opened_draft.json")
+		require.NoError(t, err)
+		assert.True(t, prSvc.created.Draft)
+	})
+
+	t.Run("redelivered opened event is ignored", func(t *testing.T) {
+		prSvc := &gitHostPRService{err: ErrPRExists}
+		mockIdentityRepo.EXPECT().GetUserIDByGitUsername(ctx, models.GitProviderGitHub, "Octo-Dev").Return("u1", nil)
+
+		result, err := handle(prSvc, "github_pull_request_opened.json")
+		require.NoError(t, err)
+		assert.Equal(t, models.GitHostActionIgnored, result.Action)
+	})
+
+	t.Run("unmapped author", func(t *testing.T) {
+		mockIdentityRepo.EXPECT().GetUserIDByGitUsername(ctx, models.GitProviderGitHub, "Octo-Dev").Return("", nil)
+
+		_, err := handle(&gitHostPRService{}, "github_pull_request_opened.json")
+		assert.ErrorIs(t, err, ErrGitUserNotMapped)
+	})
+
+	t.Run("ready for review and merge", func(t *testing.T) {
+		prSvc := &gitHostPRService{}
+
+		result, err := handle(prSvc, "github_pull_request_ready_for_review.json")
+		require.NoError(t, err)
+		assert.Equal(t, models.GitHostActionReadyForReview, result.Action)
+
+		result, err = handle(prSvc, "github_pull_request_closed_merged.json")
+		require.NoError(t, err)
+		assert.Equal(t, models.GitHostActionMerged, result.Action)
+
+		assert.Equal(t, []string{"ready acme/payments#43", "merge acme/payments#42"}, prSvc.calls)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		svc := NewGitHostService(&gitHostPRService{}, mockIdentityRepo, nil, secrets)
+		body := readFixture(t, "github_pull_request_opened.json")
+
+		_, err := svc.HandleGitHub(ctx, "pull_request", SignWebhookPayload("wrong", body), body)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+
+		svc = NewGitHostService(&gitHostPRService{}, mockIdentityRepo, nil, GitHostSecrets{})
+		_, err = svc.HandleGitHub(ctx, "pull_request", SignWebhookPayload("", body), body)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("other events are ignored", func(t *testing.T) {
+		body := []byte(`{"zen":"Keep it logically awesome."}`)
+		svc := NewGitHostService(&gitHostPRService{}, mockIdentityRepo, nil, secrets)
+
+		result, err := svc.HandleGitHub(ctx, "ping", SignWebhookPayload(secrets.GitHub, body), body)
+		require.NoError(t, err)
+		assert.Equal(t, models.GitHostActionIgnored, result.Action)
+	})
+}
+
+func TestGitHostServiceImpl_HandleGitLab(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIdentityRepo := mocks.NewMockGitIdentityRepository(ctrl)
+	ctx := context.Background()
+	prSvc := &gitHostPRService{}
+	svc := NewGitHostService(prSvc, mockIdentityRepo, nil, GitHostSecrets{GitLab: "gl-token"})
+
+	t.Run("open, undraft and merge", func(t *testing.T) {
+		mockIdentityRepo.EXPECT().GetUserIDByGitUsername(ctx, models.GitProviderGitLab, "lab.dev").Return("u2", nil)
+
+		result, err := svc.HandleGitLab(ctx, "Merge Request Hook", "gl-token", readFixture(t, "gitlab_merge_request_open.json"))
+		require.NoError(t, err)
+		assert.Equal(t, models.GitHostActionCreated, result.Action)
+		assert.Equal(t, "u2", prSvc.created.AuthorID)
+		assert.Equal(t, "acme/billing", prSvc.created.Repository)
+		assert.Equal(t, []string{"finance"}, prSvc.created.Labels)
+		assert.True(t, prSvc.created.Draft)
+
+		result, err = svc.HandleGitLab(ctx, "Merge Request Hook", "gl-token", readFixture(t, "gitlab_merge_request_update_ready.json"))
+		require.NoError(t, err)
+		assert.Equal(t, models.GitHostActionReadyForReview, result.Action)
+
+		result, err = svc.HandleGitLab(ctx, "Merge Request Hook", "gl-token", readFixture(t, "gitlab_merge_request_merge.json"))
+		require.NoError(t, err)
+		assert.Equal(t, models.GitHostActionMerged, result.Action)
+
+		assert.Equal(t, []string{"create acme/billing#7", "ready acme/billing#7", "merge acme/billing#7"}, prSvc.calls)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := svc.HandleGitLab(ctx, "Merge Request Hook", "other", readFixture(t, "gitlab_merge_request_merge.json"))
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
+
+func TestGitHostServiceImpl_MapUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIdentityRepo := mocks.NewMockGitIdentityRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	svc := NewGitHostService(&gitHostPRService{}, mockIdentityRepo, mockUserRepo, GitHostSecrets{})
+	ctx := context.Background()
+
+	t.Run("maps existing user", func(t *testing.T) {
+		identity := &models.GitIdentity{Provider: models.GitProviderGitHub, Username: " Octo-Dev ", UserID: "u1"}
+		mockUserRepo.EXPECT().UserExists(ctx, "u1").Return(true, nil)
+		mockIdentityRepo.EXPECT().SetGitIdentity(ctx, identity).Return(nil)
+
+		mapped, err := svc.MapUser(ctx, identity)
+		require.NoError(t, err)
+		assert.Equal(t, "Octo-Dev", mapped.Username)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := svc.MapUser(ctx, &models.GitIdentity{Provider: "bitbucket", Username: "dev", UserID: "u1"})
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		mockUserRepo.EXPECT().UserExists(ctx, "ghost").Return(false, nil)
+
+		_, err := svc.MapUser(ctx, &models.GitIdentity{Provider: models.GitProviderGitLab, Username: "ghost", UserID: "ghost"})
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
@@ -0,0 +1,439 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+type MembershipServiceImpl struct {
+	teamRepo repository.TeamRepository
+	userRepo repository.UserRepository
+	prRepo   repository.PullRequestRepository
+	prSvc    PullRequestService
+	tx       repository.Transactor
+}
+
+// NewMembershipService создаёт сервис. Без tx удаление команды выполняется без транзакции.
+func NewMembershipService(
+	teamRepo repository.TeamRepository,
+	userRepo repository.UserRepository,
+	prRepo repository.PullRequestRepository,
+	prSvc PullRequestService,
+	tx repository.Transactor,
+) *MembershipServiceImpl {
+	return &MembershipServiceImpl{
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		prSvc:    prSvc,
+		tx:       tx,
+	}
+}
+
+// inTransaction выполняет fn в транзакции. Без транзакций fn выполняется как есть.
+func (s *MembershipServiceImpl) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.tx == nil {
+		return fn(ctx)
+	}
+	return s.tx.WithinTransaction(ctx, fn)
+}
+
+// AddMembers добавляет пользователей в команду. Участники других команд остаются в них:
+// их основная команда не меняется - для этого есть TransferMember.
+func (s *MembershipServiceImpl) AddMembers(ctx context.Context, teamName string, members []models.TeamMember) (*models.Team, error) {
+	if err := s.ensureTeamExists(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		user := &models.User{
+			UserID:   member.UserID,
+			Username: member.Username,
+			TeamName: teamName,
+			IsActive: member.IsActive,
+		}
+
+		err := s.userRepo.CreateUser(ctx, user)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create/update user %s: %w", member.UserID, err)
+		}
+
+		err = s.teamRepo.AddTeamMember(ctx, teamName, member)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add user %s to team: %w", member.UserID, err)
+		}
+	}
+
+	team, err := s.teamRepo.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team with members: %w", err)
+	}
+
+	return team, nil
+}
+
+// RemoveMembers исключает пользователей из команды. При openReviews == OpenReviewsReassign
+// и выходе из основной команды открытые ревью пользователя передаются другим её участникам;
+// при выходе из дополнительной команды ревью остаются за ним.
+func (s *MembershipServiceImpl) RemoveMembers(ctx context.Context, teamName string, userIDs []string, openReviews string) ([]*models.MembershipChange, error) {
+	if err := validateOpenReviewsPolicy(openReviews); err != nil {
+		return nil, err
+	}
+	if err := s.ensureTeamExists(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	users := make([]*models.User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		user, err := s.getUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		isMember, err := s.teamRepo.IsTeamMember(ctx, teamName, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check membership of user %s: %w", userID, err)
+		}
+		if !isMember {
+			return nil, fmt.Errorf("%w: %s", ErrNotTeamMember, userID)
+		}
+		users = append(users, user)
+	}
+
+	// Пакет применяется целиком: ошибка на любом пользователе откатывает всех предыдущих.
+	changes := make([]*models.MembershipChange, 0, len(users))
+	err := s.inTransaction(ctx, func(ctx context.Context) error {
+		for _, user := range users {
+			change, err := s.leaveTeam(ctx, user, teamName, openReviews)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, change)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// TransferMember переводит пользователя в другую команду. Открытые ревью либо остаются
+// за ним (OpenReviewsKeep), либо переназначаются в рамках прежней команды (OpenReviewsReassign).
+func (s *MembershipServiceImpl) TransferMember(ctx context.Context, userID string, targetTeam string, openReviews string) (*models.MembershipChange, error) {
+	if err := validateOpenReviewsPolicy(openReviews); err != nil {
+		return nil, err
+	}
+	if err := s.ensureTeamExists(ctx, targetTeam); err != nil {
+		return nil, err
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.TeamName == targetTeam {
+		return nil, fmt.Errorf("%w: user %s is already in team %s", ErrInvalidArgument, userID, targetTeam)
+	}
+
+	// Переназначение ревью и смена команды фиксируются вместе.
+	var change *models.MembershipChange
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		change, err = s.moveUser(ctx, user, targetTeam, openReviews)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// ArchiveTeam переводит команду в архив: её участники перестают назначаться ревьюверами,
+// но команда, пользователи и история PR сохраняются.
+func (s *MembershipServiceImpl) ArchiveTeam(ctx context.Context, teamName string) (*models.TeamRemovalReport, error) {
+	_, report, err := s.newRemovalReport(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	archivedAt, err := s.teamRepo.SetTeamArchived(ctx, teamName, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive team: %w", err)
+	}
+	report.ArchivedAt = archivedAt
+
+	return report, nil
+}
+
+// RestoreTeam возвращает команду из архива.
+func (s *MembershipServiceImpl) RestoreTeam(ctx context.Context, teamName string) (*models.Team, error) {
+	if err := s.ensureTeamExists(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	_, err := s.teamRepo.SetTeamArchived(ctx, teamName, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore team: %w", err)
+	}
+
+	team, err := s.teamRepo.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team with members: %w", err)
+	}
+
+	return team, nil
+}
+
+// DeleteTeam удаляет команду. Пока у её участников есть открытые PR (как у авторов или
+// ревьюверов), удаление возможно только с reassignTo - участники переводятся в эту команду
+// вместе со своими PR и ревью. Перевод участников и удаление выполняются в одной транзакции:
+// при ошибке команда и её участники остаются как были.
+func (s *MembershipServiceImpl) DeleteTeam(ctx context.Context, teamName string, reassignTo string) (*models.TeamRemovalReport, error) {
+	team, report, err := s.newRemovalReport(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if reassignTo != "" {
+		if err := s.ensureReassignTarget(ctx, teamName, reassignTo); err != nil {
+			return nil, err
+		}
+	} else if len(report.OpenPullRequests) > 0 {
+		return nil, fmt.Errorf("%w: %d open pull requests, reassign_to is required", ErrTeamHasOpenPRs, len(report.OpenPullRequests))
+	}
+
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		if reassignTo != "" {
+			for _, member := range team.Members {
+				if err := s.moveMembership(ctx, member, teamName, reassignTo); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := s.teamRepo.DeleteTeam(ctx, teamName); err != nil {
+			return fmt.Errorf("failed to delete team: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.MovedTo = reassignTo
+	report.Deleted = true
+
+	return report, nil
+}
+
+// moveMembership переносит членство участника удаляемой команды в команду target.
+// Если удаляемая команда была для него основной, основной становится target.
+func (s *MembershipServiceImpl) moveMembership(ctx context.Context, member models.TeamMember, teamName string, target string) error {
+	user, err := s.getUser(ctx, member.UserID)
+	if err != nil {
+		return err
+	}
+
+	isMember, err := s.teamRepo.IsTeamMember(ctx, target, member.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to check membership of user %s: %w", member.UserID, err)
+	}
+	if !isMember {
+		err = s.teamRepo.AddTeamMember(ctx, target, member)
+		if err != nil {
+			return fmt.Errorf("failed to add user %s to team %s: %w", member.UserID, target, err)
+		}
+	}
+
+	if user.TeamName == teamName {
+		err = s.userRepo.SetUserTeam(ctx, member.UserID, target)
+		if err != nil {
+			return fmt.Errorf("failed to update team of user %s: %w", member.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *MembershipServiceImpl) newRemovalReport(ctx context.Context, teamName string) (*models.Team, *models.TeamRemovalReport, error) {
+	team, err := s.teamRepo.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get team with members: %w", err)
+	}
+	if team == nil {
+		return nil, nil, ErrTeamNotFound
+	}
+
+	refs, err := s.prRepo.GetOpenPullRequestRefsByTeam(ctx, teamName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get open pull requests of team: %w", err)
+	}
+
+	report := &models.TeamRemovalReport{
+		TeamName:         teamName,
+		ArchivedAt:       team.ArchivedAt,
+		AffectedUsers:    make([]string, 0, len(team.Members)),
+		OpenPullRequests: make([]models.PullRequestRef, 0, len(refs)),
+	}
+	for _, member := range team.Members {
+		report.AffectedUsers = append(report.AffectedUsers, member.UserID)
+	}
+	report.OpenPullRequests = append(report.OpenPullRequests, refs...)
+
+	return team, report, nil
+}
+
+func (s *MembershipServiceImpl) ensureReassignTarget(ctx context.Context, teamName string, target string) error {
+	if target == teamName {
+		return fmt.Errorf("%w: reassign_to must differ from the deleted team", ErrInvalidArgument)
+	}
+
+	team, err := s.teamRepo.GetTeamByName(ctx, target)
+	if err != nil {
+		return fmt.Errorf("failed to get team %s: %w", target, err)
+	}
+	if team == nil {
+		return fmt.Errorf("%w: %s", ErrTeamNotFound, target)
+	}
+	if team.ArchivedAt != nil {
+		return fmt.Errorf("%w: team %s is archived", ErrInvalidArgument, target)
+	}
+
+	return nil
+}
+
+func (s *MembershipServiceImpl) moveUser(ctx context.Context, user *models.User, targetTeam string, openReviews string) (*models.MembershipChange, error) {
+	change := &models.MembershipChange{
+		PreviousTeam:      user.TeamName,
+		ReassignedReviews: []models.ReviewReassignment{},
+	}
+
+	// Переназначение выполняется до смены команды: кандидаты берутся из прежней команды.
+	if openReviews == models.OpenReviewsReassign {
+		reassignments, err := s.releaseOpenReviews(ctx, user.UserID)
+		if err != nil {
+			return nil, err
+		}
+		change.ReassignedReviews = reassignments
+	}
+
+	err := s.userRepo.SetUserTeam(ctx, user.UserID, targetTeam)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update team of user %s: %w", user.UserID, err)
+	}
+
+	user.TeamName = targetTeam
+	change.User = user
+
+	return change, nil
+}
+
+func (s *MembershipServiceImpl) leaveTeam(ctx context.Context, user *models.User, teamName string, openReviews string) (*models.MembershipChange, error) {
+	change := &models.MembershipChange{
+		PreviousTeam:      teamName,
+		ReassignedReviews: []models.ReviewReassignment{},
+	}
+
+	if openReviews == models.OpenReviewsReassign && user.TeamName == teamName {
+		reassignments, err := s.releaseOpenReviews(ctx, user.UserID)
+		if err != nil {
+			return nil, err
+		}
+		change.ReassignedReviews = reassignments
+	}
+
+	err := s.teamRepo.RemoveTeamMember(ctx, teamName, user.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove user %s from team: %w", user.UserID, err)
+	}
+
+	// Основную команду мог сменить репозиторий - перечитываем пользователя.
+	updated, err := s.getUser(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	change.User = updated
+
+	return change, nil
+}
+
+func (s *MembershipServiceImpl) releaseOpenReviews(ctx context.Context, userID string) ([]models.ReviewReassignment, error) {
+	refs, err := s.prRepo.GetOpenPullRequestRefsByReviewer(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open reviews of user %s: %w", userID, err)
+	}
+
+	reassignments := make([]models.ReviewReassignment, 0, len(refs))
+	for _, ref := range refs {
+		_, replacedBy, err := s.prSvc.ReassignReviewer(ctx, ref.Repository, ref.PullRequestID, userID)
+		// Уход участника не блокируется политиками команды: ревью без замены
+		// попадает в отчёт с пустым new_reviewer_id.
+		if errors.Is(err, ErrNoCandidate) || errors.Is(err, ErrUserWithoutTeam) || errors.Is(err, ErrPolicyViolation) {
+			err = s.unassignReviewer(ctx, ref, userID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to release review of %s/%s: %w", ref.Repository, ref.PullRequestID, err)
+		}
+
+		reassignments = append(reassignments, models.ReviewReassignment{
+			Repository:    ref.Repository,
+			PullRequestID: ref.PullRequestID,
+			OldReviewerID: userID,
+			NewReviewerID: replacedBy,
+		})
+	}
+
+	return reassignments, nil
+}
+
+func (s *MembershipServiceImpl) unassignReviewer(ctx context.Context, ref models.PullRequestRef, userID string) error {
+	reviewers, err := s.prRepo.GetAssignedReviewers(ctx, ref.Repository, ref.PullRequestID)
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]string, 0, len(reviewers))
+	for _, reviewerID := range reviewers {
+		if reviewerID != userID {
+			remaining = append(remaining, reviewerID)
+		}
+	}
+
+	return s.prRepo.SetAssignedReviewers(ctx, ref.Repository, ref.PullRequestID, remaining)
+}
+
+func (s *MembershipServiceImpl) ensureTeamExists(ctx context.Context, teamName string) error {
+	exists, err := s.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		return ErrTeamNotFound
+	}
+	return nil
+}
+
+func (s *MembershipServiceImpl) getUser(ctx context.Context, userID string) (*models.User, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, userID)
+	}
+	return user, nil
+}
+
+func validateOpenReviewsPolicy(policy string) error {
+	switch policy {
+	case models.OpenReviewsKeep, models.OpenReviewsReassign:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown open_reviews policy %q", ErrInvalidArgument, policy)
+	}
+}
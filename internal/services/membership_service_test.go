@@ -0,0 +1,386 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func newTestMembershipService(ctrl *gomock.Controller) (*MembershipServiceImpl, *mocks.MockTeamRepository, *mocks.MockUserRepository, *mocks.MockPullRequestRepository) {
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+
+	userSvc := NewUserService(mockUserRepo)
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, userSvc)
+	mockTeamRepo.EXPECT().GetTeamPolicies(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockUserRepo.EXPECT().GetBlockedReviewers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	return NewMembershipService(mockTeamRepo, mockUserRepo, mockPRRepo, prSvc, nil), mockTeamRepo, mockUserRepo, mockPRRepo
+}
+
+func TestMembershipServiceImpl_AddMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, mockTeamRepo, mockUserRepo, _ := newTestMembershipService(ctrl)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		members := []models.TeamMember{{UserID: "u1", Username: "One", IsActive: true, Role: "lead", Weight: 2}}
+		team := &models.Team{TeamName: "backend", Members: members}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockUserRepo.EXPECT().CreateUser(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().AddTeamMember(ctx, "backend", members[0]).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(team, nil)
+
+		result, err := svc.AddMembers(ctx, "backend", members)
+
+		require.NoError(t, err)
+		assert.Equal(t, team, result)
+	})
+
+	t.Run("member of another team keeps primary team", func(t *testing.T) {
+		member := models.TeamMember{UserID: "u1", Username: "One", IsActive: true}
+		team := &models.Team{TeamName: "backend", Members: []models.TeamMember{member}}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockUserRepo.EXPECT().CreateUser(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().AddTeamMember(ctx, "backend", member).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(team, nil)
+
+		result, err := svc.AddMembers(ctx, "backend", []models.TeamMember{member})
+
+		require.NoError(t, err)
+		assert.Equal(t, team, result)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "missing").Return(false, nil)
+
+		_, err := svc.AddMembers(ctx, "missing", []models.TeamMember{{UserID: "u1"}})
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
+
+func TestMembershipServiceImpl_RemoveMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, mockTeamRepo, mockUserRepo, mockPRRepo := newTestMembershipService(ctrl)
+	ctx := context.Background()
+
+	t.Run("keeps open reviews", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "backend"}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "backend", "u1").Return(true, nil)
+		mockTeamRepo.EXPECT().RemoveTeamMember(ctx, "backend", "u1").Return(nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1"}, nil)
+
+		changes, err := svc.RemoveMembers(ctx, "backend", []string{"u1"}, models.OpenReviewsKeep)
+
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "backend", changes[0].PreviousTeam)
+		assert.Equal(t, "", changes[0].User.TeamName)
+		assert.Empty(t, changes[0].ReassignedReviews)
+	})
+
+	t.Run("secondary team keeps open reviews", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "frontend"}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "backend", "u1").Return(true, nil)
+		mockTeamRepo.EXPECT().RemoveTeamMember(ctx, "backend", "u1").Return(nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "frontend"}, nil)
+
+		changes, err := svc.RemoveMembers(ctx, "backend", []string{"u1"}, models.OpenReviewsReassign)
+
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "backend", changes[0].PreviousTeam)
+		assert.Equal(t, "frontend", changes[0].User.TeamName)
+		assert.Empty(t, changes[0].ReassignedReviews)
+	})
+
+	t.Run("failure partway through the batch rolls back", func(t *testing.T) {
+		mockTx := mocks.NewMockTransactor(ctrl)
+		svc := NewMembershipService(mockTeamRepo, mockUserRepo, mockPRRepo, svc.prSvc, mockTx)
+
+		// Исключение u1 откатывается вместе с транзакцией из-за ошибки на u2.
+		var txErr error
+		mockTx.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				txErr = fn(ctx)
+				return txErr
+			})
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "backend"}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "backend", "u1").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u2").Return(&models.User{UserID: "u2", TeamName: "backend"}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "backend", "u2").Return(true, nil)
+		mockTeamRepo.EXPECT().RemoveTeamMember(ctx, "backend", "u1").Return(nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1"}, nil)
+		mockTeamRepo.EXPECT().RemoveTeamMember(ctx, "backend", "u2").Return(errors.New("connection reset"))
+
+		changes, err := svc.RemoveMembers(ctx, "backend", []string{"u1", "u2"}, models.OpenReviewsKeep)
+
+		assert.ErrorContains(t, err, "connection reset")
+		assert.ErrorContains(t, txErr, "connection reset")
+		assert.Nil(t, changes)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "frontend"}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "backend", "u1").Return(false, nil)
+
+		_, err := svc.RemoveMembers(ctx, "backend", []string{"u1"}, models.OpenReviewsKeep)
+
+		assert.ErrorIs(t, err, ErrNotTeamMember)
+	})
+
+	t.Run("unknown policy", func(t *testing.T) {
+		_, err := svc.RemoveMembers(ctx, "backend", []string{"u1"}, "drop")
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestMembershipServiceImpl_TransferMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, mockTeamRepo, mockUserRepo, mockPRRepo := newTestMembershipService(ctrl)
+	ctx := context.Background()
+
+	t.Run("reassigns open reviews within previous team", func(t *testing.T) {
+		user := &models.User{UserID: "u1", TeamName: "backend", IsActive: true}
+		openPR := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, AssignedReviewers: []string{"u1"}}
+		lonelyPR := &models.PullRequest{PullRequestID: "pr2", AuthorID: "u2", Status: models.PRStatusOpen, AssignedReviewers: []string{"u1", "author"}}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "frontend").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(user, nil).Times(3)
+		mockPRRepo.EXPECT().GetOpenPullRequestRefsByReviewer(ctx, "u1").Return([]models.PullRequestRef{
+			{Repository: models.DefaultRepository, PullRequestID: "pr1"},
+			{Repository: models.DefaultRepository, PullRequestID: "pr2"},
+		}, nil)
+
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(openPR, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(&models.User{UserID: "author", TeamName: "backend"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "backend").Return([]*models.User{{UserID: "author"}, {UserID: "u1"}, {UserID: "u2"}}, nil)
+		mockPRRepo.EXPECT().SetAssignedReviewers(ctx, models.DefaultRepository, "pr1", []string{"u2"}).Return(nil)
+
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr2").Return(lonelyPR, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u2").Return(&models.User{UserID: "u2", TeamName: "backend"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "backend").Return([]*models.User{{UserID: "author"}, {UserID: "u1"}, {UserID: "u2"}}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "backend").Return(&models.Team{TeamName: "backend"}, nil)
+		mockPRRepo.EXPECT().GetAssignedReviewers(ctx, models.DefaultRepository, "pr2").Return([]string{"u1", "author"}, nil)
+		mockPRRepo.EXPECT().SetAssignedReviewers(ctx, models.DefaultRepository, "pr2", []string{"author"}).Return(nil)
+
+		mockUserRepo.EXPECT().SetUserTeam(ctx, "u1", "frontend").Return(nil)
+
+		change, err := svc.TransferMember(ctx, "u1", "frontend", models.OpenReviewsReassign)
+
+		require.NoError(t, err)
+		assert.Equal(t, "backend", change.PreviousTeam)
+		assert.Equal(t, "frontend", change.User.TeamName)
+		assert.Equal(t, []models.ReviewReassignment{
+			{Repository: models.DefaultRepository, PullRequestID: "pr1", OldReviewerID: "u1", NewReviewerID: "u2"},
+			{Repository: models.DefaultRepository, PullRequestID: "pr2", OldReviewerID: "u1"},
+		}, change.ReassignedReviews)
+	})
+
+	t.Run("failed team change rolls back reassignments", func(t *testing.T) {
+		mockTx := mocks.NewMockTransactor(ctrl)
+		svc := NewMembershipService(mockTeamRepo, mockUserRepo, mockPRRepo, svc.prSvc, mockTx)
+		openPR := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, AssignedReviewers: []string{"u1"}}
+
+		// Переназначение выполняется только внутри транзакции, которая откатывается с ошибкой SetUserTeam.
+		var inTx bool
+		var txErr error
+		mockTx.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				inTx = true
+				txErr = fn(ctx)
+				inTx = false
+				return txErr
+			})
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "frontend").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "backend", IsActive: true}, nil).Times(2)
+		mockPRRepo.EXPECT().GetOpenPullRequestRefsByReviewer(ctx, "u1").Return([]models.PullRequestRef{
+			{Repository: models.DefaultRepository, PullRequestID: "pr1"},
+		}, nil)
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(openPR, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(&models.User{UserID: "author", TeamName: "backend"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "backend").Return([]*models.User{{UserID: "author"}, {UserID: "u1"}, {UserID: "u2"}}, nil)
+		mockPRRepo.EXPECT().SetAssignedReviewers(ctx, models.DefaultRepository, "pr1", []string{"u2"}).
+			DoAndReturn(func(context.Context, string, string, []string) error {
+				assert.True(t, inTx, "reassignment must run inside the transaction")
+				return nil
+			})
+		mockUserRepo.EXPECT().SetUserTeam(ctx, "u1", "frontend").Return(errors.New("connection reset"))
+
+		change, err := svc.TransferMember(ctx, "u1", "frontend", models.OpenReviewsReassign)
+
+		assert.ErrorContains(t, err, "connection reset")
+		assert.ErrorContains(t, txErr, "connection reset")
+		assert.Nil(t, change)
+	})
+
+	t.Run("same team", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "backend"}, nil)
+
+		_, err := svc.TransferMember(ctx, "u1", "backend", models.OpenReviewsKeep)
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "frontend").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "missing").Return(nil, nil)
+
+		_, err := svc.TransferMember(ctx, "missing", "frontend", models.OpenReviewsKeep)
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestMembershipServiceImpl_ArchiveTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, mockTeamRepo, _, mockPRRepo := newTestMembershipService(ctrl)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		archivedAt := time.Now()
+		team := &models.Team{TeamName: "backend", Members: []models.TeamMember{{UserID: "u1"}, {UserID: "u2"}}}
+
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(team, nil)
+		mockPRRepo.EXPECT().GetOpenPullRequestRefsByTeam(ctx, "backend").Return([]models.PullRequestRef{{Repository: "web", PullRequestID: "pr1"}}, nil)
+		mockTeamRepo.EXPECT().SetTeamArchived(ctx, "backend", true).Return(&archivedAt, nil)
+
+		report, err := svc.ArchiveTeam(ctx, "backend")
+
+		require.NoError(t, err)
+		assert.False(t, report.Deleted)
+		assert.Equal(t, &archivedAt, report.ArchivedAt)
+		assert.Equal(t, []string{"u1", "u2"}, report.AffectedUsers)
+		assert.Equal(t, []models.PullRequestRef{{Repository: "web", PullRequestID: "pr1"}}, report.OpenPullRequests)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "missing").Return(nil, nil)
+
+		_, err := svc.ArchiveTeam(ctx, "missing")
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
+
+func TestMembershipServiceImpl_DeleteTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, mockTeamRepo, mockUserRepo, mockPRRepo := newTestMembershipService(ctrl)
+	ctx := context.Background()
+	team := &models.Team{TeamName: "backend", Members: []models.TeamMember{{UserID: "u1"}}}
+
+	t.Run("refuses with open pull requests", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(team, nil)
+		mockPRRepo.EXPECT().GetOpenPullRequestRefsByTeam(ctx, "backend").Return([]models.PullRequestRef{{Repository: "web", PullRequestID: "pr1"}}, nil)
+
+		report, err := svc.DeleteTeam(ctx, "backend", "")
+
+		assert.ErrorIs(t, err, ErrTeamHasOpenPRs)
+		assert.Nil(t, report)
+	})
+
+	t.Run("without open pull requests", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(team, nil)
+		mockPRRepo.EXPECT().GetOpenPullRequestRefsByTeam(ctx, "backend").Return(nil, nil)
+		mockTeamRepo.EXPECT().DeleteTeam(ctx, "backend").Return(nil)
+
+		report, err := svc.DeleteTeam(ctx, "backend", "")
+
+		require.NoError(t, err)
+		assert.True(t, report.Deleted)
+		assert.Equal(t, []string{"u1"}, report.AffectedUsers)
+		assert.Empty(t, report.MovedTo)
+	})
+
+	t.Run("moves members to reassignment target", func(t *testing.T) {
+		secondary := models.TeamMember{UserID: "u2", Role: "reviewer"}
+		team := &models.Team{TeamName: "backend", Members: []models.TeamMember{{UserID: "u1"}, secondary}}
+
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(team, nil)
+		mockPRRepo.EXPECT().GetOpenPullRequestRefsByTeam(ctx, "backend").Return([]models.PullRequestRef{{Repository: "web", PullRequestID: "pr1"}}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "platform").Return(&models.Team{TeamName: "platform"}, nil)
+
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "backend"}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "platform", "u1").Return(true, nil)
+		mockUserRepo.EXPECT().SetUserTeam(ctx, "u1", "platform").Return(nil)
+
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u2").Return(&models.User{UserID: "u2", TeamName: "frontend"}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "platform", "u2").Return(false, nil)
+		mockTeamRepo.EXPECT().AddTeamMember(ctx, "platform", secondary).Return(nil)
+
+		mockTeamRepo.EXPECT().DeleteTeam(ctx, "backend").Return(nil)
+
+		report, err := svc.DeleteTeam(ctx, "backend", "platform")
+
+		require.NoError(t, err)
+		assert.True(t, report.Deleted)
+		assert.Equal(t, "platform", report.MovedTo)
+		assert.Equal(t, []models.PullRequestRef{{Repository: "web", PullRequestID: "pr1"}}, report.OpenPullRequests)
+	})
+
+	t.Run("failed move keeps the team", func(t *testing.T) {
+		mockTx := mocks.NewMockTransactor(ctrl)
+		svc := NewMembershipService(mockTeamRepo, mockUserRepo, mockPRRepo, svc.prSvc, mockTx)
+		team := &models.Team{TeamName: "backend", Members: []models.TeamMember{{UserID: "u1"}, {UserID: "u2"}}}
+
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(team, nil)
+		mockPRRepo.EXPECT().GetOpenPullRequestRefsByTeam(ctx, "backend").Return(nil, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "platform").Return(&models.Team{TeamName: "platform"}, nil)
+		// Перевод u1 откатывается вместе с транзакцией, DeleteTeam не вызывается.
+		mockTx.EXPECT().WithinTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1", TeamName: "backend"}, nil)
+		mockTeamRepo.EXPECT().IsTeamMember(ctx, "platform", "u1").Return(true, nil)
+		mockUserRepo.EXPECT().SetUserTeam(ctx, "u1", "platform").Return(nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "u2").Return(nil, errors.New("connection reset"))
+
+		report, err := svc.DeleteTeam(ctx, "backend", "platform")
+
+		assert.ErrorContains(t, err, "connection reset")
+		assert.Nil(t, report)
+	})
+
+	t.Run("archived reassignment target", func(t *testing.T) {
+		archivedAt := time.Now()
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(team, nil)
+		mockPRRepo.EXPECT().GetOpenPullRequestRefsByTeam(ctx, "backend").Return(nil, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "legacy").Return(&models.Team{TeamName: "legacy", ArchivedAt: &archivedAt}, nil)
+
+		_, err := svc.DeleteTeam(ctx, "backend", "legacy")
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
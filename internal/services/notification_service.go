@@ -0,0 +1,380 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"strings"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/notify"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+const (
+	// notificationCursor - имя позиции сервиса уведомлений в журнале событий.
+	notificationCursor    = "notifications"
+	notificationBatchSize = 100
+)
+
+// NotificationServiceImpl уведомляет ревьюверов о назначениях из журнала событий и напоминает
+// о неотвеченных PR. Уведомление уходит сразу в канал пользователя или откладывается до дайджеста.
+type NotificationServiceImpl struct {
+	eventRepo     repository.EventRepository
+	notifRepo     repository.NotificationRepository
+	prRepo        repository.PullRequestRepository
+	userRepo      repository.UserRepository
+	notifiers     map[string]notify.Notifier
+	reminderAfter time.Duration
+}
+
+// NewNotificationService создаёт сервис с настроенными каналами; канал log должен быть среди них.
+// reminderAfter - через сколько после назначения и предыдущего напоминания ревьювер получает
+// напоминание о неотвеченном PR.
+func NewNotificationService(
+	eventRepo repository.EventRepository,
+	notifRepo repository.NotificationRepository,
+	prRepo repository.PullRequestRepository,
+	userRepo repository.UserRepository,
+	notifiers map[string]notify.Notifier,
+	reminderAfter time.Duration,
+) *NotificationServiceImpl {
+	return &NotificationServiceImpl{
+		eventRepo:     eventRepo,
+		notifRepo:     notifRepo,
+		prRepo:        prRepo,
+		userRepo:      userRepo,
+		notifiers:     notifiers,
+		reminderAfter: reminderAfter,
+	}
+}
+
+// defaultNotificationPreferences - настройки пользователя, который их не задавал.
+func defaultNotificationPreferences(userID string) *models.NotificationPreferences {
+	return &models.NotificationPreferences{
+		UserID:  userID,
+		Mode:    models.NotificationModeImmediate,
+		Channel: notify.ChannelLog,
+	}
+}
+
+// SetPreferences сохраняет настройки уведомлений. Пустые режим и канал - immediate и log;
+// выбрать можно только канал, настроенный в сервисе.
+func (s *NotificationServiceImpl) SetPreferences(ctx context.Context, prefs *models.NotificationPreferences) (*models.NotificationPreferences, error) {
+	defaults := defaultNotificationPreferences(prefs.UserID)
+	if prefs.Mode == "" {
+		prefs.Mode = defaults.Mode
+	}
+	if prefs.Channel == "" {
+		prefs.Channel = defaults.Channel
+	}
+	prefs.Email = strings.TrimSpace(prefs.Email)
+
+	if prefs.Mode != models.NotificationModeImmediate && prefs.Mode != models.NotificationModeDigest {
+		return nil, fmt.Errorf("%w: mode must be %q or %q", ErrInvalidArgument, models.NotificationModeImmediate, models.NotificationModeDigest)
+	}
+	if _, ok := s.notifiers[prefs.Channel]; !ok {
+		return nil, fmt.Errorf("%w: notification channel %q is not configured", ErrInvalidArgument, prefs.Channel)
+	}
+	if prefs.Channel == notify.ChannelEmail {
+		// ParseAddress принимает и "Bob <bob@example.com>"; сохраняется только сам адрес.
+		addr, err := mail.ParseAddress(prefs.Email)
+		if err != nil {
+			return nil, fmt.Errorf("%w: email channel requires a valid email", ErrInvalidArgument)
+		}
+		prefs.Email = addr.Address
+	}
+
+	exists, err := s.userRepo.UserExists(ctx, prefs.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	err = s.notifRepo.SetNotificationPreferences(ctx, prefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// GetPreferences возвращает настройки уведомлений пользователя или настройки по умолчанию.
+func (s *NotificationServiceImpl) GetPreferences(ctx context.Context, userID string) (*models.NotificationPreferences, error) {
+	exists, err := s.userRepo.UserExists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	return s.preferences(ctx, userID)
+}
+
+func (s *NotificationServiceImpl) preferences(ctx context.Context, userID string) (*models.NotificationPreferences, error) {
+	prefs, err := s.notifRepo.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	if prefs == nil {
+		prefs = defaultNotificationPreferences(userID)
+	}
+	return prefs, nil
+}
+
+// ProcessEvents уведомляет о событиях, записанных после предыдущего вызова. При первом
+// запуске сервис начинает с последнего события, не уведомляя о прошлых назначениях.
+// Позиция сохраняется после каждого события. Ошибка доставки одному получателю не мешает
+// остальным и не задерживает журнал: она логируется, а уведомление этому получателю теряется.
+func (s *NotificationServiceImpl) ProcessEvents(ctx context.Context) error {
+	saved, err := s.eventRepo.GetEventCursor(ctx, notificationCursor)
+	if err != nil {
+		return fmt.Errorf("failed to get notification cursor: %w", err)
+	}
+
+	var cursor int64
+	if saved != nil {
+		cursor = *saved
+	} else {
+		cursor, err = s.eventRepo.GetLastEventID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get last event: %w", err)
+		}
+		if err := s.eventRepo.SetEventCursor(ctx, notificationCursor, cursor); err != nil {
+			return fmt.Errorf("failed to save notification cursor: %w", err)
+		}
+	}
+
+	var errs []error
+	for {
+		events, err := s.eventRepo.GetEventsAfter(ctx, cursor, notificationBatchSize)
+		if err != nil {
+			return errors.Join(append(errs, fmt.Errorf("failed to get events: %w", err))...)
+		}
+
+		for _, event := range events {
+			if err := s.notifyEvent(ctx, event); err != nil {
+				errs = append(errs, fmt.Errorf("failed to notify about event %d: %w", event.ID, err))
+			}
+			cursor = event.ID
+			if err := s.eventRepo.SetEventCursor(ctx, notificationCursor, cursor); err != nil {
+				return errors.Join(append(errs, fmt.Errorf("failed to save notification cursor: %w", err))...)
+			}
+		}
+
+		if len(events) < notificationBatchSize {
+			return errors.Join(errs...)
+		}
+	}
+}
+
+func (s *NotificationServiceImpl) notifyEvent(ctx context.Context, event *models.Event) error {
+	data := notify.TemplateData{
+		Repository:      event.Data.Repository,
+		PullRequestID:   event.Data.PullRequestID,
+		PullRequestName: event.Data.PullRequestName,
+		AuthorID:        event.Data.AuthorID,
+		OldReviewerID:   event.Data.OldReviewerID,
+		NewReviewerID:   event.Data.NewReviewerID,
+	}
+
+	var errs []error
+	deliver := func(userID, kind string, data notify.TemplateData) {
+		if err := s.deliver(ctx, userID, kind, data); err != nil {
+			log.Printf("Failed to notify %s about event %d: %v", userID, event.ID, err)
+			errs = append(errs, err)
+		}
+	}
+
+	switch event.Type {
+	case models.EventReviewersAssigned:
+		for _, reviewerID := range event.Data.Reviewers {
+			deliver(reviewerID, notify.KindAssignment, data)
+		}
+	case models.EventReviewerReassigned:
+		deliver(event.Data.NewReviewerID, notify.KindReassignment, data)
+		data.Unassigned = true
+		deliver(event.Data.OldReviewerID, notify.KindReassignment, data)
+	}
+
+	return errors.Join(errs...)
+}
+
+// deliver отправляет уведомление пользователю по его настройкам. Удалённым пользователям
+// уведомления не отправляются.
+func (s *NotificationServiceImpl) deliver(ctx context.Context, userID, kind string, data notify.TemplateData) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil
+	}
+
+	prefs, err := s.preferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	subject, body, err := notify.Render(kind, data)
+	if err != nil {
+		return err
+	}
+
+	if prefs.Mode == models.NotificationModeDigest {
+		err := s.notifRepo.AddDigestItem(ctx, &models.DigestItem{UserID: userID, Kind: kind, Subject: subject, Body: body})
+		if err != nil {
+			return fmt.Errorf("failed to save digest item: %w", err)
+		}
+		return nil
+	}
+
+	return s.send(ctx, user, prefs, subject, body, "")
+}
+
+// SendMessage сразу отправляет пользователю готовое сообщение в его канал, независимо от
+// режима уведомлений. html - необязательная HTML-версия body.
+func (s *NotificationServiceImpl) SendMessage(ctx context.Context, userID, subject, body, html string) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	prefs, err := s.preferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.send(ctx, user, prefs, subject, body, html)
+}
+
+// send отправляет сообщение в канал пользователя. Если канал отключили после выбора,
+// сообщение уходит в лог.
+func (s *NotificationServiceImpl) send(ctx context.Context, user *models.User, prefs *models.NotificationPreferences, subject, body, html string) error {
+	notifier, ok := s.notifiers[prefs.Channel]
+	if !ok {
+		notifier = s.notifiers[notify.ChannelLog]
+	}
+
+	err := notifier.Notify(ctx, notify.Message{
+		UserID:   user.UserID,
+		Username: user.Username,
+		Email:    prefs.Email,
+		Subject:  subject,
+		Body:     body,
+		HTML:     html,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send %s notification to %s: %w", prefs.Channel, user.UserID, err)
+	}
+	return nil
+}
+
+// SendReminders напоминает ревьюверам об открытых PR, на которые они не ответили за
+// reminderAfter; следующее напоминание - не раньше чем через reminderAfter после предыдущего.
+// Неудачное напоминание тоже считается отправленным: повторная попытка - через reminderAfter,
+// а ошибка одному ревьюверу не мешает остальным.
+func (s *NotificationServiceImpl) SendReminders(ctx context.Context) error {
+	assignments, err := s.prRepo.GetReviewAssignments(ctx, models.ReviewAssignmentFilter{OpenOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to get review assignments: %w", err)
+	}
+
+	now := time.Now()
+	var errs []error
+	for _, a := range assignments {
+		if a.RespondedAt != nil || now.Sub(a.AssignedAt) < s.reminderAfter {
+			continue
+		}
+		if a.RemindedAt != nil && now.Sub(*a.RemindedAt) < s.reminderAfter {
+			continue
+		}
+
+		err := s.deliver(ctx, a.ReviewerID, notify.KindReminder, notify.TemplateData{
+			Repository:      a.Repository,
+			PullRequestID:   a.PullRequestID,
+			PullRequestName: a.PullRequestName,
+			AuthorID:        a.AuthorID,
+			WaitingHours:    int(now.Sub(a.AssignedAt).Hours()),
+		})
+		if err != nil {
+			log.Printf("Failed to remind %s about pull request %s: %v", a.ReviewerID, a.PullRequestID, err)
+			errs = append(errs, err)
+		}
+
+		err = s.prRepo.SetReviewReminded(ctx, a.Repository, a.PullRequestID, a.ReviewerID, now)
+		if err != nil {
+			return errors.Join(append(errs, fmt.Errorf("failed to save reminder time: %w", err))...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SendDigests отправляет каждому пользователю отложенные уведомления одним сообщением.
+// Ошибка доставки одному пользователю не мешает остальным: его уведомления останутся
+// до следующего дайджеста.
+func (s *NotificationServiceImpl) SendDigests(ctx context.Context) error {
+	items, err := s.notifRepo.GetDigestItems(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get digest items: %w", err)
+	}
+
+	var errs []error
+	for start := 0; start < len(items); {
+		end := start
+		for end < len(items) && items[end].UserID == items[start].UserID {
+			end++
+		}
+		if err := s.sendDigest(ctx, items[start].UserID, items[start:end]); err != nil {
+			log.Printf("Failed to send digest to %s: %v", items[start].UserID, err)
+			errs = append(errs, err)
+		}
+		start = end
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *NotificationServiceImpl) sendDigest(ctx context.Context, userID string, items []*models.DigestItem) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	prefs, err := s.preferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]notify.DigestEntry, 0, len(items))
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		entries = append(entries, notify.DigestEntry{Subject: item.Subject, Body: item.Body, CreatedAt: item.CreatedAt})
+		ids = append(ids, item.ID)
+	}
+
+	// Уведомления удалённого пользователя удаляются вместе с ним, но могут попасть в выборку.
+	if user != nil {
+		subject, body, err := notify.RenderDigest(user.Username, entries)
+		if err != nil {
+			return err
+		}
+		if err := s.send(ctx, user, prefs, subject, body, ""); err != nil {
+			return err
+		}
+	}
+
+	if err := s.notifRepo.DeleteDigestItems(ctx, ids); err != nil {
+		return fmt.Errorf("failed to delete digest items: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/notify"
+)
+
+// recordingNotifier запоминает отправленные уведомления. failFor - получатели, доставка
+// которым всегда завершается ошибкой.
+type recordingNotifier struct {
+	sent    []notify.Message
+	err     error
+	failFor map[string]bool
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, msg notify.Message) error {
+	if n.err != nil {
+		return n.err
+	}
+	if n.failFor[msg.UserID] {
+		return errors.New("550 mailbox unavailable")
+	}
+	n.sent = append(n.sent, msg)
+	return nil
+}
+
+func TestNotificationServiceImpl_SetPreferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotifRepo := mocks.NewMockNotificationRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	notifiers := map[string]notify.Notifier{notify.ChannelLog: &recordingNotifier{}, notify.ChannelEmail: &recordingNotifier{}}
+	svc := NewNotificationService(nil, mockNotifRepo, nil, mockUserRepo, notifiers, time.Hour)
+	ctx := context.Background()
+
+	t.Run("defaults to immediate log notifications", func(t *testing.T) {
+		mockUserRepo.EXPECT().UserExists(ctx, "u1").Return(true, nil)
+		mockNotifRepo.EXPECT().SetNotificationPreferences(ctx, gomock.Any()).Return(nil)
+
+		prefs, err := svc.SetPreferences(ctx, &models.NotificationPreferences{UserID: "u1"})
+		require.NoError(t, err)
+		assert.Equal(t, models.NotificationModeImmediate, prefs.Mode)
+		assert.Equal(t, notify.ChannelLog, prefs.Channel)
+	})
+
+	t.Run("stores bare email address", func(t *testing.T) {
+		mockUserRepo.EXPECT().UserExists(ctx, "u1").Return(true, nil)
+		mockNotifRepo.EXPECT().SetNotificationPreferences(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, prefs *models.NotificationPreferences) error {
+			assert.Equal(t, "bob@example.com", prefs.Email)
+			return nil
+		})
+
+		prefs, err := svc.SetPreferences(ctx, &models.NotificationPreferences{UserID: "u1", Channel: notify.ChannelEmail, Email: " Bob <bob@example.com> "})
+		require.NoError(t, err)
+		assert.Equal(t, "bob@example.com", prefs.Email)
+	})
+
+	for name, prefs := range map[string]*models.NotificationPreferences{
+		"unknown mode":           {UserID: "u1", Mode: "weekly"},
+		"channel not configured": {UserID: "u1", Channel: notify.ChannelChat},
+		"email without address":  {UserID: "u1", Channel: notify.ChannelEmail, Email: "bob"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.SetPreferences(ctx, prefs)
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		})
+	}
+
+	t.Run("unknown user", func(t *testing.T) {
+		mockUserRepo.EXPECT().UserExists(ctx, "ghost").Return(false, nil)
+
+		_, err := svc.SetPreferences(ctx, &models.NotificationPreferences{UserID: "ghost", Mode: models.NotificationModeDigest})
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestNotificationServiceImpl_ProcessEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := mocks.NewMockEventRepository(ctrl)
+	mockNotifRepo := mocks.NewMockNotificationRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	chat := &recordingNotifier{}
+	svc := NewNotificationService(mockEventRepo, mockNotifRepo, nil, mockUserRepo,
+		map[string]notify.Notifier{notify.ChannelLog: &recordingNotifier{}, notify.ChannelChat: chat}, time.Hour)
+	ctx := context.Background()
+
+	for _, id := range []string{"u2", "u3", "u4"} {
+		mockUserRepo.EXPECT().GetUserByID(ctx, id).Return(&models.User{UserID: id, Username: "user-" + id}, nil).AnyTimes()
+	}
+	mockNotifRepo.EXPECT().GetNotificationPreferences(ctx, "u2").Return(&models.NotificationPreferences{UserID: "u2", Mode: models.NotificationModeImmediate, Channel: notify.ChannelChat}, nil).AnyTimes()
+	mockNotifRepo.EXPECT().GetNotificationPreferences(ctx, "u3").Return(&models.NotificationPreferences{UserID: "u3", Mode: models.NotificationModeDigest, Channel: notify.ChannelChat}, nil).AnyTimes()
+	mockNotifRepo.EXPECT().GetNotificationPreferences(ctx, "u4").Return(&models.NotificationPreferences{UserID: "u4", Mode: models.NotificationModeImmediate, Channel: notify.ChannelChat}, nil).AnyTimes()
+
+	pr := models.EventData{Repository: "default", PullRequestID: "pr1", PullRequestName: "Search", AuthorID: "u1"}
+	assigned := &models.Event{ID: 6, Type: models.EventReviewersAssigned, Data: pr}
+	assigned.Data.Reviewers = []string{"u2", "u3"}
+	reassigned := &models.Event{ID: 8, Type: models.EventReviewerReassigned, Data: pr}
+	reassigned.Data.Reviewers, reassigned.Data.OldReviewerID, reassigned.Data.NewReviewerID = []string{"u3", "u4"}, "u2", "u4"
+	merged := &models.Event{ID: 9, Type: models.EventPullRequestMerged, Data: pr}
+
+	t.Run("first run starts from the latest event", func(t *testing.T) {
+		gomock.InOrder(
+			mockEventRepo.EXPECT().GetEventCursor(ctx, notificationCursor).Return(nil, nil),
+			mockEventRepo.EXPECT().GetLastEventID(ctx).Return(int64(5), nil),
+			mockEventRepo.EXPECT().SetEventCursor(ctx, notificationCursor, int64(5)).Return(nil),
+			mockEventRepo.EXPECT().GetEventsAfter(ctx, int64(5), notificationBatchSize).Return(nil, nil),
+		)
+
+		require.NoError(t, svc.ProcessEvents(ctx))
+		assert.Empty(t, chat.sent)
+	})
+
+	t.Run("notifies reviewers by their preferences", func(t *testing.T) {
+		cursor := int64(5)
+		mockEventRepo.EXPECT().GetEventCursor(ctx, notificationCursor).Return(&cursor, nil)
+		mockEventRepo.EXPECT().GetEventsAfter(ctx, int64(5), notificationBatchSize).Return([]*models.Event{assigned, reassigned, merged}, nil)
+		mockEventRepo.EXPECT().SetEventCursor(ctx, notificationCursor, int64(6)).Return(nil)
+		mockEventRepo.EXPECT().SetEventCursor(ctx, notificationCursor, int64(8)).Return(nil)
+		mockEventRepo.EXPECT().SetEventCursor(ctx, notificationCursor, int64(9)).Return(nil)
+		mockNotifRepo.EXPECT().AddDigestItem(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, item *models.DigestItem) error {
+			assert.Equal(t, "u3", item.UserID)
+			assert.Equal(t, notify.KindAssignment, item.Kind)
+			assert.Equal(t, "Review requested: Search", item.Subject)
+			return nil
+		})
+
+		require.NoError(t, svc.ProcessEvents(ctx))
+
+		require.Len(t, chat.sent, 3)
+		assert.Equal(t, "u2", chat.sent[0].UserID)
+		assert.Equal(t, "Review requested: Search", chat.sent[0].Subject)
+		assert.Equal(t, "u4", chat.sent[1].UserID)
+		assert.Contains(t, chat.sent[1].Body, "instead of u2")
+		assert.Equal(t, "u2", chat.sent[2].UserID)
+		assert.Equal(t, "Review reassigned: Search", chat.sent[2].Subject)
+	})
+
+	t.Run("failing recipient does not block others", func(t *testing.T) {
+		chat.sent = nil
+		chat.failFor = map[string]bool{"u2": true}
+		defer func() { chat.failFor = nil }()
+
+		cursor := int64(5)
+		mockEventRepo.EXPECT().GetEventCursor(ctx, notificationCursor).Return(&cursor, nil)
+		mockEventRepo.EXPECT().GetEventsAfter(ctx, int64(5), notificationBatchSize).Return([]*models.Event{assigned, reassigned}, nil)
+		mockEventRepo.EXPECT().SetEventCursor(ctx, notificationCursor, int64(6)).Return(nil)
+		mockEventRepo.EXPECT().SetEventCursor(ctx, notificationCursor, int64(8)).Return(nil)
+		mockNotifRepo.EXPECT().AddDigestItem(ctx, gomock.Any()).Return(nil)
+
+		err := svc.ProcessEvents(ctx)
+
+		assert.ErrorContains(t, err, "550 mailbox unavailable")
+		require.Len(t, chat.sent, 1)
+		assert.Equal(t, "u4", chat.sent[0].UserID)
+	})
+}
+
+func TestNotificationServiceImpl_SendReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockNotifRepo := mocks.NewMockNotificationRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	logChannel := &recordingNotifier{}
+	svc := NewNotificationService(nil, mockNotifRepo, mockPRRepo, mockUserRepo,
+		map[string]notify.Notifier{notify.ChannelLog: logChannel}, 24*time.Hour)
+	ctx := context.Background()
+
+	now := time.Now()
+	longAgo, recently := now.Add(-30*time.Hour), now.Add(-2*time.Hour)
+	assignment := func(prID string, assignedAt time.Time, respondedAt, remindedAt *time.Time) *models.ReviewAssignment {
+		return &models.ReviewAssignment{
+			Repository: "default", PullRequestID: prID, PullRequestName: "PR " + prID, AuthorID: "u1", ReviewerID: "u2",
+			AssignedAt: assignedAt, RespondedAt: respondedAt, RemindedAt: remindedAt,
+		}
+	}
+
+	// Ревьювер u3 не получает напоминаний: ошибка не мешает остальным, повтор - через reminderAfter.
+	unreachable := assignment("unreachable", longAgo, nil, nil)
+	unreachable.ReviewerID = "u3"
+	logChannel.failFor = map[string]bool{"u3": true}
+
+	mockPRRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{OpenOnly: true}).Return([]*models.ReviewAssignment{
+		assignment("responded", longAgo, &recently, nil),
+		assignment("fresh", recently, nil, nil),
+		assignment("reminded", longAgo, nil, &recently),
+		unreachable,
+		assignment("waiting", longAgo, nil, nil),
+	}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, "u2").Return(&models.User{UserID: "u2", Username: "bob"}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, "u3").Return(&models.User{UserID: "u3", Username: "eve"}, nil)
+	mockNotifRepo.EXPECT().GetNotificationPreferences(ctx, "u2").Return(nil, nil)
+	mockNotifRepo.EXPECT().GetNotificationPreferences(ctx, "u3").Return(nil, nil)
+	mockPRRepo.EXPECT().SetReviewReminded(ctx, "default", "unreachable", "u3", gomock.Any()).Return(nil)
+	mockPRRepo.EXPECT().SetReviewReminded(ctx, "default", "waiting", "u2", gomock.Any()).Return(nil)
+
+	assert.ErrorContains(t, svc.SendReminders(ctx), "550 mailbox unavailable")
+
+	require.Len(t, logChannel.sent, 1)
+	assert.Equal(t, "Reminder: PR waiting is waiting for your review", logChannel.sent[0].Subject)
+	assert.Contains(t, logChannel.sent[0].Body, "for 30 h")
+}
+
+func TestNotificationServiceImpl_SendDigests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotifRepo := mocks.NewMockNotificationRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	logChannel := &recordingNotifier{}
+	failing := &recordingNotifier{err: errors.New("mailbox full")}
+	svc := NewNotificationService(nil, mockNotifRepo, nil, mockUserRepo,
+		map[string]notify.Notifier{notify.ChannelLog: logChannel, notify.ChannelEmail: failing}, time.Hour)
+	ctx := context.Background()
+
+	mockNotifRepo.EXPECT().GetDigestItems(ctx).Return([]*models.DigestItem{
+		{ID: 1, UserID: "u2", Subject: "Review requested: A", Body: "A\n"},
+		{ID: 3, UserID: "u2", Subject: "Review requested: B", Body: "B\n"},
+		{ID: 2, UserID: "u3", Subject: "Review requested: C", Body: "C\n"},
+	}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, "u2").Return(&models.User{UserID: "u2", Username: "bob"}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, "u3").Return(&models.User{UserID: "u3", Username: "eve"}, nil)
+	mockNotifRepo.EXPECT().GetNotificationPreferences(ctx, "u2").Return(&models.NotificationPreferences{UserID: "u2", Mode: models.NotificationModeDigest, Channel: notify.ChannelLog}, nil)
+	mockNotifRepo.EXPECT().GetNotificationPreferences(ctx, "u3").Return(&models.NotificationPreferences{UserID: "u3", Mode: models.NotificationModeDigest, Channel: notify.ChannelEmail, Email: "eve@example.com"}, nil)
+	mockNotifRepo.EXPECT().DeleteDigestItems(ctx, []int64{1, 3}).Return(nil)
+
+	err := svc.SendDigests(ctx)
+
+	assert.ErrorContains(t, err, "mailbox full")
+	require.Len(t, logChannel.sent, 1)
+	assert.Equal(t, "Review digest: 2 update(s)", logChannel.sent[0].Subject)
+	assert.Contains(t, logChannel.sent[0].Body, "Review requested: B")
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+// normalizePullRequestMetadata проверяет описательные поля PR: URL должен быть
+// абсолютным http(s)-адресом, размеры - неотрицательными. Метки нормализуются как
+// навыки, приоритет по умолчанию - normal, число файлов по умолчанию - число changed_files.
+func normalizePullRequestMetadata(pr *models.PullRequest) error {
+	if pr.URL != "" {
+		parsed, err := url.Parse(pr.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("%w: url must be an absolute http(s) URL", ErrInvalidArgument)
+		}
+	}
+
+	if pr.LinesAdded < 0 || pr.LinesRemoved < 0 || pr.FilesChanged < 0 {
+		return fmt.Errorf("%w: lines_added, lines_removed and files_changed must not be negative", ErrInvalidArgument)
+	}
+	if pr.FilesChanged == 0 {
+		pr.FilesChanged = len(pr.ChangedFiles)
+	}
+
+	labels, err := normalizeTags("label", pr.Labels)
+	if err != nil {
+		return err
+	}
+	pr.Labels = labels
+
+	priority, err := normalizePriority(pr.Priority)
+	if err != nil {
+		return err
+	}
+	pr.Priority = priority
+
+	return nil
+}
+
+func normalizePriority(priority string) (string, error) {
+	switch priority {
+	case "":
+		return models.PriorityNormal, nil
+	case models.PriorityLow, models.PriorityNormal, models.PriorityHigh, models.PriorityCritical:
+		return priority, nil
+	default:
+		return "", fmt.Errorf("%w: unknown priority %q", ErrInvalidArgument, priority)
+	}
+}
+
+// ruleMatches сообщает, подходит ли PR под все заданные условия правила.
+func ruleMatches(rule models.AssignmentRule, pr *models.PullRequest) bool {
+	if rule.Label != "" && !slices.Contains(pr.Labels, rule.Label) {
+		return false
+	}
+	if rule.Priority != "" && pr.Priority != rule.Priority {
+		return false
+	}
+	return pr.LinesAdded+pr.LinesRemoved >= rule.MinChangedLines
+}
+
+// reviewerCountFor возвращает число ревьюверов PR: число из настроек репозитория плюс
+// дополнительные ревьюверы всех подходящих правил, но не больше maxReviewerCount.
+func reviewerCountFor(repo *models.Repo, pr *models.PullRequest) int {
+	count := reviewerCount(repo)
+	for _, rule := range repo.Rules {
+		if ruleMatches(rule, pr) {
+			count += rule.ExtraReviewers
+		}
+	}
+	return min(count, maxReviewerCount)
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestNormalizePullRequestMetadata(t *testing.T) {
+	t.Run("defaults and normalization", func(t *testing.T) {
+		pr := &models.PullRequest{
+			URL:          "https://git.example.com/backend/pull/42",
+			Labels:       []string{" Security", "bug", "security"},
+			ChangedFiles: []string{"a.go", "b.go"},
+		}
+
+		require.NoError(t, normalizePullRequestMetadata(pr))
+		assert.Equal(t, []string{"bug", "security"}, pr.Labels)
+		assert.Equal(t, models.PriorityNormal, pr.Priority)
+		assert.Equal(t, 2, pr.FilesChanged)
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		prs := []*models.PullRequest{
+			{URL: "git.example.com/pull/42"},
+			{URL: "ftp://git.example.com/pull/42"},
+			{LinesAdded: -1},
+			{FilesChanged: -3},
+			{Labels: []string{" "}},
+			{Priority: "urgent"},
+		}
+		for _, pr := range prs {
+			assert.ErrorIs(t, normalizePullRequestMetadata(pr), ErrInvalidArgument)
+		}
+	})
+}
+
+func TestReviewerCountFor(t *testing.T) {
+	repo := &models.Repo{Rules: []models.AssignmentRule{
+		{Label: "security", ExtraReviewers: 1},
+		{MinChangedLines: 500, ExtraReviewers: 1},
+		{Label: "security", Priority: models.PriorityCritical, ExtraReviewers: 9},
+	}}
+
+	tests := []struct {
+		name  string
+		pr    *models.PullRequest
+		count int
+	}{
+		{"no rule matches", &models.PullRequest{Labels: []string{"bug"}, LinesAdded: 10, Priority: models.PriorityNormal}, 2},
+		{"label", &models.PullRequest{Labels: []string{"bug", "security"}, Priority: models.PriorityNormal}, 3},
+		{"size counts added and removed lines", &models.PullRequest{LinesAdded: 300, LinesRemoved: 200}, 3},
+		{"rules add up", &models.PullRequest{Labels: []string{"security"}, LinesAdded: 800}, 4},
+		{"capped", &models.PullRequest{Labels: []string{"security"}, Priority: models.PriorityCritical}, maxReviewerCount},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.count, reviewerCountFor(repo, tt.pr), tt.name)
+	}
+}
@@ -5,37 +5,169 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	"pr-reviewer-assignment-service/internal/models"
 	"pr-reviewer-assignment-service/internal/repository"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// defaultReviewerCount - число ревьюверов PR, если в репозитории не задано другое.
+	// Владельцы кода назначаются сверх него, если их больше.
+	defaultReviewerCount = 2
+)
+
 type PullRequestServiceImpl struct {
-	prRepo   repository.PullRequestRepository
-	userRepo repository.UserRepository
-	teamRepo repository.TeamRepository
-	userSvc  UserService
-	randGen  *rand.Rand
+	prRepo     repository.PullRequestRepository
+	userRepo   repository.UserRepository
+	teamRepo   repository.TeamRepository
+	repoRepo   repository.RepoRepository
+	ownersRepo repository.CodeOwnersRepository
+	userSvc    UserService
+	randGen    *rand.Rand
+
+	rotationHistorySize int
+	rotationWindow      time.Duration
+	businessHours       BusinessHours
+
+	tx     repository.Transactor
+	events repository.EventRepository
 }
 
 func NewPullRequestService(
 	prRepo repository.PullRequestRepository,
 	userRepo repository.UserRepository,
 	teamRepo repository.TeamRepository,
+	repoRepo repository.RepoRepository,
+	ownersRepo repository.CodeOwnersRepository,
 	userSvc UserService,
 ) *PullRequestServiceImpl {
 	return &PullRequestServiceImpl{
-		prRepo:   prRepo,
-		userRepo: userRepo,
-		teamRepo: teamRepo,
-		userSvc:  userSvc,
-		randGen:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		prRepo:     prRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		repoRepo:   repoRepo,
+		ownersRepo: ownersRepo,
+		userSvc:    userSvc,
+		randGen:    rand.New(rand.NewSource(time.Now().UnixNano())),
+
+		rotationHistorySize: defaultRotationHistorySize,
+		rotationWindow:      defaultRotationWindow,
+		businessHours:       DefaultBusinessHours(),
 	}
 }
 
+// CreatePullRequest создаёт PR и назначает ревьюверов по настройкам его репозитория.
+// Владельцы изменённых файлов назначаются обязательно, остальные места заполняются
+// стратегией репозитория. Если PR с таким ID уже есть в репозитории, возвращается
+// существующий PR вместе с ErrPRExists. Черновику ревьюверы назначаются в MarkReadyForReview.
 func (s *PullRequestServiceImpl) CreatePullRequest(ctx context.Context, pr *models.PullRequest) (*models.PullRequest, error) {
-	err := s.userSvc.ValidateUserExists(ctx, pr.AuthorID)
+	err := normalizeNewPullRequest(pr)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.prRepo.PullRequestExists(ctx, pr.Repository, pr.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check PR existence: %w", err)
+	}
+	if exists {
+		return s.existingPullRequest(ctx, pr.Repository, pr.PullRequestID)
+	}
+
+	if pr.Draft {
+		pr.AssignedReviewers = []string{}
+		err = s.validateDraft(ctx, pr)
+	} else {
+		_, err = s.assignReviewers(ctx, pr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		if err := s.prRepo.CreatePullRequest(ctx, pr); err != nil {
+			return err
+		}
+		if pr.Draft {
+			return nil
+		}
+		return s.enqueue(ctx, newPullRequestEvent(models.EventReviewersAssigned, pr))
+	})
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return s.existingPullRequest(ctx, pr.Repository, pr.PullRequestID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+
+	return pr, nil
+}
+
+// validateDraft проверяет репозиторий, автора и исключённых ревьюверов черновика так же, как
+// assignReviewers, не подбирая ревьюверов.
+func (s *PullRequestServiceImpl) validateDraft(ctx context.Context, pr *models.PullRequest) error {
+	if _, err := s.getRepo(ctx, pr.Repository); err != nil {
+		return err
+	}
+	if err := s.userSvc.ValidateUserExists(ctx, pr.AuthorID); err != nil {
+		return fmt.Errorf("invalid author: %w", err)
+	}
+	return s.normalizeExcludedReviewers(ctx, pr)
+}
+
+// normalizeNewPullRequest проверяет поля создаваемого PR и приводит их к каноническому виду.
+func normalizeNewPullRequest(pr *models.PullRequest) error {
+	requiredSkills, err := normalizeSkills(pr.RequiredSkills)
+	if err != nil {
+		return err
+	}
+	skillMatch, err := normalizeSkillMatch(pr.SkillMatch)
+	if err != nil {
+		return err
+	}
+	pr.RequiredSkills, pr.SkillMatch = requiredSkills, skillMatch
+
+	changedFiles, err := normalizeChangedFiles(pr.ChangedFiles)
+	if err != nil {
+		return err
+	}
+	pr.Repository, pr.ChangedFiles = repositoryOrDefault(pr.Repository), changedFiles
+
+	return normalizePullRequestMetadata(pr)
+}
+
+// DryRunPullRequest подбирает ревьюверов для PR так же, как CreatePullRequest, но ничего
+// не сохраняет. Случайная стратегия даёт один из возможных составов. В ответе перечислены
+// пользователи, исключённые запретами пар и исключениями PR.
+func (s *PullRequestServiceImpl) DryRunPullRequest(ctx context.Context, pr *models.PullRequest) (*models.AssignmentPreview, error) {
+	err := normalizeNewPullRequest(pr)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts, err := s.assignReviewers(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.AssignmentPreview{PullRequest: pr, Excluded: conflicts.exclusions()}, nil
+}
+
+// assignReviewers подбирает ревьюверов PR в pr.AssignedReviewers: владельцев кода, ревьюверов,
+// которых требуют политики команды автора, и остальных по настройкам репозитория. Возвращает
+// исключения, которые учитывались при подборе.
+func (s *PullRequestServiceImpl) assignReviewers(ctx context.Context, pr *models.PullRequest) (reviewerConflicts, error) {
+	repo, err := s.getRepo(ctx, pr.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.userSvc.ValidateUserExists(ctx, pr.AuthorID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid author: %w", err)
 	}
@@ -46,77 +178,363 @@ func (s *PullRequestServiceImpl) CreatePullRequest(ctx context.Context, pr *mode
 	}
 
 	if author.TeamName == "" {
-		return nil, errors.New("author is not assigned to any team")
+		return nil, fmt.Errorf("invalid author: %w", ErrUserWithoutTeam)
 	}
 
-	activeMembers, err := s.userRepo.GetActiveUsersByTeam(ctx, author.TeamName)
+	err = s.normalizeExcludedReviewers(ctx, pr)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get team members: %w", err)
+		return nil, err
 	}
 
-	var candidates []*models.User
-	for _, member := range activeMembers {
-		if member.UserID != pr.AuthorID {
-			candidates = append(candidates, member)
+	conflicts, err := s.reviewerConflicts(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	rotation, err := s.rotationWeights(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedReviewers, err := s.codeOwnerReviewers(ctx, pr, conflicts, rotation)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := make(map[string]bool, len(selectedReviewers))
+	uncovered := skillSet(pr.RequiredSkills)
+	for _, owner := range selectedReviewers {
+		taken[owner.UserID] = true
+		for _, skill := range owner.Skills {
+			delete(uncovered, skill)
 		}
 	}
 
-	selectedReviewers := s.selectRandomReviewers(candidates, 2)
+	requirements, err := s.levelRequirements(ctx, repo, pr, author)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ревьюверы, которых требуют политики команды автора и правила репозитория, назначаются
+	// первыми и занимают места в общем числе ревьюверов, но назначаются и сверх него.
+	for _, requirement := range unsatisfiedRequirements(requirements, selectedReviewers) {
+		candidates, err := s.assignmentCandidates(ctx, repo, author.TeamName, func(member *models.User) bool {
+			return member.UserID != pr.AuthorID && !taken[member.UserID] && conflicts.allows(member.UserID) && requirement.allows(member)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrPolicyViolation, requirement)
+		}
+
+		picked, err := s.pickReviewers(ctx, repo, pr, candidates, 1, uncovered, rotation)
+		if err != nil {
+			return nil, err
+		}
+		taken[picked[0].UserID] = true
+		for _, skill := range picked[0].Skills {
+			delete(uncovered, skill)
+		}
+		selectedReviewers = append(selectedReviewers, picked[0])
+	}
+	requireSkills := pr.SkillMatch == models.SkillMatchRequire && len(uncovered) > 0
+
+	if remaining := reviewerCountFor(repo, pr) - len(selectedReviewers); remaining > 0 {
+		candidates, err := s.assignmentCandidates(ctx, repo, author.TeamName, func(member *models.User) bool {
+			return member.UserID != pr.AuthorID && !taken[member.UserID] && conflicts.allows(member.UserID) && (!requireSkills || hasAnySkill(member, uncovered))
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		picked, err := s.pickReviewers(ctx, repo, pr, candidates, remaining, uncovered, rotation)
+		if err != nil {
+			return nil, err
+		}
+		selectedReviewers = append(selectedReviewers, picked...)
+	}
+	if requireSkills && len(uncovered) > 0 {
+		return nil, fmt.Errorf("%w: no reviewers with skills %v", ErrNoCandidate, sortedSkills(uncovered))
+	}
+
 	pr.AssignedReviewers = make([]string, len(selectedReviewers))
 	for i, reviewer := range selectedReviewers {
 		pr.AssignedReviewers[i] = reviewer.UserID
 	}
 
-	err = s.prRepo.CreatePullRequest(ctx, pr)
+	return conflicts, nil
+}
+
+func (s *PullRequestServiceImpl) existingPullRequest(ctx context.Context, repo, prID string) (*models.PullRequest, error) {
+	existing, err := s.prRepo.GetPullRequestByID(ctx, repo, prID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create pull request: %w", err)
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+	return existing, ErrPRExists
+}
+
+// MarkReadyForReview снимает с PR отметку черновика и назначает ревьюверов так же, как
+// CreatePullRequest. Для PR, который не черновик, возвращает его без изменений.
+func (s *PullRequestServiceImpl) MarkReadyForReview(ctx context.Context, repo, prID string) (*models.PullRequest, error) {
+	repo = repositoryOrDefault(repo)
+
+	pr, err := s.prRepo.GetPullRequestByID(ctx, repo, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+	if pr == nil {
+		return nil, ErrPRNotFound
+	}
+	if pr.Status == models.PRStatusMerged {
+		return nil, fmt.Errorf("cannot mark ready for review: %w", ErrPRMerged)
+	}
+	if !pr.Draft {
+		return pr, nil
+	}
+
+	_, err = s.assignReviewers(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		ready, err := s.prRepo.SetPullRequestReady(ctx, repo, prID)
+		if err != nil {
+			return fmt.Errorf("failed to mark pull request ready: %w", err)
+		}
+		// Параллельный вызов уже назначил ревьюверов.
+		if !ready {
+			return nil
+		}
+		if err := s.prRepo.SetAssignedReviewers(ctx, repo, prID, pr.AssignedReviewers); err != nil {
+			return fmt.Errorf("failed to update reviewers: %w", err)
+		}
+		return s.enqueue(ctx, newPullRequestEvent(models.EventReviewersAssigned, pr))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err = s.prRepo.GetPullRequestByID(ctx, repo, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+	if pr == nil {
+		return nil, ErrPRNotFound
 	}
 
 	return pr, nil
 }
 
-func (s *PullRequestServiceImpl) MergePullRequest(ctx context.Context, prID string) error {
-	exists, err := s.prRepo.PullRequestExists(ctx, prID)
+// MergePullRequest идемпотентен: повторный вызов возвращает PR с исходным mergedAt.
+func (s *PullRequestServiceImpl) MergePullRequest(ctx context.Context, repo, prID string) (*models.PullRequest, error) {
+	repo = repositoryOrDefault(repo)
+
+	exists, err := s.prRepo.PullRequestExists(ctx, repo, prID)
 	if err != nil {
-		return fmt.Errorf("failed to check PR existence: %w", err)
+		return nil, fmt.Errorf("failed to check PR existence: %w", err)
 	}
 	if !exists {
-		return errors.New("pull request not found")
+		return nil, ErrPRNotFound
 	}
 
-	err = s.prRepo.MergePullRequest(ctx, prID)
+	var pr *models.PullRequest
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		merged, err := s.prRepo.MergePullRequest(ctx, repo, prID)
+		if err != nil {
+			return fmt.Errorf("failed to merge pull request: %w", err)
+		}
+
+		pr, err = s.prRepo.GetPullRequestByID(ctx, repo, prID)
+		if err != nil {
+			return fmt.Errorf("failed to get pull request: %w", err)
+		}
+		if pr == nil {
+			return ErrPRNotFound
+		}
+
+		// Повторный merge событие не порождает.
+		if !merged {
+			return nil
+		}
+		return s.enqueue(ctx, newPullRequestEvent(models.EventPullRequestMerged, pr))
+	})
 	if err != nil {
-		return fmt.Errorf("failed to merge pull request: %w", err)
+		return nil, err
 	}
 
-	return nil
+	return pr, nil
 }
 
-func (s *PullRequestServiceImpl) GetUserPullRequests(ctx context.Context, userID string) ([]*models.PullRequestShort, error) {
+// GetUserPullRequests возвращает страницу PR, где пользователь назначен ревьювером.
+// По умолчанию возвращаются только открытые PR.
+func (s *PullRequestServiceImpl) GetUserPullRequests(ctx context.Context, userID string, filter models.ReviewFilter, cursor string) (*models.ReviewPage, error) {
 	err := s.userSvc.ValidateUserExists(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid user: %w", err)
 	}
 
-	prs, err := s.prRepo.GetPullRequestsByReviewer(ctx, userID)
+	switch filter.Status {
+	case "":
+		filter.Status = models.PRStatusOpen
+	case models.PRStatusOpen, models.PRStatusMerged, models.PRStatusAll:
+	default:
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidArgument, filter.Status)
+	}
+
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultPageSize
+	case filter.Limit < 0 || filter.Limit > maxPageSize:
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidArgument, maxPageSize)
+	}
+
+	filter.Cursor, err = decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := filter.Limit
+	filter.Limit = pageSize + 1
+
+	prs, err := s.prRepo.GetPullRequestsByReviewer(ctx, userID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pull requests for user: %w", err)
 	}
 
-	return prs, nil
+	total, err := s.prRepo.CountPullRequestsByReviewer(ctx, userID, filter.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pull requests for user: %w", err)
+	}
+
+	page := &models.ReviewPage{
+		UserID:       userID,
+		PullRequests: prs,
+		TotalCount:   total,
+	}
+	if len(prs) > pageSize {
+		page.PullRequests = prs[:pageSize]
+		last := prs[pageSize-1]
+		page.NextCursor = encodeCursor(last.Repository, last.PullRequestID, last.CreatedAt)
+	}
+	if page.PullRequests == nil {
+		page.PullRequests = []*models.PullRequestShort{}
+	}
+
+	now := time.Now()
+	for _, pr := range page.PullRequests {
+		pr.SLA = s.businessHours.reviewShortSLAState(pr, now)
+	}
+
+	return page, nil
 }
 
-func (s *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) error {
-	pr, err := s.prRepo.GetPullRequestByID(ctx, prID)
+func (s *PullRequestServiceImpl) GetPullRequest(ctx context.Context, repo, prID string) (*models.PullRequest, error) {
+	pr, err := s.prRepo.GetPullRequestByID(ctx, repositoryOrDefault(repo), prID)
 	if err != nil {
-		return fmt.Errorf("failed to get pull request: %w", err)
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
 	}
 	if pr == nil {
-		return errors.New("pull request not found")
+		return nil, ErrPRNotFound
+	}
+
+	pr.SLA = s.businessHours.pullRequestSLAState(pr, time.Now())
+	return pr, nil
+}
+
+// ListPullRequests возвращает страницу PR по фильтру. cursor - значение next_cursor
+// из предыдущей страницы, пустая строка означает первую страницу.
+func (s *PullRequestServiceImpl) ListPullRequests(ctx context.Context, filter models.PullRequestFilter, cursor string) (*models.PullRequestPage, error) {
+	if err := normalizePullRequestFilter(&filter); err != nil {
+		return nil, err
+	}
+
+	decoded, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	filter.Cursor = decoded
+
+	pageSize := filter.Limit
+	filter.Limit = pageSize + 1
+
+	prs, err := s.prRepo.ListPullRequests(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+
+	page := &models.PullRequestPage{PullRequests: prs}
+	if len(prs) > pageSize {
+		page.PullRequests = prs[:pageSize]
+		last := prs[pageSize-1]
+		page.NextCursor = encodeCursor(last.Repository, last.PullRequestID, last.CreatedAt)
+	}
+	if page.PullRequests == nil {
+		page.PullRequests = []*models.PullRequest{}
+	}
+
+	now := time.Now()
+	for _, pr := range page.PullRequests {
+		pr.SLA = s.businessHours.pullRequestSLAState(pr, now)
+	}
+
+	return page, nil
+}
+
+func normalizePullRequestFilter(filter *models.PullRequestFilter) error {
+	switch filter.Status {
+	case "", models.PRStatusOpen, models.PRStatusMerged:
+	default:
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidArgument, filter.Status)
+	}
+
+	if filter.Priority != "" {
+		if _, err := normalizePriority(filter.Priority); err != nil {
+			return err
+		}
+	}
+	filter.Label = strings.ToLower(strings.TrimSpace(filter.Label))
+
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = models.PRSortByCreatedAt
+	case models.PRSortByCreatedAt, models.PRSortByID:
+	default:
+		return fmt.Errorf("%w: unknown sort field %q", ErrInvalidArgument, filter.SortBy)
+	}
+
+	switch filter.SortOrder {
+	case "":
+		filter.SortOrder = models.SortOrderDesc
+	case models.SortOrderAsc, models.SortOrderDesc:
+	default:
+		return fmt.Errorf("%w: unknown sort order %q", ErrInvalidArgument, filter.SortOrder)
+	}
+
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultPageSize
+	case filter.Limit < 0 || filter.Limit > maxPageSize:
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidArgument, maxPageSize)
+	}
+
+	return nil
+}
+
+func (s *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, repo, prID string, oldReviewerID string) (*models.PullRequest, string, error) {
+	repo = repositoryOrDefault(repo)
+
+	pr, err := s.prRepo.GetPullRequestByID(ctx, repo, prID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get pull request: %w", err)
+	}
+	if pr == nil {
+		return nil, "", ErrPRNotFound
 	}
 
 	if pr.Status == models.PRStatusMerged {
-		return errors.New("cannot reassign reviewer for merged pull request")
+		return nil, "", fmt.Errorf("cannot reassign reviewer: %w", ErrPRMerged)
 	}
 
 	isAssigned := false
@@ -127,35 +545,90 @@ func (s *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, prID stri
 		}
 	}
 	if !isAssigned {
-		return errors.New("reviewer is not assigned to this pull request")
+		return nil, "", ErrNotAssigned
 	}
 
 	oldReviewer, err := s.userSvc.GetUserWithTeam(ctx, oldReviewerID)
 	if err != nil {
-		return fmt.Errorf("failed to get reviewer team: %w", err)
+		return nil, "", fmt.Errorf("failed to get reviewer team: %w", err)
 	}
 
 	if oldReviewer.TeamName == "" {
-		return errors.New("reviewer is not assigned to any team")
+		return nil, "", fmt.Errorf("invalid reviewer: %w", ErrUserWithoutTeam)
 	}
 
-	activeMembers, err := s.userRepo.GetActiveUsersByTeam(ctx, oldReviewer.TeamName)
+	assigned := make(map[string]bool, len(pr.AssignedReviewers))
+	for _, reviewerID := range pr.AssignedReviewers {
+		assigned[reviewerID] = true
+	}
+
+	author, err := s.userRepo.GetUserByID(ctx, pr.AuthorID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get author: %w", err)
+	}
+	settings, err := s.getRepo(ctx, repo)
 	if err != nil {
-		return fmt.Errorf("failed to get team members: %w", err)
+		return nil, "", err
+	}
+	requirements, err := s.levelRequirements(ctx, settings, pr, author)
+	if err != nil {
+		return nil, "", err
+	}
+	conflicts, err := s.reviewerConflicts(ctx, pr)
+	if err != nil {
+		return nil, "", err
+	}
+	rotation, err := s.rotationWeights(ctx, author)
+	if err != nil {
+		return nil, "", err
 	}
 
-	var candidates []*models.User
-	for _, member := range activeMembers {
-		if member.UserID != pr.AuthorID && member.UserID != oldReviewerID {
-			candidates = append(candidates, member)
+	// Навыки PR и требования политик, которые не покрывают оставшиеся ревьюверы,
+	// должен закрыть новый.
+	uncovered := skillSet(pr.RequiredSkills)
+	var remaining []*models.User
+	for _, reviewerID := range pr.AssignedReviewers {
+		if reviewerID == oldReviewerID || (len(uncovered) == 0 && len(requirements) == 0) {
+			continue
+		}
+		reviewer, err := s.userSvc.GetUserWithTeam(ctx, reviewerID)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get reviewer %s: %w", reviewerID, err)
+		}
+		for _, skill := range reviewer.Skills {
+			delete(uncovered, skill)
+		}
+		remaining = append(remaining, reviewer)
+	}
+	requireSkills := pr.SkillMatch == models.SkillMatchRequire && len(uncovered) > 0
+	requirements = unsatisfiedRequirements(requirements, remaining)
+
+	candidates, err := s.assignmentCandidates(ctx, settings, oldReviewer.TeamName, func(member *models.User) bool {
+		if member.UserID == pr.AuthorID || assigned[member.UserID] || !conflicts.allows(member.UserID) ||
+			(requireSkills && !hasAnySkill(member, uncovered)) {
+			return false
 		}
+		return len(unsatisfiedRequirements(requirements, []*models.User{member})) == 0
+	})
+	if err != nil {
+		return nil, "", err
 	}
 
 	if len(candidates) == 0 {
-		return errors.New("no candidate reviewers available")
+		if len(requirements) > 0 {
+			return nil, "", fmt.Errorf("%w: %s", ErrPolicyViolation, requirements[0])
+		}
+		return nil, "", ErrNoCandidate
 	}
 
-	newReviewer := candidates[s.randGen.Intn(len(candidates))]
+	picked, err := s.pickReviewers(ctx, settings, pr, candidates, 1, uncovered, rotation)
+	if err != nil {
+		return nil, "", err
+	}
+	if requireSkills && len(uncovered) > 0 {
+		return nil, "", fmt.Errorf("%w: no reviewer with skills %v", ErrNoCandidate, sortedSkills(uncovered))
+	}
+	newReviewer := picked[0]
 
 	for i, reviewerID := range pr.AssignedReviewers {
 		if reviewerID == oldReviewerID {
@@ -164,15 +637,117 @@ func (s *PullRequestServiceImpl) ReassignReviewer(ctx context.Context, prID stri
 		}
 	}
 
-	err = s.prRepo.SetAssignedReviewers(ctx, prID, pr.AssignedReviewers)
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		if err := s.prRepo.SetAssignedReviewers(ctx, repo, prID, pr.AssignedReviewers); err != nil {
+			return fmt.Errorf("failed to update reviewers: %w", err)
+		}
+		return s.enqueue(ctx, newReassignedEvent(pr, oldReviewerID, newReviewer.UserID))
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update reviewers: %w", err)
+		return nil, "", err
 	}
 
-	return nil
+	return pr, newReviewer.UserID, nil
+}
+
+func repositoryOrDefault(repo string) string {
+	if repo = strings.TrimSpace(repo); repo == "" {
+		return models.DefaultRepository
+	}
+	return repo
+}
+
+func (s *PullRequestServiceImpl) getRepo(ctx context.Context, name string) (*models.Repo, error) {
+	repo, err := s.repoRepo.GetRepo(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+	if repo == nil {
+		return nil, ErrRepoNotFound
+	}
+	return repo, nil
 }
 
-func (s *PullRequestServiceImpl) selectRandomReviewers(candidates []*models.User, count int) []*models.User {
+func reviewerCount(repo *models.Repo) int {
+	if repo.ReviewerCount == nil {
+		return defaultReviewerCount
+	}
+	return *repo.ReviewerCount
+}
+
+// assignmentCandidates возвращает кандидатов в ревьюверы PR репозитория repo: активных
+// участников его допустимых команд, а если они не заданы - команды team (см. reviewerCandidates).
+func (s *PullRequestServiceImpl) assignmentCandidates(ctx context.Context, repo *models.Repo, team string, eligible func(*models.User) bool) ([]*models.User, error) {
+	if len(repo.EligibleTeams) == 0 {
+		return s.reviewerCandidates(ctx, team, eligible)
+	}
+
+	var candidates []*models.User
+	seen := make(map[string]bool)
+	for _, eligibleTeam := range repo.EligibleTeams {
+		members, err := s.userRepo.GetActiveUsersByTeam(ctx, eligibleTeam)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team members: %w", err)
+		}
+		for _, member := range members {
+			if !seen[member.UserID] && eligible(member) {
+				seen[member.UserID] = true
+				candidates = append(candidates, member)
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+// reviewerCandidates возвращает активных участников команды teamName, прошедших фильтр eligible.
+// Если в команде подходящих кандидатов нет, поиск поднимается к родительской команде и выше.
+func (s *PullRequestServiceImpl) reviewerCandidates(ctx context.Context, teamName string, eligible func(*models.User) bool) ([]*models.User, error) {
+	visited := make(map[string]bool)
+	team := teamName
+	for team != "" && !visited[team] {
+		visited[team] = true
+
+		activeMembers, err := s.userRepo.GetActiveUsersByTeam(ctx, team)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team members: %w", err)
+		}
+
+		var candidates []*models.User
+		for _, member := range activeMembers {
+			if eligible(member) {
+				candidates = append(candidates, member)
+			}
+		}
+		if len(candidates) > 0 {
+			return candidates, nil
+		}
+
+		current, err := s.teamRepo.GetTeamByName(ctx, team)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team %s: %w", team, err)
+		}
+		if current == nil {
+			break
+		}
+		team = current.ParentTeam
+	}
+
+	return nil, nil
+}
+
+// pickReviewers выбирает count ревьюверов из candidates по стратегии репозитория.
+// С требуемыми навыками PR выбор идёт через selectBySkills, который вычёркивает
+// покрытые навыки из uncovered. rotation снижает шансы недавних ревьюверов автора.
+func (s *PullRequestServiceImpl) pickReviewers(ctx context.Context, repo *models.Repo, pr *models.PullRequest, candidates []*models.User, count int, uncovered map[string]bool, rotation rotationWeights) ([]*models.User, error) {
+	if len(pr.RequiredSkills) == 0 && repo.Strategy != models.StrategyLeastLoaded {
+		return s.selectRandomReviewers(candidates, count, rotation), nil
+	}
+	// Без требуемых навыков selectBySkills выбирает наименее загруженных.
+	return s.selectBySkills(ctx, candidates, count, uncovered, rotation)
+}
+
+func (s *PullRequestServiceImpl) selectRandomReviewers(candidates []*models.User, count int, rotation rotationWeights) []*models.User {
 	if len(candidates) <= count {
 		return candidates
 	}
@@ -183,7 +758,7 @@ func (s *PullRequestServiceImpl) selectRandomReviewers(candidates []*models.User
 	selected := make([]*models.User, 0, count)
 
 	for i := 0; i < count && len(available) > 0; i++ {
-		randomIndex := s.randGen.Intn(len(available))
+		randomIndex := s.pickWeighted(available, rotation)
 
 		selected = append(selected, available[randomIndex])
 
@@ -192,3 +767,35 @@ func (s *PullRequestServiceImpl) selectRandomReviewers(candidates []*models.User
 
 	return selected
 }
+
+// pickWeighted возвращает индекс случайного кандидата с вероятностью, пропорциональной
+// его весу в команде с учётом штрафа ротации. При равных весах выбор равновероятен.
+func (s *PullRequestServiceImpl) pickWeighted(candidates []*models.User, rotation rotationWeights) int {
+	weights := make([]float64, len(candidates))
+	total := 0.0
+	for i, candidate := range candidates {
+		weights[i] = float64(reviewWeight(candidate)) * rotation.of(candidate.UserID)
+		total += weights[i]
+	}
+	// Если штраф обнулил веса всех кандидатов, выбор идёт без его учёта.
+	if total <= 0 {
+		return s.pickWeighted(candidates, nil)
+	}
+
+	point := s.randGen.Float64() * total
+	for i, weight := range weights {
+		point -= weight
+		if point < 0 {
+			return i
+		}
+	}
+
+	return len(candidates) - 1
+}
+
+func reviewWeight(user *models.User) int {
+	if user.ReviewWeight <= 0 {
+		return models.DefaultMemberWeight
+	}
+	return user.ReviewWeight
+}
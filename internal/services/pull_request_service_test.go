@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -11,8 +12,20 @@ import (
 
 	"pr-reviewer-assignment-service/internal/mocks"
 	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
 )
 
+// newDefaultRepoRepo возвращает репозиторий, в котором любой запрошенный репозиторий
+// существует и использует настройки по умолчанию.
+func newDefaultRepoRepo(ctrl *gomock.Controller) *mocks.MockRepoRepository {
+	mockRepoRepo := mocks.NewMockRepoRepository(ctrl)
+	mockRepoRepo.EXPECT().GetRepo(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, name string) (*models.Repo, error) {
+			return &models.Repo{Name: name}, nil
+		}).AnyTimes()
+	return mockRepoRepo
+}
+
 func TestPullRequestServiceImpl_selectRandomReviewers(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -27,7 +40,7 @@ func TestPullRequestServiceImpl_selectRandomReviewers(t *testing.T) {
 		userRepo: mockUserRepo,
 		teamRepo: mockTeamRepo,
 		userSvc:  &UserServiceImpl{userRepo: mockUserSvc},
-		randGen:  NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, &UserServiceImpl{userRepo: mockUserSvc}).randGen,
+		randGen:  NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, nil, nil, &UserServiceImpl{userRepo: mockUserSvc}).randGen,
 	}
 
 	candidates := []*models.User{
@@ -38,7 +51,7 @@ func TestPullRequestServiceImpl_selectRandomReviewers(t *testing.T) {
 	}
 
 	t.Run("select fewer than available", func(t *testing.T) {
-		selected := prSvc.selectRandomReviewers(candidates, 2)
+		selected := prSvc.selectRandomReviewers(candidates, 2, nil)
 
 		assert.Len(t, selected, 2)
 		userIDs := make(map[string]bool)
@@ -49,7 +62,7 @@ func TestPullRequestServiceImpl_selectRandomReviewers(t *testing.T) {
 	})
 
 	t.Run("select all available", func(t *testing.T) {
-		selected := prSvc.selectRandomReviewers(candidates, 4)
+		selected := prSvc.selectRandomReviewers(candidates, 4, nil)
 
 		assert.Len(t, selected, 4)
 		userIDs := make(map[string]bool)
@@ -60,22 +73,36 @@ func TestPullRequestServiceImpl_selectRandomReviewers(t *testing.T) {
 	})
 
 	t.Run("select more than available", func(t *testing.T) {
-		selected := prSvc.selectRandomReviewers(candidates, 10)
+		selected := prSvc.selectRandomReviewers(candidates, 10, nil)
 
 		assert.Len(t, selected, 4)
 	})
 
 	t.Run("empty candidates", func(t *testing.T) {
-		selected := prSvc.selectRandomReviewers([]*models.User{}, 2)
+		selected := prSvc.selectRandomReviewers([]*models.User{}, 2, nil)
 
 		assert.Len(t, selected, 0)
 	})
 
 	t.Run("select zero", func(t *testing.T) {
-		selected := prSvc.selectRandomReviewers(candidates, 0)
+		selected := prSvc.selectRandomReviewers(candidates, 0, nil)
 
 		assert.Len(t, selected, 0)
 	})
+
+	t.Run("weight biases selection", func(t *testing.T) {
+		weighted := []*models.User{
+			{UserID: "light", ReviewWeight: 1},
+			{UserID: "heavy", ReviewWeight: 99},
+		}
+
+		picks := map[string]int{}
+		for i := 0; i < 1000; i++ {
+			picks[weighted[prSvc.pickWeighted(weighted, nil)].UserID]++
+		}
+
+		assert.Greater(t, picks["heavy"], 900)
+	})
 }
 
 func TestPullRequestServiceImpl_GetUserPullRequests(t *testing.T) {
@@ -87,7 +114,7 @@ func TestPullRequestServiceImpl_GetUserPullRequests(t *testing.T) {
 	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
 	mockUserSvc := mocks.NewMockUserRepository(ctrl)
 
-	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, &UserServiceImpl{userRepo: mockUserSvc})
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserSvc})
 
 	ctx := context.Background()
 	userID := "test-user"
@@ -95,37 +122,611 @@ func TestPullRequestServiceImpl_GetUserPullRequests(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		expectedPRs := []*models.PullRequestShort{
 			{PullRequestID: "pr1", PullRequestName: "PR 1", AuthorID: "author1", Status: "OPEN"},
-			{PullRequestID: "pr2", PullRequestName: "PR 2", AuthorID: "author2", Status: "MERGED"},
+			{PullRequestID: "pr2", PullRequestName: "PR 2", AuthorID: "author2", Status: "OPEN"},
 		}
+		expectedFilter := models.ReviewFilter{Status: models.PRStatusOpen, Limit: defaultPageSize + 1}
 
 		mockUserSvc.EXPECT().UserExists(ctx, userID).Return(true, nil)
-		mockPRRepo.EXPECT().GetPullRequestsByReviewer(ctx, userID).Return(expectedPRs, nil)
+		mockPRRepo.EXPECT().GetPullRequestsByReviewer(ctx, userID, expectedFilter).Return(expectedPRs, nil)
+		mockPRRepo.EXPECT().CountPullRequestsByReviewer(ctx, userID, models.PRStatusOpen).Return(2, nil)
 
-		prs, err := prSvc.GetUserPullRequests(ctx, userID)
+		page, err := prSvc.GetUserPullRequests(ctx, userID, models.ReviewFilter{}, "")
 
 		require.NoError(t, err)
-		assert.Equal(t, expectedPRs, prs)
+		assert.Equal(t, userID, page.UserID)
+		assert.Equal(t, expectedPRs, page.PullRequests)
+		assert.Equal(t, 2, page.TotalCount)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("paginates with cursor", func(t *testing.T) {
+		now := time.Now()
+		prs := []*models.PullRequestShort{
+			{PullRequestID: "pr3", Status: "MERGED", CreatedAt: &now},
+			{PullRequestID: "pr2", Status: "OPEN", CreatedAt: &now},
+		}
+
+		mockUserSvc.EXPECT().UserExists(ctx, userID).Return(true, nil)
+		mockPRRepo.EXPECT().GetPullRequestsByReviewer(ctx, userID, models.ReviewFilter{Status: models.PRStatusAll, Limit: 2}).Return(prs, nil)
+		mockPRRepo.EXPECT().CountPullRequestsByReviewer(ctx, userID, models.PRStatusAll).Return(3, nil)
+
+		page, err := prSvc.GetUserPullRequests(ctx, userID, models.ReviewFilter{Status: models.PRStatusAll, Limit: 1}, "")
+
+		require.NoError(t, err)
+		assert.Len(t, page.PullRequests, 1)
+		assert.Equal(t, 3, page.TotalCount)
+
+		cursor, err := decodeCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "pr3", cursor.ID)
+
+		mockUserSvc.EXPECT().UserExists(ctx, userID).Return(true, nil)
+		mockPRRepo.EXPECT().GetPullRequestsByReviewer(ctx, userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, filter models.ReviewFilter) ([]*models.PullRequestShort, error) {
+				require.NotNil(t, filter.Cursor)
+				assert.Equal(t, "pr3", filter.Cursor.ID)
+				return prs[1:], nil
+			})
+		mockPRRepo.EXPECT().CountPullRequestsByReviewer(ctx, userID, models.PRStatusAll).Return(3, nil)
+
+		page, err = prSvc.GetUserPullRequests(ctx, userID, models.ReviewFilter{Status: models.PRStatusAll, Limit: 1}, page.NextCursor)
+
+		require.NoError(t, err)
+		assert.Equal(t, "pr2", page.PullRequests[0].PullRequestID)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		mockUserSvc.EXPECT().UserExists(ctx, userID).Return(true, nil)
+
+		page, err := prSvc.GetUserPullRequests(ctx, userID, models.ReviewFilter{Status: "CLOSED"}, "")
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, page)
 	})
 
 	t.Run("user not found", func(t *testing.T) {
 		mockUserSvc.EXPECT().UserExists(ctx, userID).Return(false, nil)
 
-		prs, err := prSvc.GetUserPullRequests(ctx, userID)
+		page, err := prSvc.GetUserPullRequests(ctx, userID, models.ReviewFilter{}, "")
 
 		assert.Error(t, err)
-		assert.Nil(t, prs)
+		assert.Nil(t, page)
 		assert.Contains(t, err.Error(), "invalid user")
+		assert.ErrorIs(t, err, ErrUserNotFound)
 	})
 
 	t.Run("repository error", func(t *testing.T) {
 		expectedErr := errors.New("db error")
 		mockUserSvc.EXPECT().UserExists(ctx, userID).Return(true, nil)
-		mockPRRepo.EXPECT().GetPullRequestsByReviewer(ctx, userID).Return(nil, expectedErr)
+		mockPRRepo.EXPECT().GetPullRequestsByReviewer(ctx, userID, gomock.Any()).Return(nil, expectedErr)
 
-		prs, err := prSvc.GetUserPullRequests(ctx, userID)
+		page, err := prSvc.GetUserPullRequests(ctx, userID, models.ReviewFilter{}, "")
 
 		assert.Error(t, err)
-		assert.Nil(t, prs)
+		assert.Nil(t, page)
 		assert.Contains(t, err.Error(), "failed to get pull requests for user")
 	})
 }
+
+func TestPullRequestServiceImpl_CreatePullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockTeamRepo.EXPECT().GetTeamPolicies(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockUserRepo.EXPECT().GetBlockedReviewers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", PullRequestName: "PR 1", AuthorID: "author", Status: models.PRStatusOpen}
+		author := &models.User{UserID: "author", TeamName: "team", IsActive: true}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			author,
+			{UserID: "reviewer", TeamName: "team", IsActive: true},
+		}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"reviewer"}, created.AssignedReviewers)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		existing := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, AssignedReviewers: []string{"reviewer"}}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(true, nil)
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(existing, nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"})
+
+		assert.ErrorIs(t, err, ErrPRExists)
+		assert.Equal(t, existing, created)
+	})
+
+	t.Run("concurrent insert of the same id", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen}
+		existing := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(&models.User{UserID: "author", TeamName: "team"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return(nil, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "team").Return(&models.Team{TeamName: "team"}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(repository.ErrDuplicateKey)
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(existing, nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		assert.ErrorIs(t, err, ErrPRExists)
+		assert.Equal(t, existing, created)
+	})
+
+	t.Run("author without team", func(t *testing.T) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(&models.User{UserID: "author"}, nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"})
+
+		assert.ErrorIs(t, err, ErrUserWithoutTeam)
+		assert.Nil(t, created)
+	})
+}
+
+func TestPullRequestServiceImpl_RepositorySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockTeamRepo.EXPECT().GetTeamPolicies(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockUserRepo.EXPECT().GetBlockedReviewers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+	mockRepoRepo := mocks.NewMockRepoRepository(ctrl)
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, mockRepoRepo, nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+	author := &models.User{UserID: "author", TeamName: "team", IsActive: true}
+
+	expectAuthor := func(repo string) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, repo, "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+	}
+
+	t.Run("eligible teams and reviewer count", func(t *testing.T) {
+		count := 3
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Repository: "infra"}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, "infra", "pr1").Return(false, nil)
+		mockRepoRepo.EXPECT().GetRepo(ctx, "infra").Return(&models.Repo{
+			Name:          "infra",
+			ReviewerCount: &count,
+			EligibleTeams: []string{"ops", "sre"},
+		}, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "ops").Return([]*models.User{{UserID: "ops1"}, {UserID: "both"}}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "sre").Return([]*models.User{{UserID: "both"}, {UserID: "sre1"}, author}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"ops1", "both", "sre1"}, created.AssignedReviewers)
+	})
+
+	t.Run("least loaded strategy", func(t *testing.T) {
+		count := 1
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockRepoRepo.EXPECT().GetRepo(ctx, models.DefaultRepository).Return(&models.Repo{
+			Name:          models.DefaultRepository,
+			ReviewerCount: &count,
+			Strategy:      models.StrategyLeastLoaded,
+		}, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{author, {UserID: "busy"}, {UserID: "idle"}}, nil)
+		mockPRRepo.EXPECT().GetOpenReviewCounts(ctx, []string{"busy", "idle"}).Return(map[string]int{"busy": 4}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DefaultRepository, created.Repository)
+		assert.Equal(t, []string{"idle"}, created.AssignedReviewers)
+	})
+
+	t.Run("rule adds reviewer for label", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Repository: "auth", Labels: []string{"Security"}}
+
+		expectAuthor("auth")
+		mockRepoRepo.EXPECT().GetRepo(ctx, "auth").Return(&models.Repo{
+			Name:  "auth",
+			Rules: []models.AssignmentRule{{Label: "security", ExtraReviewers: 1}},
+		}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{author, {UserID: "r1"}, {UserID: "r2"}, {UserID: "r3"}}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"security"}, created.Labels)
+		assert.ElementsMatch(t, []string{"r1", "r2", "r3"}, created.AssignedReviewers)
+	})
+
+	t.Run("rule requires senior reviewer for large PR", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Repository: "core", LinesAdded: 450, LinesRemoved: 100}
+		rules := []models.AssignmentRule{{MinChangedLines: 500, MinLevel: models.LevelSenior}}
+
+		expectAuthor("core")
+		mockRepoRepo.EXPECT().GetRepo(ctx, "core").Return(&models.Repo{Name: "core", Rules: rules}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			author,
+			{UserID: "m1", Level: models.LevelMid},
+			{UserID: "s1", Level: models.LevelSenior},
+		}, nil).Times(2)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s1", "m1"}, created.AssignedReviewers)
+	})
+
+	t.Run("unsatisfiable rule level is reported", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Repository: "core", LinesAdded: 500}
+		rules := []models.AssignmentRule{{MinChangedLines: 500, MinLevel: models.LevelSenior}}
+
+		expectAuthor("core")
+		mockRepoRepo.EXPECT().GetRepo(ctx, "core").Return(&models.Repo{Name: "core", Rules: rules}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{author, {UserID: "m1", Level: models.LevelMid}}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "team").Return(&models.Team{TeamName: "team"}, nil)
+
+		_, err := prSvc.CreatePullRequest(ctx, pr)
+
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		assert.Contains(t, err.Error(), "repository rule 1 requires a senior or lead reviewer")
+	})
+
+	t.Run("zero reviewers", func(t *testing.T) {
+		count := 0
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Repository: "docs"}
+
+		expectAuthor("docs")
+		mockRepoRepo.EXPECT().GetRepo(ctx, "docs").Return(&models.Repo{Name: "docs", ReviewerCount: &count}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Empty(t, created.AssignedReviewers)
+	})
+
+	t.Run("unknown repository", func(t *testing.T) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, "missing", "pr1").Return(false, nil)
+		mockRepoRepo.EXPECT().GetRepo(ctx, "missing").Return(nil, nil)
+
+		_, err := prSvc.CreatePullRequest(ctx, &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Repository: "missing"})
+
+		assert.ErrorIs(t, err, ErrRepoNotFound)
+	})
+}
+
+func TestPullRequestServiceImpl_MergePullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+
+	t.Run("returns merged PR on repeated calls", func(t *testing.T) {
+		mergedAt := time.Now()
+		merged := &models.PullRequest{PullRequestID: "pr1", Status: models.PRStatusMerged, MergedAt: &mergedAt}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(true, nil).Times(2)
+		mockPRRepo.EXPECT().MergePullRequest(ctx, models.DefaultRepository, "pr1").Return(true, nil)
+		mockPRRepo.EXPECT().MergePullRequest(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(merged, nil).Times(2)
+
+		first, err := prSvc.MergePullRequest(ctx, "", "pr1")
+		require.NoError(t, err)
+		second, err := prSvc.MergePullRequest(ctx, "", "pr1")
+		require.NoError(t, err)
+
+		assert.Equal(t, models.PRStatusMerged, first.Status)
+		assert.Equal(t, first.MergedAt, second.MergedAt)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "missing").Return(false, nil)
+
+		pr, err := prSvc.MergePullRequest(ctx, "", "missing")
+
+		assert.ErrorIs(t, err, ErrPRNotFound)
+		assert.Nil(t, pr)
+	})
+}
+
+func TestPullRequestServiceImpl_MarkReadyForReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockTeamRepo.EXPECT().GetTeamPolicies(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockUserRepo.EXPECT().GetBlockedReviewers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+	author := &models.User{UserID: "author", TeamName: "team", IsActive: true}
+
+	t.Run("draft is created without reviewers", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", PullRequestName: "PR 1", AuthorID: "author", Status: models.PRStatusOpen, Draft: true}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Empty(t, created.AssignedReviewers)
+	})
+
+	t.Run("draft with unknown author", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr4", PullRequestName: "PR 4", AuthorID: "ghost", Status: models.PRStatusOpen, Draft: true}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr4").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "ghost").Return(false, nil)
+
+		_, err := prSvc.CreatePullRequest(ctx, pr)
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("draft with unknown excluded reviewer", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr5", PullRequestName: "PR 5", AuthorID: "author", Status: models.PRStatusOpen, Draft: true, ExcludedReviewers: []string{"ghost"}}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr5").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "ghost").Return(false, nil)
+
+		_, err := prSvc.CreatePullRequest(ctx, pr)
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("ready draft gets reviewers", func(t *testing.T) {
+		draft := &models.PullRequest{Repository: models.DefaultRepository, PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, Draft: true}
+		ready := &models.PullRequest{Repository: models.DefaultRepository, PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, AssignedReviewers: []string{"reviewer"}}
+
+		gomock.InOrder(
+			mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(draft, nil),
+			mockPRRepo.EXPECT().SetPullRequestReady(ctx, models.DefaultRepository, "pr1").Return(true, nil),
+			mockPRRepo.EXPECT().SetAssignedReviewers(ctx, models.DefaultRepository, "pr1", []string{"reviewer"}).Return(nil),
+			mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(ready, nil),
+		)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			author,
+			{UserID: "reviewer", TeamName: "team", IsActive: true},
+		}, nil)
+
+		pr, err := prSvc.MarkReadyForReview(ctx, "", "pr1")
+
+		require.NoError(t, err)
+		assert.Equal(t, ready, pr)
+	})
+
+	t.Run("non-draft is returned as is", func(t *testing.T) {
+		open := &models.PullRequest{PullRequestID: "pr2", Status: models.PRStatusOpen, AssignedReviewers: []string{"reviewer"}}
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr2").Return(open, nil)
+
+		pr, err := prSvc.MarkReadyForReview(ctx, "", "pr2")
+
+		require.NoError(t, err)
+		assert.Equal(t, open, pr)
+	})
+
+	t.Run("merged", func(t *testing.T) {
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr3").
+			Return(&models.PullRequest{PullRequestID: "pr3", Status: models.PRStatusMerged, Draft: true}, nil)
+
+		_, err := prSvc.MarkReadyForReview(ctx, "", "pr3")
+
+		assert.ErrorIs(t, err, ErrPRMerged)
+	})
+}
+
+func TestPullRequestServiceImpl_ReassignReviewer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockTeamRepo.EXPECT().GetTeamPolicies(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockUserRepo.EXPECT().GetBlockedReviewers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, AssignedReviewers: []string{"r1", "r2"}}
+
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(pr, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(&models.User{UserID: "author", TeamName: "team"}, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "r1").Return(&models.User{UserID: "r1", TeamName: "team"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			{UserID: "author"}, {UserID: "r1"}, {UserID: "r2"}, {UserID: "r3"},
+		}, nil)
+		mockPRRepo.EXPECT().SetAssignedReviewers(ctx, models.DefaultRepository, "pr1", []string{"r3", "r2"}).Return(nil)
+
+		updated, replacedBy, err := prSvc.ReassignReviewer(ctx, "", "pr1", "r1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "r3", replacedBy)
+		assert.Equal(t, []string{"r3", "r2"}, updated.AssignedReviewers)
+	})
+
+	t.Run("merged PR", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", Status: models.PRStatusMerged, AssignedReviewers: []string{"r1"}}
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(pr, nil)
+
+		_, _, err := prSvc.ReassignReviewer(ctx, "", "pr1", "r1")
+
+		assert.ErrorIs(t, err, ErrPRMerged)
+	})
+
+	t.Run("reviewer not assigned", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", Status: models.PRStatusOpen, AssignedReviewers: []string{"r1"}}
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(pr, nil)
+
+		_, _, err := prSvc.ReassignReviewer(ctx, "", "pr1", "r9")
+
+		assert.ErrorIs(t, err, ErrNotAssigned)
+	})
+
+	t.Run("escalates to parent team", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, AssignedReviewers: []string{"r1"}}
+
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(pr, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(&models.User{UserID: "author", TeamName: "team"}, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "r1").Return(&models.User{UserID: "r1", TeamName: "squad"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "squad").Return([]*models.User{{UserID: "author"}, {UserID: "r1"}}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "squad").Return(&models.Team{TeamName: "squad", ParentTeam: "department"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "department").Return([]*models.User{{UserID: "lead"}}, nil)
+		mockPRRepo.EXPECT().SetAssignedReviewers(ctx, models.DefaultRepository, "pr1", []string{"lead"}).Return(nil)
+
+		_, replacedBy, err := prSvc.ReassignReviewer(ctx, "", "pr1", "r1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "lead", replacedBy)
+	})
+
+	t.Run("no candidate up to the root", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, AssignedReviewers: []string{"r1"}}
+
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(pr, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(&models.User{UserID: "author", TeamName: "team"}, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "r1").Return(&models.User{UserID: "r1", TeamName: "squad"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "squad").Return([]*models.User{{UserID: "r1"}}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "squad").Return(&models.Team{TeamName: "squad", ParentTeam: "department"}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "department").Return(nil, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "department").Return(&models.Team{TeamName: "department"}, nil)
+
+		_, _, err := prSvc.ReassignReviewer(ctx, "", "pr1", "r1")
+
+		assert.ErrorIs(t, err, ErrNoCandidate)
+	})
+}
+
+func TestPullRequestServiceImpl_ListPullRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+	now := time.Now()
+	prs := []*models.PullRequest{
+		{PullRequestID: "pr3", CreatedAt: &now},
+		{PullRequestID: "pr2", CreatedAt: &now},
+		{PullRequestID: "pr1", CreatedAt: &now},
+	}
+
+	t.Run("applies defaults and returns next cursor", func(t *testing.T) {
+		mockPRRepo.EXPECT().ListPullRequests(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter models.PullRequestFilter) ([]*models.PullRequest, error) {
+				assert.Equal(t, models.PRSortByCreatedAt, filter.SortBy)
+				assert.Equal(t, models.SortOrderDesc, filter.SortOrder)
+				assert.Equal(t, 3, filter.Limit)
+				assert.Nil(t, filter.Cursor)
+				return prs, nil
+			})
+
+		page, err := prSvc.ListPullRequests(ctx, models.PullRequestFilter{Limit: 2}, "")
+
+		require.NoError(t, err)
+		assert.Len(t, page.PullRequests, 2)
+		assert.NotEmpty(t, page.NextCursor)
+
+		cursor, err := decodeCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "pr2", cursor.ID)
+		assert.True(t, now.Equal(cursor.CreatedAt))
+	})
+
+	t.Run("last page has no cursor", func(t *testing.T) {
+		mockPRRepo.EXPECT().ListPullRequests(ctx, gomock.Any()).Return(prs[:1], nil)
+
+		page, err := prSvc.ListPullRequests(ctx, models.PullRequestFilter{Limit: 2}, "")
+
+		require.NoError(t, err)
+		assert.Len(t, page.PullRequests, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("passes decoded cursor to repository", func(t *testing.T) {
+		cursor := encodeCursor(prs[1].Repository, prs[1].PullRequestID, prs[1].CreatedAt)
+		mockPRRepo.EXPECT().ListPullRequests(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter models.PullRequestFilter) ([]*models.PullRequest, error) {
+				require.NotNil(t, filter.Cursor)
+				assert.Equal(t, "pr2", filter.Cursor.ID)
+				return nil, nil
+			})
+
+		page, err := prSvc.ListPullRequests(ctx, models.PullRequestFilter{}, cursor)
+
+		require.NoError(t, err)
+		assert.Empty(t, page.PullRequests)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		filters := []models.PullRequestFilter{
+			{Status: "CLOSED"},
+			{SortBy: "author"},
+			{SortOrder: "sideways"},
+			{Limit: maxPageSize + 1},
+		}
+		for _, filter := range filters {
+			_, err := prSvc.ListPullRequests(ctx, filter, "")
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		}
+
+		_, err := prSvc.ListPullRequests(ctx, models.PullRequestFilter{}, "not-a-cursor!")
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
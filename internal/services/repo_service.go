@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+// maxReviewerCount ограничивает число ревьюверов PR, задаваемое в настройках репозитория.
+const maxReviewerCount = 10
+
+type RepoServiceImpl struct {
+	repoRepo repository.RepoRepository
+	teamRepo repository.TeamRepository
+}
+
+func NewRepoService(repoRepo repository.RepoRepository, teamRepo repository.TeamRepository) *RepoServiceImpl {
+	return &RepoServiceImpl{
+		repoRepo: repoRepo,
+		teamRepo: teamRepo,
+	}
+}
+
+func (s *RepoServiceImpl) CreateRepo(ctx context.Context, repo *models.Repo) (*models.Repo, error) {
+	err := s.normalizeRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repoRepo.CreateRepo(ctx, repo)
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return nil, ErrRepoExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository: %w", err)
+	}
+
+	return repo, nil
+}
+
+func (s *RepoServiceImpl) GetRepo(ctx context.Context, name string) (*models.Repo, error) {
+	repo, err := s.repoRepo.GetRepo(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+	if repo == nil {
+		return nil, ErrRepoNotFound
+	}
+
+	return repo, nil
+}
+
+// UpdateRepo заменяет настройки репозитория целиком: незаданные поля сбрасываются
+// к значениям по умолчанию.
+func (s *RepoServiceImpl) UpdateRepo(ctx context.Context, repo *models.Repo) (*models.Repo, error) {
+	err := s.normalizeRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repoRepo.RepoExists(ctx, repo.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check repository existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrRepoNotFound
+	}
+
+	err = s.repoRepo.UpdateRepo(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update repository: %w", err)
+	}
+
+	return repo, nil
+}
+
+// normalizeRepo проверяет настройки репозитория и приводит список допустимых команд
+// к отсортированному набору без повторов.
+func (s *RepoServiceImpl) normalizeRepo(ctx context.Context, repo *models.Repo) error {
+	repo.Name = strings.TrimSpace(repo.Name)
+	if repo.Name == "" {
+		return fmt.Errorf("%w: repository is required", ErrInvalidArgument)
+	}
+
+	switch repo.Strategy {
+	case "", models.StrategyRandom, models.StrategyLeastLoaded:
+	default:
+		return fmt.Errorf("%w: strategy must be %s or %s", ErrInvalidArgument, models.StrategyRandom, models.StrategyLeastLoaded)
+	}
+
+	if repo.ReviewerCount != nil && (*repo.ReviewerCount < 0 || *repo.ReviewerCount > maxReviewerCount) {
+		return fmt.Errorf("%w: reviewer_count must be between 0 and %d", ErrInvalidArgument, maxReviewerCount)
+	}
+
+	if repo.OwnerTeam != "" {
+		err := s.ensureTeamExists(ctx, repo.OwnerTeam)
+		if err != nil {
+			return err
+		}
+	}
+
+	set := make(map[string]bool, len(repo.EligibleTeams))
+	for _, team := range repo.EligibleTeams {
+		if set[team] {
+			continue
+		}
+		err := s.ensureTeamExists(ctx, team)
+		if err != nil {
+			return err
+		}
+		set[team] = true
+	}
+
+	teams := make([]string, 0, len(set))
+	for team := range set {
+		teams = append(teams, team)
+	}
+	sort.Strings(teams)
+	repo.EligibleTeams = teams
+
+	return normalizeAssignmentRules(repo)
+}
+
+// normalizeAssignmentRules проверяет правила репозитория: у правила должно быть хотя бы
+// одно условие, до maxReviewerCount дополнительных ревьюверов и хотя бы один
+// дополнительный ревьювер или min_level.
+func normalizeAssignmentRules(repo *models.Repo) error {
+	if repo.Rules == nil {
+		repo.Rules = []models.AssignmentRule{}
+	}
+
+	for i := range repo.Rules {
+		rule := &repo.Rules[i]
+
+		rule.Label = strings.ToLower(strings.TrimSpace(rule.Label))
+		if len(rule.Label) > maxTagLength {
+			return fmt.Errorf("%w: rule %d: label must be at most %d characters", ErrInvalidArgument, i+1, maxTagLength)
+		}
+		if rule.Priority != "" {
+			if _, err := normalizePriority(rule.Priority); err != nil {
+				return fmt.Errorf("rule %d: %w", i+1, err)
+			}
+		}
+		if rule.MinChangedLines < 0 {
+			return fmt.Errorf("%w: rule %d: min_changed_lines must not be negative", ErrInvalidArgument, i+1)
+		}
+		if rule.Label == "" && rule.Priority == "" && rule.MinChangedLines == 0 {
+			return fmt.Errorf("%w: rule %d: label, priority or min_changed_lines is required", ErrInvalidArgument, i+1)
+		}
+		minLevel, err := normalizeLevel(rule.MinLevel)
+		if err != nil {
+			return fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		rule.MinLevel = minLevel
+		if rule.ExtraReviewers < 0 || rule.ExtraReviewers > maxReviewerCount {
+			return fmt.Errorf("%w: rule %d: extra_reviewers must be between 0 and %d", ErrInvalidArgument, i+1, maxReviewerCount)
+		}
+		if rule.ExtraReviewers == 0 && rule.MinLevel == "" {
+			return fmt.Errorf("%w: rule %d: extra_reviewers or min_level is required", ErrInvalidArgument, i+1)
+		}
+	}
+
+	return nil
+}
+
+func (s *RepoServiceImpl) ensureTeamExists(ctx context.Context, teamName string) error {
+	exists, err := s.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrTeamNotFound, teamName)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+func TestRepoServiceImpl_CreateRepo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepoRepo := mocks.NewMockRepoRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	svc := NewRepoService(mockRepoRepo, mockTeamRepo)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		count := 3
+		repo := &models.Repo{
+			Name:          " backend ",
+			OwnerTeam:     "platform",
+			ReviewerCount: &count,
+			Strategy:      models.StrategyLeastLoaded,
+			EligibleTeams: []string{"infra", "platform", "infra"},
+		}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "platform").Return(true, nil).Times(2)
+		mockTeamRepo.EXPECT().TeamExists(ctx, "infra").Return(true, nil)
+		mockRepoRepo.EXPECT().CreateRepo(ctx, repo).Return(nil)
+
+		created, err := svc.CreateRepo(ctx, repo)
+
+		require.NoError(t, err)
+		assert.Equal(t, "backend", created.Name)
+		assert.Equal(t, []string{"infra", "platform"}, created.EligibleTeams)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		mockRepoRepo.EXPECT().CreateRepo(ctx, gomock.Any()).Return(repository.ErrDuplicateKey)
+
+		_, err := svc.CreateRepo(ctx, &models.Repo{Name: "backend"})
+
+		assert.ErrorIs(t, err, ErrRepoExists)
+	})
+
+	t.Run("unknown eligible team", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "ghost").Return(false, nil)
+
+		_, err := svc.CreateRepo(ctx, &models.Repo{Name: "backend", EligibleTeams: []string{"ghost"}})
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		negative, tooMany := -1, maxReviewerCount+1
+		repos := []*models.Repo{
+			{Name: "  "},
+			{Name: "backend", Strategy: "round_robin"},
+			{Name: "backend", ReviewerCount: &negative},
+			{Name: "backend", ReviewerCount: &tooMany},
+			{Name: "backend", Rules: []models.AssignmentRule{{ExtraReviewers: 1}}},
+			{Name: "backend", Rules: []models.AssignmentRule{{Label: "security"}}},
+			{Name: "backend", Rules: []models.AssignmentRule{{Priority: "urgent", ExtraReviewers: 1}}},
+			{Name: "backend", Rules: []models.AssignmentRule{{MinChangedLines: 500, MinLevel: "principal"}}},
+		}
+		for _, repo := range repos {
+			_, err := svc.CreateRepo(ctx, repo)
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		}
+	})
+}
+
+func TestRepoServiceImpl_UpdateRepo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepoRepo := mocks.NewMockRepoRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	svc := NewRepoService(mockRepoRepo, mockTeamRepo)
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		repo := &models.Repo{Name: "backend", Strategy: models.StrategyRandom}
+
+		mockRepoRepo.EXPECT().RepoExists(ctx, "backend").Return(true, nil)
+		mockRepoRepo.EXPECT().UpdateRepo(ctx, repo).Return(nil)
+
+		updated, err := svc.UpdateRepo(ctx, repo)
+
+		require.NoError(t, err)
+		assert.Empty(t, updated.EligibleTeams)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepoRepo.EXPECT().RepoExists(ctx, "missing").Return(false, nil)
+
+		_, err := svc.UpdateRepo(ctx, &models.Repo{Name: "missing"})
+
+		assert.ErrorIs(t, err, ErrRepoNotFound)
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		mockRepoRepo.EXPECT().GetRepo(ctx, "missing").Return(nil, nil)
+
+		_, err := svc.GetRepo(ctx, "missing")
+
+		assert.ErrorIs(t, err, ErrRepoNotFound)
+	})
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/notify"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+// digestPageSize - сколько PR ревьювера читается за один запрос при сборке сводки.
+const digestPageSize = 100
+
+// ReviewDigestServiceImpl собирает сводки ревью: ревьюверу - его ожидающие ревью, руководителю
+// команды (участнику с ролью lead) - ещё и нагрузку команды и её просроченные ревью.
+type ReviewDigestServiceImpl struct {
+	prRepo          repository.PullRequestRepository
+	teamRepo        repository.TeamRepository
+	userRepo        repository.UserRepository
+	statSvc         StatisticService
+	slaSvc          ReviewSLAService
+	notificationSvc NotificationService
+	hours           BusinessHours
+}
+
+func NewReviewDigestService(
+	prRepo repository.PullRequestRepository,
+	teamRepo repository.TeamRepository,
+	userRepo repository.UserRepository,
+	statSvc StatisticService,
+	slaSvc ReviewSLAService,
+	notificationSvc NotificationService,
+	hours BusinessHours,
+) *ReviewDigestServiceImpl {
+	return &ReviewDigestServiceImpl{
+		prRepo:          prRepo,
+		teamRepo:        teamRepo,
+		userRepo:        userRepo,
+		statSvc:         statSvc,
+		slaSvc:          slaSvc,
+		notificationSvc: notificationSvc,
+		hours:           hours,
+	}
+}
+
+// GetDigest собирает сводку ревью пользователя на текущий момент.
+func (s *ReviewDigestServiceImpl) GetDigest(ctx context.Context, userID string) (*models.ReviewDigest, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	leads, err := s.teamRepo.GetTeamsByMemberRole(ctx, models.TeamRoleLead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team leads: %w", err)
+	}
+
+	return s.buildDigest(ctx, user, leads[userID], make(map[string]*models.TeamDigest), time.Now())
+}
+
+// SendDigests отправляет сводки активным ревьюверам с открытыми ревью и руководителям команд
+// в их канал уведомлений. Пустые сводки не отправляются; ошибка доставки одному пользователю
+// не мешает остальным.
+func (s *ReviewDigestServiceImpl) SendDigests(ctx context.Context) error {
+	assignments, err := s.prRepo.GetReviewAssignments(ctx, models.ReviewAssignmentFilter{OpenOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to get review assignments: %w", err)
+	}
+	leads, err := s.teamRepo.GetTeamsByMemberRole(ctx, models.TeamRoleLead)
+	if err != nil {
+		return fmt.Errorf("failed to get team leads: %w", err)
+	}
+
+	recipients := make(map[string]bool, len(leads))
+	for _, assignment := range assignments {
+		recipients[assignment.ReviewerID] = true
+	}
+	for userID := range leads {
+		recipients[userID] = true
+	}
+	userIDs := make([]string, 0, len(recipients))
+	for userID := range recipients {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+
+	now := time.Now()
+	teams := make(map[string]*models.TeamDigest)
+	var errs []error
+	for _, userID := range userIDs {
+		if err := s.sendDigest(ctx, userID, leads[userID], teams, now); err != nil {
+			log.Printf("Failed to send review digest to %s: %v", userID, err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *ReviewDigestServiceImpl) sendDigest(ctx context.Context, userID string, leadTeams []string, teams map[string]*models.TeamDigest, now time.Time) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		return nil
+	}
+
+	digest, err := s.buildDigest(ctx, user, leadTeams, teams, now)
+	if err != nil {
+		return err
+	}
+	if digest.Empty() {
+		return nil
+	}
+
+	subject, text, html, err := notify.RenderReviewDigest(digest)
+	if err != nil {
+		return err
+	}
+	return s.notificationSvc.SendMessage(ctx, userID, subject, text, html)
+}
+
+// buildDigest собирает сводку пользователя. Разделы команд кешируются в teams: у команды
+// может быть несколько руководителей.
+func (s *ReviewDigestServiceImpl) buildDigest(ctx context.Context, user *models.User, leadTeams []string, teams map[string]*models.TeamDigest, now time.Time) (*models.ReviewDigest, error) {
+	pending, err := s.pendingReviews(ctx, user.UserID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := &models.ReviewDigest{
+		UserID:         user.UserID,
+		Username:       user.Username,
+		GeneratedAt:    now,
+		PendingReviews: pending,
+		Teams:          []models.TeamDigest{},
+	}
+	for _, teamName := range leadTeams {
+		team, ok := teams[teamName]
+		if !ok {
+			team, err = s.teamDigest(ctx, teamName)
+			if err != nil {
+				return nil, err
+			}
+			teams[teamName] = team
+		}
+		digest.Teams = append(digest.Teams, *team)
+	}
+
+	return digest, nil
+}
+
+// pendingReviews возвращает открытые PR, на которых ревьювер ещё не вынес вердикт, от самых давних назначений.
+func (s *ReviewDigestServiceImpl) pendingReviews(ctx context.Context, userID string, now time.Time) ([]models.PendingReview, error) {
+	pending := []models.PendingReview{}
+	filter := models.ReviewFilter{Status: models.PRStatusOpen, Limit: digestPageSize}
+	for {
+		prs, err := s.prRepo.GetPullRequestsByReviewer(ctx, userID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull requests for user: %w", err)
+		}
+
+		for _, pr := range prs {
+			if pr.Verdict != "" || pr.AssignedAt == nil {
+				continue
+			}
+			sla := s.hours.reviewShortSLAState(pr, now)
+			pending = append(pending, models.PendingReview{
+				Repository:      pr.Repository,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				AssignedAt:      *pr.AssignedAt,
+				WaitingHours:    int(now.Sub(*pr.AssignedAt).Hours()),
+				Overdue:         sla != nil && sla.Overdue,
+			})
+		}
+
+		if len(prs) < digestPageSize || prs[len(prs)-1].CreatedAt == nil {
+			break
+		}
+		last := prs[len(prs)-1]
+		filter.Cursor = &models.PullRequestCursor{CreatedAt: *last.CreatedAt, ID: last.PullRequestID, Repository: last.Repository}
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].AssignedAt.Before(pending[j].AssignedAt)
+	})
+	return pending, nil
+}
+
+func (s *ReviewDigestServiceImpl) teamDigest(ctx context.Context, teamName string) (*models.TeamDigest, error) {
+	load, err := s.statSvc.GetTeamLoad(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get load of team %s: %w", teamName, err)
+	}
+	overdue, err := s.slaSvc.GetOverdueByTeam(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue reviews of team %s: %w", teamName, err)
+	}
+
+	return &models.TeamDigest{TeamName: teamName, Members: load.Members, OverdueReviews: overdue.Reviews}, nil
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/notify"
+)
+
+type reviewDigestTestEnv struct {
+	prRepo    *mocks.MockPullRequestRepository
+	teamRepo  *mocks.MockTeamRepository
+	userRepo  *mocks.MockUserRepository
+	notifRepo *mocks.MockNotificationRepository
+	channel   *recordingNotifier
+	svc       *ReviewDigestServiceImpl
+}
+
+func newReviewDigestTestEnv(t *testing.T) *reviewDigestTestEnv {
+	ctrl := gomock.NewController(t)
+	env := &reviewDigestTestEnv{
+		prRepo:    mocks.NewMockPullRequestRepository(ctrl),
+		teamRepo:  mocks.NewMockTeamRepository(ctrl),
+		userRepo:  mocks.NewMockUserRepository(ctrl),
+		notifRepo: mocks.NewMockNotificationRepository(ctrl),
+		channel:   &recordingNotifier{},
+	}
+
+	hours := DefaultBusinessHours()
+	statSvc := NewStatisticService(env.prRepo, env.teamRepo, env.userRepo)
+	slaSvc := NewReviewSLAService(env.prRepo, env.teamRepo, env.userRepo, hours)
+	notificationSvc := NewNotificationService(nil, env.notifRepo, env.prRepo, env.userRepo,
+		map[string]notify.Notifier{notify.ChannelLog: env.channel}, time.Hour)
+	env.svc = NewReviewDigestService(env.prRepo, env.teamRepo, env.userRepo, statSvc, slaSvc, notificationSvc, hours)
+	return env
+}
+
+// expectTeam настраивает нагрузку и просроченные ревью команды backend.
+func (env *reviewDigestTestEnv) expectTeam(ctx context.Context, overdue *models.ReviewAssignment) {
+	env.teamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(&models.Team{
+		TeamName: "backend",
+		Members:  []models.TeamMember{{UserID: "u2", Username: "bob", IsActive: true}},
+	}, nil)
+	env.prRepo.EXPECT().GetOpenReviewCounts(ctx, []string{"u2"}).Return(map[string]int{"u2": 2}, nil)
+	env.teamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+	env.prRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{TeamName: "backend", OpenOnly: true}).
+		Return([]*models.ReviewAssignment{overdue}, nil)
+}
+
+func TestReviewDigestServiceImpl_GetDigest(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	oneHour := 1
+	tenDaysAgo, twoHoursAgo := now.Add(-240*time.Hour), now.Add(-2*time.Hour)
+	openFilter := models.ReviewFilter{Status: models.PRStatusOpen, Limit: digestPageSize}
+
+	t.Run("pending reviews oldest first and led teams", func(t *testing.T) {
+		env := newReviewDigestTestEnv(t)
+		env.userRepo.EXPECT().GetUserByID(ctx, "u2").Return(&models.User{UserID: "u2", Username: "bob", IsActive: true}, nil)
+		env.teamRepo.EXPECT().GetTeamsByMemberRole(ctx, models.TeamRoleLead).Return(map[string][]string{"u2": {"backend"}}, nil)
+		env.prRepo.EXPECT().GetPullRequestsByReviewer(ctx, "u2", openFilter).Return([]*models.PullRequestShort{
+			{Repository: "default", PullRequestID: "pr-new", PullRequestName: "New", AuthorID: "u1", Status: models.PRStatusOpen, AssignedAt: &twoHoursAgo},
+			{Repository: "default", PullRequestID: "pr-voted", AuthorID: "u1", Status: models.PRStatusOpen, AssignedAt: &tenDaysAgo, Verdict: models.ReviewVerdictApproved},
+			{Repository: "default", PullRequestID: "pr-old", PullRequestName: "Old", AuthorID: "u1", Status: models.PRStatusOpen, AssignedAt: &tenDaysAgo,
+				TeamSLA: models.ReviewSLA{FirstResponseHours: &oneHour}},
+		}, nil)
+		env.expectTeam(ctx, &models.ReviewAssignment{
+			Repository: "default", PullRequestID: "pr-old", AuthorID: "u1", ReviewerID: "u3", Status: models.PRStatusOpen,
+			AssignedAt: tenDaysAgo, TeamSLA: models.ReviewSLA{FirstResponseHours: &oneHour},
+		})
+
+		digest, err := env.svc.GetDigest(ctx, "u2")
+		require.NoError(t, err)
+
+		assert.Equal(t, "bob", digest.Username)
+		require.Len(t, digest.PendingReviews, 2)
+		assert.Equal(t, "pr-old", digest.PendingReviews[0].PullRequestID)
+		assert.Equal(t, 240, digest.PendingReviews[0].WaitingHours)
+		assert.True(t, digest.PendingReviews[0].Overdue)
+		assert.Equal(t, "pr-new", digest.PendingReviews[1].PullRequestID)
+		assert.False(t, digest.PendingReviews[1].Overdue)
+
+		require.Len(t, digest.Teams, 1)
+		assert.Equal(t, []models.MemberLoad{{UserID: "u2", Username: "bob", IsActive: true, OpenReviews: 2}}, digest.Teams[0].Members)
+		require.Len(t, digest.Teams[0].OverdueReviews, 1)
+		assert.Equal(t, "u3", digest.Teams[0].OverdueReviews[0].ReviewerID)
+	})
+
+	t.Run("reads all pages", func(t *testing.T) {
+		env := newReviewDigestTestEnv(t)
+		env.userRepo.EXPECT().GetUserByID(ctx, "u2").Return(&models.User{UserID: "u2", Username: "bob", IsActive: true}, nil)
+		env.teamRepo.EXPECT().GetTeamsByMemberRole(ctx, models.TeamRoleLead).Return(map[string][]string{}, nil)
+
+		page := make([]*models.PullRequestShort, digestPageSize)
+		for i := range page {
+			createdAt := now.Add(-time.Duration(i) * time.Minute)
+			page[i] = &models.PullRequestShort{Repository: "default", PullRequestID: fmt.Sprintf("pr-%d", i), CreatedAt: &createdAt, AssignedAt: &createdAt}
+		}
+		last := page[digestPageSize-1]
+		nextFilter := openFilter
+		nextFilter.Cursor = &models.PullRequestCursor{CreatedAt: *last.CreatedAt, ID: last.PullRequestID, Repository: "default"}
+		gomock.InOrder(
+			env.prRepo.EXPECT().GetPullRequestsByReviewer(ctx, "u2", openFilter).Return(page, nil),
+			env.prRepo.EXPECT().GetPullRequestsByReviewer(ctx, "u2", nextFilter).Return([]*models.PullRequestShort{
+				{Repository: "default", PullRequestID: "pr-oldest", AssignedAt: &tenDaysAgo},
+			}, nil),
+		)
+
+		digest, err := env.svc.GetDigest(ctx, "u2")
+		require.NoError(t, err)
+		require.Len(t, digest.PendingReviews, digestPageSize+1)
+		assert.Equal(t, "pr-oldest", digest.PendingReviews[0].PullRequestID)
+		assert.Empty(t, digest.Teams)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		env := newReviewDigestTestEnv(t)
+		env.userRepo.EXPECT().GetUserByID(ctx, "ghost").Return(nil, nil)
+
+		_, err := env.svc.GetDigest(ctx, "ghost")
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestReviewDigestServiceImpl_SendDigests(t *testing.T) {
+	ctx := context.Background()
+	env := newReviewDigestTestEnv(t)
+	assignedAt := time.Now().Add(-5 * time.Hour)
+	openFilter := models.ReviewFilter{Status: models.PRStatusOpen, Limit: digestPageSize}
+
+	env.prRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{OpenOnly: true}).Return([]*models.ReviewAssignment{
+		{PullRequestID: "pr-1", ReviewerID: "u2"},
+		{PullRequestID: "pr-1", ReviewerID: "u3"},
+		{PullRequestID: "pr-2", ReviewerID: "u4"},
+	}, nil)
+	env.teamRepo.EXPECT().GetTeamsByMemberRole(ctx, models.TeamRoleLead).Return(map[string][]string{"u5": {"backend"}}, nil)
+
+	users := map[string]*models.User{
+		"u2": {UserID: "u2", Username: "bob", IsActive: true},
+		"u3": {UserID: "u3", Username: "eve", IsActive: false},
+		"u4": {UserID: "u4", Username: "dan", IsActive: true},
+		"u5": {UserID: "u5", Username: "lea", IsActive: true},
+	}
+	for userID, user := range users {
+		env.userRepo.EXPECT().GetUserByID(ctx, userID).Return(user, nil).AnyTimes()
+	}
+	env.notifRepo.EXPECT().GetNotificationPreferences(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+
+	env.prRepo.EXPECT().GetPullRequestsByReviewer(ctx, "u2", openFilter).Return([]*models.PullRequestShort{
+		{Repository: "default", PullRequestID: "pr-1", PullRequestName: "Search", AuthorID: "u1", AssignedAt: &assignedAt},
+	}, nil)
+	// У u4 ревью с вердиктом - сводка пустая и не отправляется.
+	env.prRepo.EXPECT().GetPullRequestsByReviewer(ctx, "u4", openFilter).Return([]*models.PullRequestShort{
+		{Repository: "default", PullRequestID: "pr-2", AuthorID: "u1", AssignedAt: &assignedAt, Verdict: models.ReviewVerdictCommented},
+	}, nil)
+	env.prRepo.EXPECT().GetPullRequestsByReviewer(ctx, "u5", openFilter).Return(nil, nil)
+	env.expectTeam(ctx, &models.ReviewAssignment{PullRequestID: "pr-1", ReviewerID: "u2", AssignedAt: assignedAt})
+
+	require.NoError(t, env.svc.SendDigests(ctx))
+
+	require.Len(t, env.channel.sent, 2)
+	assert.Equal(t, "u2", env.channel.sent[0].UserID)
+	assert.Equal(t, "Review digest: 1 pending review(s)", env.channel.sent[0].Subject)
+	assert.Contains(t, env.channel.sent[0].Body, "default/pr-1 \"Search\" by u1, waiting 5 h")
+	assert.Contains(t, env.channel.sent[0].HTML, "<h2>Pending reviews</h2>")
+	assert.Equal(t, "u5", env.channel.sent[1].UserID)
+	assert.Equal(t, "Review digest: 0 pending review(s), 0 overdue in your teams", env.channel.sent[1].Subject)
+	assert.Contains(t, env.channel.sent[1].Body, "Team backend")
+}
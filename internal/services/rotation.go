@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+const (
+	// defaultRotationHistorySize - сколько последних PR автора учитывается при ротации ревьюверов.
+	defaultRotationHistorySize = 5
+	// defaultRotationWindow - окно, за которое вклад прошлого ревью в штраф затухает до нуля.
+	defaultRotationWindow = 30 * 24 * time.Hour
+
+	maxRotationPenalty = 100
+)
+
+// rotationWeights - множители веса кандидатов в ревьюверы с учётом штрафа за повтор
+// недавних пар автор → ревьювер. Кандидаты без недавних ревью в карту не попадают.
+type rotationWeights map[string]float64
+
+// of возвращает множитель веса кандидата: 1 - без штрафа, 0 - кандидат выбирается,
+// только если у всех остальных множитель тоже нулевой.
+func (w rotationWeights) of(userID string) float64 {
+	if factor, ok := w[userID]; ok {
+		return factor
+	}
+	return 1
+}
+
+// SetRotation задаёт, сколько последних PR автора учитывать при ротации ревьюверов
+// и за какое время затухает штраф за повтор пары. Нулевые значения отключают ротацию.
+func (s *PullRequestServiceImpl) SetRotation(historySize int, window time.Duration) {
+	s.rotationHistorySize = historySize
+	s.rotationWindow = window
+}
+
+// normalizeRotationPenalty проверяет силу штрафа за повтор пары: процент от 0 до 100.
+func normalizeRotationPenalty(penalty int) (int, error) {
+	if penalty < 0 || penalty > maxRotationPenalty {
+		return 0, fmt.Errorf("%w: rotation_penalty must be between 0 and %d", ErrInvalidArgument, maxRotationPenalty)
+	}
+	return penalty, nil
+}
+
+// rotationWeights штрафует ревьюверов последних PR автора: каждое такое ревью даёт вклад
+// от 1 (PR создан только что) до 0 (PR старше окна затухания), а вес кандидата умножается
+// на (1 - penalty/100) в степени суммы вкладов. penalty - настройка основной команды автора.
+func (s *PullRequestServiceImpl) rotationWeights(ctx context.Context, author *models.User) (rotationWeights, error) {
+	if s.rotationHistorySize <= 0 || s.rotationWindow <= 0 || author == nil || author.TeamName == "" {
+		return nil, nil
+	}
+
+	penalty, err := s.teamRepo.GetTeamRotationPenalty(ctx, author.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team rotation penalty: %w", err)
+	}
+	if penalty <= 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	pairings, err := s.prRepo.GetRecentPairings(ctx, author.UserID, s.rotationHistorySize, now.Add(-s.rotationWindow))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent pairings: %w", err)
+	}
+
+	scores := make(map[string]float64)
+	for _, pairing := range pairings {
+		age := now.Sub(pairing.CreatedAt)
+		if age < 0 {
+			age = 0
+		}
+		if decay := 1 - float64(age)/float64(s.rotationWindow); decay > 0 {
+			scores[pairing.ReviewerID] += decay
+		}
+	}
+
+	keep := 1 - float64(penalty)/maxRotationPenalty
+	weights := make(rotationWeights, len(scores))
+	for userID, score := range scores {
+		weights[userID] = math.Pow(keep, score)
+	}
+
+	return weights, nil
+}
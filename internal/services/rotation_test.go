@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestPullRequestServiceImpl_RotationWeights(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+	prSvc.SetRotation(3, 10*24*time.Hour)
+
+	ctx := context.Background()
+	author := &models.User{UserID: "author", TeamName: "team"}
+
+	t.Run("recent pairings decay with age", func(t *testing.T) {
+		now := time.Now()
+		pairings := []models.ReviewPairing{
+			{AuthorID: "author", ReviewerID: "fresh", CreatedAt: now},
+			{AuthorID: "author", ReviewerID: "fresh", CreatedAt: now},
+			{AuthorID: "author", ReviewerID: "old", CreatedAt: now.Add(-9 * 24 * time.Hour)},
+		}
+
+		mockTeamRepo.EXPECT().GetTeamRotationPenalty(ctx, "team").Return(50, nil)
+		mockPRRepo.EXPECT().GetRecentPairings(ctx, "author", 3, gomock.Any()).Return(pairings, nil)
+
+		weights, err := prSvc.rotationWeights(ctx, author)
+
+		require.NoError(t, err)
+		assert.InDelta(t, 0.25, weights.of("fresh"), 0.01)
+		assert.InDelta(t, 0.93, weights.of("old"), 0.01)
+		assert.Equal(t, 1.0, weights.of("stranger"))
+	})
+
+	t.Run("zero penalty skips history", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamRotationPenalty(ctx, "team").Return(0, nil)
+
+		weights, err := prSvc.rotationWeights(ctx, author)
+
+		require.NoError(t, err)
+		assert.Nil(t, weights)
+	})
+
+	t.Run("penalised reviewer is picked less often", func(t *testing.T) {
+		candidates := []*models.User{{UserID: "repeat"}, {UserID: "other"}}
+		rotation := rotationWeights{"repeat": 0.1}
+
+		picks := map[string]int{}
+		for i := 0; i < 1000; i++ {
+			picks[candidates[prSvc.pickWeighted(candidates, rotation)].UserID]++
+		}
+
+		assert.Greater(t, picks["other"], 800)
+	})
+
+	t.Run("fully penalised candidates are still picked", func(t *testing.T) {
+		candidates := []*models.User{{UserID: "a"}, {UserID: "b"}}
+
+		selected := prSvc.selectRandomReviewers(candidates, 1, rotationWeights{"a": 0, "b": 0})
+
+		assert.Len(t, selected, 1)
+	})
+
+	t.Run("create avoids the previous reviewer", func(t *testing.T) {
+		one := 1
+		mockRepoRepo := mocks.NewMockRepoRepository(ctrl)
+		mockRepoRepo.EXPECT().GetRepo(ctx, models.DefaultRepository).Return(&models.Repo{Name: models.DefaultRepository, ReviewerCount: &one}, nil)
+		singleSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, mockRepoRepo, nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+		members := []*models.User{author, {UserID: "repeat"}, {UserID: "other"}}
+		pairings := []models.ReviewPairing{{AuthorID: "author", ReviewerID: "repeat", CreatedAt: time.Now()}}
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"}
+
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().GetBlockedReviewers(ctx, "author").Return(nil, nil)
+		mockTeamRepo.EXPECT().GetTeamRotationPenalty(ctx, "team").Return(100, nil)
+		mockPRRepo.EXPECT().GetRecentPairings(ctx, "author", defaultRotationHistorySize, gomock.Any()).Return(pairings, nil)
+		mockTeamRepo.EXPECT().GetTeamPolicies(ctx, "team").Return(nil, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return(members, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := singleSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other"}, created.AssignedReviewers)
+	})
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+// levelRanks упорядочивает уровни пользователей; пустой уровень не входит ни в один.
+var levelRanks = map[string]int{
+	models.LevelJunior: 1,
+	models.LevelMid:    2,
+	models.LevelSenior: 3,
+	models.LevelLead:   4,
+}
+
+// levelRequirement - требование политики команды или правила репозитория: среди ревьюверов
+// PR должен быть хотя бы один пользователь уровня не ниже minLevel и не выше maxLevel.
+// source - откуда требование, для сообщений об ошибках.
+type levelRequirement struct {
+	source   string
+	minLevel string
+	maxLevel string
+}
+
+func (r levelRequirement) allows(user *models.User) bool {
+	rank, ok := levelRanks[user.Level]
+	return ok && rank >= levelRanks[r.minLevel] && rank <= levelRanks[r.maxLevel]
+}
+
+func (r levelRequirement) String() string {
+	switch levelRanks[r.maxLevel] - levelRanks[r.minLevel] {
+	case 0:
+		return fmt.Sprintf("%s requires a %s reviewer", r.source, r.minLevel)
+	case 1:
+		return fmt.Sprintf("%s requires a %s or %s reviewer", r.source, r.minLevel, r.maxLevel)
+	default:
+		return fmt.Sprintf("%s requires a %s or higher reviewer", r.source, r.minLevel)
+	}
+}
+
+func seniorRequirement(policy string) levelRequirement {
+	return levelRequirement{source: "policy " + policy, minLevel: models.LevelSenior, maxLevel: models.LevelLead}
+}
+
+func juniorRequirement(policy string) levelRequirement {
+	return levelRequirement{source: "policy " + policy, minLevel: models.LevelJunior, maxLevel: models.LevelJunior}
+}
+
+// normalizeLevel проверяет уровень пользователя. Пустая строка сбрасывает уровень.
+func normalizeLevel(level string) (string, error) {
+	level = strings.ToLower(strings.TrimSpace(level))
+	if level == "" {
+		return "", nil
+	}
+	if _, ok := levelRanks[level]; !ok {
+		return "", fmt.Errorf("%w: level must be %s, %s, %s or %s", ErrInvalidArgument,
+			models.LevelJunior, models.LevelMid, models.LevelSenior, models.LevelLead)
+	}
+	return level, nil
+}
+
+// normalizeTeamPolicies проверяет политики команды и возвращает их отсортированными без повторов.
+func normalizeTeamPolicies(policies []string) ([]string, error) {
+	set := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		policy = strings.ToLower(strings.TrimSpace(policy))
+		switch policy {
+		case models.PolicyJuniorNeedsSenior, models.PolicyMentorshipPairing:
+			set[policy] = true
+		default:
+			return nil, fmt.Errorf("%w: unknown policy %q", ErrInvalidArgument, policy)
+		}
+	}
+
+	normalized := make([]string, 0, len(set))
+	for policy := range set {
+		normalized = append(normalized, policy)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// policyRequirements переводит политики команды автора в требования к составу ревьюверов его PR.
+func policyRequirements(policies []string, author *models.User) []levelRequirement {
+	var requirements []levelRequirement
+	for _, policy := range policies {
+		switch policy {
+		case models.PolicyJuniorNeedsSenior:
+			if author.Level == models.LevelJunior {
+				requirements = append(requirements, seniorRequirement(policy))
+			}
+		case models.PolicyMentorshipPairing:
+			requirements = append(requirements, seniorRequirement(policy), juniorRequirement(policy))
+		}
+	}
+	return requirements
+}
+
+// ruleRequirements возвращает требования к уровню подходящих под PR правил репозитория.
+func ruleRequirements(repo *models.Repo, pr *models.PullRequest) []levelRequirement {
+	var requirements []levelRequirement
+	for i, rule := range repo.Rules {
+		if rule.MinLevel != "" && ruleMatches(rule, pr) {
+			requirements = append(requirements, levelRequirement{
+				source:   fmt.Sprintf("repository rule %d", i+1),
+				minLevel: rule.MinLevel,
+				maxLevel: models.LevelLead,
+			})
+		}
+	}
+	return requirements
+}
+
+// levelRequirements возвращает требования политик основной команды автора PR и подходящих
+// под PR правил его репозитория.
+func (s *PullRequestServiceImpl) levelRequirements(ctx context.Context, repo *models.Repo, pr *models.PullRequest, author *models.User) ([]levelRequirement, error) {
+	requirements := ruleRequirements(repo, pr)
+	if author == nil || author.TeamName == "" {
+		return requirements, nil
+	}
+
+	policies, err := s.teamRepo.GetTeamPolicies(ctx, author.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team policies: %w", err)
+	}
+
+	return append(policyRequirements(policies, author), requirements...), nil
+}
+
+// unsatisfiedRequirements возвращает требования, которым не отвечает ни один из reviewers.
+func unsatisfiedRequirements(requirements []levelRequirement, reviewers []*models.User) []levelRequirement {
+	var unsatisfied []levelRequirement
+	for _, requirement := range requirements {
+		satisfied := false
+		for _, reviewer := range reviewers {
+			if requirement.allows(reviewer) {
+				satisfied = true
+				break
+			}
+		}
+		if !satisfied {
+			unsatisfied = append(unsatisfied, requirement)
+		}
+	}
+	return unsatisfied
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestNormalizeLevelAndPolicies(t *testing.T) {
+	level, err := normalizeLevel(" Senior")
+	require.NoError(t, err)
+	assert.Equal(t, models.LevelSenior, level)
+
+	level, err = normalizeLevel("")
+	require.NoError(t, err)
+	assert.Empty(t, level)
+
+	_, err = normalizeLevel("principal")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	policies, err := normalizeTeamPolicies([]string{"mentorship_pairing", "Junior_Needs_Senior", "mentorship_pairing"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{models.PolicyJuniorNeedsSenior, models.PolicyMentorshipPairing}, policies)
+
+	_, err = normalizeTeamPolicies([]string{"two_seniors"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestPolicyRequirements(t *testing.T) {
+	junior := &models.User{UserID: "j", Level: models.LevelJunior}
+	senior := &models.User{UserID: "s", Level: models.LevelSenior}
+
+	assert.Empty(t, policyRequirements([]string{models.PolicyJuniorNeedsSenior}, senior))
+
+	requirements := policyRequirements([]string{models.PolicyJuniorNeedsSenior}, junior)
+	require.Len(t, requirements, 1)
+	assert.True(t, requirements[0].allows(&models.User{Level: models.LevelLead}))
+	assert.False(t, requirements[0].allows(&models.User{Level: models.LevelMid}))
+	assert.False(t, requirements[0].allows(&models.User{}))
+
+	pairing := policyRequirements([]string{models.PolicyMentorshipPairing}, senior)
+	assert.Len(t, unsatisfiedRequirements(pairing, []*models.User{senior}), 1)
+	assert.Empty(t, unsatisfiedRequirements(pairing, []*models.User{junior, senior}))
+}
+
+func TestRuleRequirements(t *testing.T) {
+	repo := &models.Repo{Rules: []models.AssignmentRule{
+		{Label: "security", ExtraReviewers: 1},
+		{MinChangedLines: 500, MinLevel: models.LevelSenior},
+		{Priority: models.PriorityCritical, MinLevel: models.LevelLead},
+	}}
+
+	assert.Empty(t, ruleRequirements(repo, &models.PullRequest{Labels: []string{"security"}, LinesAdded: 100}))
+
+	requirements := ruleRequirements(repo, &models.PullRequest{LinesAdded: 300, LinesRemoved: 200, Priority: models.PriorityCritical})
+	require.Len(t, requirements, 2)
+	assert.Equal(t, "repository rule 2 requires a senior or lead reviewer", requirements[0].String())
+	assert.Equal(t, "repository rule 3 requires a lead reviewer", requirements[1].String())
+	assert.False(t, requirements[0].allows(&models.User{Level: models.LevelMid}))
+	assert.True(t, requirements[0].allows(&models.User{Level: models.LevelLead}))
+}
+
+func TestPullRequestServiceImpl_MentorshipPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+	mockUserRepo.EXPECT().GetBlockedReviewers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	ctx := context.Background()
+	junior := &models.User{UserID: "author", TeamName: "team", IsActive: true, Level: models.LevelJunior}
+	mid := &models.User{UserID: "author", TeamName: "team", IsActive: true, Level: models.LevelMid}
+
+	expectAuthor := func(author *models.User, policies []string) {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockTeamRepo.EXPECT().GetTeamPolicies(ctx, "team").Return(policies, nil)
+	}
+
+	t.Run("junior author gets a senior reviewer", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"}
+		members := []*models.User{junior, {UserID: "j2", Level: models.LevelJunior}, {UserID: "s1", Level: models.LevelSenior}}
+
+		expectAuthor(junior, []string{models.PolicyJuniorNeedsSenior})
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return(members, nil).Times(2)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"s1", "j2"}, created.AssignedReviewers)
+	})
+
+	t.Run("mentorship pairing assigns junior and senior", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"}
+		members := []*models.User{
+			mid,
+			{UserID: "m2", Level: models.LevelMid},
+			{UserID: "j1", Level: models.LevelJunior},
+			{UserID: "l1", Level: models.LevelLead},
+		}
+
+		expectAuthor(mid, []string{models.PolicyMentorshipPairing})
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return(members, nil).Times(2)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"l1", "j1"}, created.AssignedReviewers)
+	})
+
+	t.Run("unsatisfiable policy is reported", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author"}
+
+		expectAuthor(junior, []string{models.PolicyJuniorNeedsSenior})
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{junior, {UserID: "m1", Level: models.LevelMid}}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "team").Return(&models.Team{TeamName: "team"}, nil)
+
+		_, err := prSvc.CreatePullRequest(ctx, pr)
+
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		assert.Contains(t, err.Error(), models.PolicyJuniorNeedsSenior)
+	})
+
+	reassignPR := func() *models.PullRequest {
+		return &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", Status: models.PRStatusOpen, AssignedReviewers: []string{"s1", "j2"}}
+	}
+	expectReassign := func() {
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(reassignPR(), nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "s1").Return(&models.User{UserID: "s1", TeamName: "team", Level: models.LevelSenior}, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(junior, nil)
+		mockTeamRepo.EXPECT().GetTeamPolicies(ctx, "team").Return([]string{models.PolicyJuniorNeedsSenior}, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "j2").Return(&models.User{UserID: "j2", TeamName: "team", Level: models.LevelJunior}, nil)
+	}
+
+	t.Run("reassign replaces senior with senior", func(t *testing.T) {
+		expectReassign()
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			junior,
+			{UserID: "s1", Level: models.LevelSenior},
+			{UserID: "j2", Level: models.LevelJunior},
+			{UserID: "j3", Level: models.LevelJunior},
+			{UserID: "l1", Level: models.LevelLead},
+		}, nil)
+		mockPRRepo.EXPECT().SetAssignedReviewers(ctx, models.DefaultRepository, "pr1", []string{"l1", "j2"}).Return(nil)
+
+		_, replacedBy, err := prSvc.ReassignReviewer(ctx, "", "pr1", "s1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "l1", replacedBy)
+	})
+
+	t.Run("reassign without senior is reported", func(t *testing.T) {
+		expectReassign()
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			junior,
+			{UserID: "s1", Level: models.LevelSenior},
+			{UserID: "j2", Level: models.LevelJunior},
+			{UserID: "j3", Level: models.LevelJunior},
+		}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "team").Return(&models.Team{TeamName: "team"}, nil)
+
+		_, _, err := prSvc.ReassignReviewer(ctx, "", "pr1", "s1")
+
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+	})
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"pr-reviewer-assignment-service/internal/models"
 )
@@ -10,22 +11,109 @@ type UserService interface {
 	SetUserActiveStatus(ctx context.Context, userID string, isActive bool) error
 	ValidateUserExists(ctx context.Context, userID string) error
 	GetUserWithTeam(ctx context.Context, userID string) (*models.User, error)
+	SetUserSkills(ctx context.Context, userID string, skills []string) (*models.User, error)
+	SetUserLevel(ctx context.Context, userID string, level string) (*models.User, error)
+	BlockPair(ctx context.Context, pair *models.BlockedPair, mutual bool) (*models.BlockedPairList, error)
+	UnblockPair(ctx context.Context, authorID, reviewerID string, mutual bool) (*models.BlockedPairList, error)
+	GetBlockedPairs(ctx context.Context, userID string) (*models.BlockedPairList, error)
 }
 
 type TeamService interface {
 	CreateTeamWithMembers(ctx context.Context, teamName string, members []models.TeamMember) (*models.Team, error)
 	GetTeamWithMembers(ctx context.Context, teamName string) (*models.Team, error)
+	ListTeams(ctx context.Context) ([]*models.Team, error)
+	GetMembersByTeams(ctx context.Context, teamNames []string) (map[string][]models.TeamMember, error)
+	SetParentTeam(ctx context.Context, teamName string, parentTeam string) (*models.Team, error)
+	GetTeamSubtree(ctx context.Context, rootTeam string) (*models.TeamNode, error)
+	SetTeamPolicies(ctx context.Context, teamName string, policies []string) (*models.Team, error)
+	SetRotationPenalty(ctx context.Context, teamName string, penalty int) (*models.Team, error)
+	SetTeamSLA(ctx context.Context, teamName string, sla models.ReviewSLA) (*models.Team, error)
+	SetAutoReassign(ctx context.Context, teamName string, settings models.AutoReassignSettings) (*models.Team, error)
+}
+
+type MembershipService interface {
+	AddMembers(ctx context.Context, teamName string, members []models.TeamMember) (*models.Team, error)
+	RemoveMembers(ctx context.Context, teamName string, userIDs []string, openReviews string) ([]*models.MembershipChange, error)
+	TransferMember(ctx context.Context, userID string, targetTeam string, openReviews string) (*models.MembershipChange, error)
+	ArchiveTeam(ctx context.Context, teamName string) (*models.TeamRemovalReport, error)
+	RestoreTeam(ctx context.Context, teamName string) (*models.Team, error)
+	DeleteTeam(ctx context.Context, teamName string, reassignTo string) (*models.TeamRemovalReport, error)
 }
 
 type PullRequestService interface {
 	CreatePullRequest(ctx context.Context, pr *models.PullRequest) (*models.PullRequest, error)
-	MergePullRequest(ctx context.Context, prID string) error
-	ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) error
-	GetUserPullRequests(ctx context.Context, userID string) ([]*models.PullRequestShort, error)
+	DryRunPullRequest(ctx context.Context, pr *models.PullRequest) (*models.AssignmentPreview, error)
+	MergePullRequest(ctx context.Context, repository, prID string) (*models.PullRequest, error)
+	MarkReadyForReview(ctx context.Context, repository, prID string) (*models.PullRequest, error)
+	ReassignReviewer(ctx context.Context, repository, prID string, oldReviewerID string) (*models.PullRequest, string, error)
+	GetUserPullRequests(ctx context.Context, userID string, filter models.ReviewFilter, cursor string) (*models.ReviewPage, error)
+	GetPullRequest(ctx context.Context, repository, prID string) (*models.PullRequest, error)
+	ListPullRequests(ctx context.Context, filter models.PullRequestFilter, cursor string) (*models.PullRequestPage, error)
+	RecordReviewResponse(ctx context.Context, repository, prID, reviewerID, verdict string) (*models.ReviewAssignment, error)
+}
+
+type ReviewSLAService interface {
+	GetOverdueByTeam(ctx context.Context, teamName string) (*models.OverdueReviews, error)
+	GetOverdueByReviewer(ctx context.Context, userID string) (*models.OverdueReviews, error)
+	GetOpenReviewsByReviewers(ctx context.Context, userIDs []string) (map[string][]models.ReviewAssignment, error)
+}
+
+type AutoReassignService interface {
+	ReassignStaleReviews(ctx context.Context) ([]*models.AutoReassignment, error)
+	GetAutoReassignments(ctx context.Context, repository, prID string) ([]*models.AutoReassignment, error)
+}
+
+type WebhookService interface {
+	Subscribe(ctx context.Context, sub *models.WebhookSubscription) (*models.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	GetDeadLetters(ctx context.Context, subscriptionID int64) ([]*models.WebhookDeadLetter, error)
+	ReplayDeadLetters(ctx context.Context, subscriptionID int64, ids []int64) (int, error)
+	Dispatch(ctx context.Context) error
+}
+
+type EventStreamService interface {
+	Stream(ctx context.Context, filter models.EventStreamFilter, lastEventID *int64, send func([]*models.Event) error) error
+}
+
+type NotificationService interface {
+	SetPreferences(ctx context.Context, prefs *models.NotificationPreferences) (*models.NotificationPreferences, error)
+	GetPreferences(ctx context.Context, userID string) (*models.NotificationPreferences, error)
+	ProcessEvents(ctx context.Context) error
+	SendReminders(ctx context.Context) error
+	SendDigests(ctx context.Context) error
+	SendMessage(ctx context.Context, userID, subject, body, html string) error
+}
+
+type ReviewDigestService interface {
+	GetDigest(ctx context.Context, userID string) (*models.ReviewDigest, error)
+	SendDigests(ctx context.Context) error
+}
+
+type GitHostService interface {
+	HandleGitHub(ctx context.Context, eventType, signature string, body []byte) (*models.GitHostResult, error)
+	HandleGitLab(ctx context.Context, eventType, token string, body []byte) (*models.GitHostResult, error)
+	MapUser(ctx context.Context, identity *models.GitIdentity) (*models.GitIdentity, error)
+	UnmapUser(ctx context.Context, provider, username string) error
+	ListMappings(ctx context.Context, provider string) ([]*models.GitIdentity, error)
+}
+
+type RepoService interface {
+	CreateRepo(ctx context.Context, repo *models.Repo) (*models.Repo, error)
+	GetRepo(ctx context.Context, name string) (*models.Repo, error)
+	UpdateRepo(ctx context.Context, repo *models.Repo) (*models.Repo, error)
+}
+
+type CodeOwnersService interface {
+	UploadCodeOwners(ctx context.Context, repository string, content string) (*models.CodeOwnersFile, error)
+	GetCodeOwners(ctx context.Context, repository string, version int) (*models.CodeOwnersFile, error)
+	ValidateCodeOwners(ctx context.Context, repository string, version int) (*models.CodeOwnersValidation, error)
 }
 
 type StatisticService interface {
 	GetAssignmentsByUsers(ctx context.Context) ([]*models.UserAssignmentStats, error)
 	GetPRCountByStatus(ctx context.Context) ([]*models.PRStatusStats, error)
 	GetTeamStatistics(ctx context.Context) ([]*models.TeamStats, error)
+	GetFairnessReport(ctx context.Context, teamName string, since *time.Time) (*models.FairnessReport, error)
+	GetTeamLoad(ctx context.Context, teamName string) (*models.TeamLoad, error)
 }
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+const maxTagLength = 100
+
+// normalizeSkills приводит теги навыков к нижнему регистру, убирает пробелы и дубликаты.
+func normalizeSkills(skills []string) ([]string, error) {
+	return normalizeTags("skill", skills)
+}
+
+// normalizeTags приводит теги (навыки, метки) к нижнему регистру, убирает пробелы и
+// дубликаты. kind называет вид тега в сообщении об ошибке.
+func normalizeTags(kind string, tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || len(tag) > maxTagLength {
+			return nil, fmt.Errorf("%w: %s must be 1..%d characters", ErrInvalidArgument, kind, maxTagLength)
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+func normalizeSkillMatch(mode string) (string, error) {
+	switch mode {
+	case "":
+		return models.SkillMatchPrefer, nil
+	case models.SkillMatchPrefer, models.SkillMatchRequire:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%w: unknown skill_match %q", ErrInvalidArgument, mode)
+	}
+}
+
+func sortedSkills(skills map[string]bool) []string {
+	sorted := make([]string, 0, len(skills))
+	for skill := range skills {
+		sorted = append(sorted, skill)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func hasAnySkill(user *models.User, skills map[string]bool) bool {
+	for _, skill := range user.Skills {
+		if skills[skill] {
+			return true
+		}
+	}
+	return false
+}
+
+func skillSet(skills []string) map[string]bool {
+	set := make(map[string]bool, len(skills))
+	for _, skill := range skills {
+		set[skill] = true
+	}
+	return set
+}
+
+// selectBySkills выбирает до count ревьюверов, жадно покрывая навыки из uncovered:
+// сначала кандидат, закрывающий больше непокрытых навыков, при равенстве - менее
+// загруженный открытыми ревью, затем случайный с учётом веса и штрафа ротации. Покрытые
+// навыки удаляются из uncovered.
+func (s *PullRequestServiceImpl) selectBySkills(ctx context.Context, candidates []*models.User, count int, uncovered map[string]bool, rotation rotationWeights) ([]*models.User, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.UserID
+	}
+
+	loads, err := s.prRepo.GetOpenReviewCounts(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer load: %w", err)
+	}
+
+	available := make([]*models.User, len(candidates))
+	copy(available, candidates)
+
+	selected := make([]*models.User, 0, count)
+	for len(selected) < count && len(available) > 0 {
+		var best []*models.User
+		bestGain, bestLoad := -1, 0
+		for _, candidate := range available {
+			gain := 0
+			for _, skill := range candidate.Skills {
+				if uncovered[skill] {
+					gain++
+				}
+			}
+
+			load := loads[candidate.UserID]
+			switch {
+			case gain > bestGain || (gain == bestGain && load < bestLoad):
+				best, bestGain, bestLoad = []*models.User{candidate}, gain, load
+			case gain == bestGain && load == bestLoad:
+				best = append(best, candidate)
+			}
+		}
+
+		picked := best[s.pickWeighted(best, rotation)]
+		selected = append(selected, picked)
+		for _, skill := range picked.Skills {
+			delete(uncovered, skill)
+		}
+
+		for i, candidate := range available {
+			if candidate == picked {
+				available = append(available[:i], available[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestNormalizeSkills(t *testing.T) {
+	skills, err := normalizeSkills([]string{" Go", "postgres", "go", "Frontend "})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"frontend", "go", "postgres"}, skills)
+
+	_, err = normalizeSkills([]string{"go", "  "})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestPullRequestServiceImpl_SkillMatchedAssignment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockTeamRepo.EXPECT().GetTeamPolicies(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockUserRepo.EXPECT().GetBlockedReviewers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockTeamRepo.EXPECT().GetTeamRotationPenalty(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+	author := &models.User{UserID: "author", TeamName: "team", IsActive: true}
+
+	expectAuthor := func() {
+		mockPRRepo.EXPECT().PullRequestExists(ctx, models.DefaultRepository, "pr1").Return(false, nil)
+		mockUserRepo.EXPECT().UserExists(ctx, "author").Return(true, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+	}
+
+	t.Run("prefers skill coverage then lower load", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", RequiredSkills: []string{"Postgres", "go"}}
+
+		expectAuthor()
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			author,
+			{UserID: "busy", Skills: []string{"frontend"}},
+			{UserID: "dba", Skills: []string{"go", "postgres"}},
+			{UserID: "idle"},
+		}, nil)
+		mockPRRepo.EXPECT().GetOpenReviewCounts(ctx, []string{"busy", "dba", "idle"}).Return(map[string]int{"busy": 5, "dba": 9}, nil)
+		mockPRRepo.EXPECT().CreatePullRequest(ctx, pr).Return(nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dba", "idle"}, created.AssignedReviewers)
+		assert.Equal(t, []string{"go", "postgres"}, created.RequiredSkills)
+		assert.Equal(t, models.SkillMatchPrefer, created.SkillMatch)
+	})
+
+	t.Run("require fails without skilled reviewers", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", RequiredSkills: []string{"postgres"}, SkillMatch: models.SkillMatchRequire}
+
+		expectAuthor()
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{author, {UserID: "frontender", Skills: []string{"frontend"}}}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "team").Return(&models.Team{TeamName: "team"}, nil)
+
+		created, err := prSvc.CreatePullRequest(ctx, pr)
+
+		assert.ErrorIs(t, err, ErrNoCandidate)
+		assert.Nil(t, created)
+	})
+
+	t.Run("require fails when skills stay uncovered", func(t *testing.T) {
+		pr := &models.PullRequest{PullRequestID: "pr1", AuthorID: "author", RequiredSkills: []string{"postgres", "go", "k8s"}, SkillMatch: models.SkillMatchRequire}
+
+		expectAuthor()
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			author,
+			{UserID: "dba", Skills: []string{"postgres"}},
+			{UserID: "gopher", Skills: []string{"go"}},
+		}, nil)
+		mockPRRepo.EXPECT().GetOpenReviewCounts(ctx, gomock.Any()).Return(map[string]int{}, nil)
+
+		_, err := prSvc.CreatePullRequest(ctx, pr)
+
+		assert.ErrorIs(t, err, ErrNoCandidate)
+		assert.Contains(t, err.Error(), "k8s")
+	})
+
+	t.Run("unknown skill match mode", func(t *testing.T) {
+		_, err := prSvc.CreatePullRequest(ctx, &models.PullRequest{PullRequestID: "pr1", SkillMatch: "maybe"})
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("reassign covers skills of the leaving reviewer", func(t *testing.T) {
+		pr := &models.PullRequest{
+			PullRequestID:     "pr1",
+			AuthorID:          "author",
+			Status:            models.PRStatusOpen,
+			AssignedReviewers: []string{"r1", "r2"},
+			RequiredSkills:    []string{"go", "postgres"},
+			SkillMatch:        models.SkillMatchRequire,
+		}
+
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(pr, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "author").Return(author, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "r1").Return(&models.User{UserID: "r1", TeamName: "team", Skills: []string{"postgres"}}, nil)
+		mockUserRepo.EXPECT().GetUserByID(ctx, "r2").Return(&models.User{UserID: "r2", TeamName: "team", Skills: []string{"go"}}, nil)
+		mockUserRepo.EXPECT().GetActiveUsersByTeam(ctx, "team").Return([]*models.User{
+			{UserID: "r1", Skills: []string{"postgres"}},
+			{UserID: "r2", Skills: []string{"go"}},
+			{UserID: "gopher", Skills: []string{"go"}},
+			{UserID: "dba", Skills: []string{"postgres"}},
+		}, nil)
+		mockPRRepo.EXPECT().GetOpenReviewCounts(ctx, []string{"dba"}).Return(map[string]int{}, nil)
+		mockPRRepo.EXPECT().SetAssignedReviewers(ctx, models.DefaultRepository, "pr1", []string{"dba", "r2"}).Return(nil)
+
+		_, replacedBy, err := prSvc.ReassignReviewer(ctx, "", "pr1", "r1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "dba", replacedBy)
+	})
+}
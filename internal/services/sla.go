@@ -0,0 +1,284 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+// maxSLAHours ограничивает сроки SLA: 720 рабочих часов - больше четырёх месяцев по 9 часов в день.
+const maxSLAHours = 720
+
+// BusinessHours - рабочее время, в котором отсчитываются сроки SLA: с StartHour до EndHour
+// по будням в часовом поясе Location.
+type BusinessHours struct {
+	Location  *time.Location
+	StartHour int
+	EndHour   int
+}
+
+// DefaultBusinessHours - рабочий день с 9 до 18 по UTC.
+func DefaultBusinessHours() BusinessHours {
+	return BusinessHours{Location: time.UTC, StartHour: 9, EndHour: 18}
+}
+
+// NewBusinessHours проверяет часовой пояс и границы рабочего дня.
+func NewBusinessHours(timezone string, startHour, endHour int) (BusinessHours, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return BusinessHours{}, fmt.Errorf("unknown business hours timezone %q: %w", timezone, err)
+	}
+	if startHour < 0 || endHour > 24 || startHour >= endHour {
+		return BusinessHours{}, fmt.Errorf("business hours must satisfy 0 <= start < end <= 24, got %d-%d", startHour, endHour)
+	}
+	return BusinessHours{Location: location, StartHour: startHour, EndHour: endHour}, nil
+}
+
+// Add возвращает момент, когда от from пройдёт d рабочего времени.
+func (b BusinessHours) Add(from time.Time, d time.Duration) time.Time {
+	t := from.In(b.Location)
+	for {
+		dayStart := time.Date(t.Year(), t.Month(), t.Day(), b.StartHour, 0, 0, 0, b.Location)
+		dayEnd := time.Date(t.Year(), t.Month(), t.Day(), b.EndHour, 0, 0, 0, b.Location)
+		nextDay := dayStart.AddDate(0, 0, 1)
+
+		if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday || !t.Before(dayEnd) {
+			t = nextDay
+			continue
+		}
+		if t.Before(dayStart) {
+			t = dayStart
+		}
+
+		left := dayEnd.Sub(t)
+		if d <= left {
+			return t.Add(d)
+		}
+		d -= left
+		t = nextDay
+	}
+}
+
+// dueAt возвращает срок через hours рабочих часов от start или nil, если срок не задан.
+func (b BusinessHours) dueAt(start time.Time, hours *int) *time.Time {
+	if hours == nil {
+		return nil
+	}
+	due := b.Add(start, time.Duration(*hours)*time.Hour)
+	return &due
+}
+
+// reviewSLAState вычисляет сроки назначения ревьювера, отсчитывая их от assignedAt.
+func (b BusinessHours) reviewSLAState(sla models.ReviewSLA, status string, assignedAt time.Time, respondedAt *time.Time, now time.Time) models.SLAState {
+	state := models.SLAState{
+		FirstResponseDueAt: b.dueAt(assignedAt, sla.FirstResponseHours),
+		CompletionDueAt:    b.dueAt(assignedAt, sla.CompletionHours),
+	}
+	if status != models.PRStatusOpen {
+		return state
+	}
+
+	if respondedAt == nil && state.FirstResponseDueAt != nil && now.After(*state.FirstResponseDueAt) {
+		state.Overdue = true
+	}
+	if state.CompletionDueAt != nil && now.After(*state.CompletionDueAt) {
+		state.Overdue = true
+	}
+	return state
+}
+
+// pullRequestSLAState вычисляет срок завершения ревью PR, отсчитывая его от создания PR.
+func (b BusinessHours) pullRequestSLAState(pr *models.PullRequest, now time.Time) *models.SLAState {
+	if pr.CompletionSLAHours == nil || pr.CreatedAt == nil {
+		return nil
+	}
+
+	state := &models.SLAState{CompletionDueAt: b.dueAt(*pr.CreatedAt, pr.CompletionSLAHours)}
+	state.Overdue = pr.Status == models.PRStatusOpen && now.After(*state.CompletionDueAt)
+	return state
+}
+
+// reviewShortSLAState вычисляет сроки назначения ревьювера на PR из его списка ревью.
+// Если у команды автора SLA не задан, возвращается nil.
+func (b BusinessHours) reviewShortSLAState(pr *models.PullRequestShort, now time.Time) *models.SLAState {
+	if pr.AssignedAt == nil || (pr.TeamSLA.FirstResponseHours == nil && pr.TeamSLA.CompletionHours == nil) {
+		return nil
+	}
+
+	state := b.reviewSLAState(pr.TeamSLA, pr.Status, *pr.AssignedAt, pr.RespondedAt, now)
+	return &state
+}
+
+// SetBusinessHours задаёт рабочее время, в котором отсчитываются сроки SLA.
+func (s *PullRequestServiceImpl) SetBusinessHours(hours BusinessHours) {
+	s.businessHours = hours
+}
+
+// RecordReviewResponse отмечает первый ответ ревьювера на открытый PR и снимает с
+// назначения просрочку по сроку первого ответа. Повторный ответ время не меняет.
+// Непустой verdict заменяет прежний вердикт ревьювера и порождает событие review.submitted.
+func (s *PullRequestServiceImpl) RecordReviewResponse(ctx context.Context, repo, prID, reviewerID, verdict string) (*models.ReviewAssignment, error) {
+	repo = repositoryOrDefault(repo)
+	switch verdict {
+	case "", models.ReviewVerdictApproved, models.ReviewVerdictChangesRequested, models.ReviewVerdictCommented:
+	default:
+		return nil, fmt.Errorf("%w: verdict must be %q, %q or %q", ErrInvalidArgument,
+			models.ReviewVerdictApproved, models.ReviewVerdictChangesRequested, models.ReviewVerdictCommented)
+	}
+
+	pr, err := s.prRepo.GetPullRequestByID(ctx, repo, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+	if pr == nil {
+		return nil, ErrPRNotFound
+	}
+	if pr.Status == models.PRStatusMerged {
+		return nil, fmt.Errorf("cannot record review response: %w", ErrPRMerged)
+	}
+
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		err := s.prRepo.SetReviewResponded(ctx, repo, prID, reviewerID, time.Now(), verdict)
+		if err != nil || verdict == "" {
+			return err
+		}
+		return s.enqueue(ctx, newReviewSubmittedEvent(pr, reviewerID, verdict))
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotAssigned
+		}
+		return nil, fmt.Errorf("failed to record review response: %w", err)
+	}
+
+	assignments, err := s.prRepo.GetReviewAssignments(ctx, models.ReviewAssignmentFilter{
+		Repository:    repo,
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review assignment: %w", err)
+	}
+	if len(assignments) == 0 {
+		return nil, ErrNotAssigned
+	}
+
+	assignment := assignments[0]
+	assignment.SLA = s.businessHours.reviewSLAState(assignment.TeamSLA, assignment.Status, assignment.AssignedAt, assignment.RespondedAt, time.Now())
+	return assignment, nil
+}
+
+// normalizeReviewSLA проверяет сроки SLA: от 1 до maxSLAHours часов, первый ответ не позже завершения.
+func normalizeReviewSLA(sla models.ReviewSLA) (models.ReviewSLA, error) {
+	for _, hours := range []*int{sla.FirstResponseHours, sla.CompletionHours} {
+		if hours != nil && (*hours < 1 || *hours > maxSLAHours) {
+			return sla, fmt.Errorf("%w: SLA hours must be between 1 and %d", ErrInvalidArgument, maxSLAHours)
+		}
+	}
+	if sla.FirstResponseHours != nil && sla.CompletionHours != nil && *sla.FirstResponseHours > *sla.CompletionHours {
+		return sla, fmt.Errorf("%w: first_response_hours must not exceed completion_hours", ErrInvalidArgument)
+	}
+	return sla, nil
+}
+
+type ReviewSLAServiceImpl struct {
+	prRepo   repository.PullRequestRepository
+	teamRepo repository.TeamRepository
+	userRepo repository.UserRepository
+	hours    BusinessHours
+}
+
+func NewReviewSLAService(
+	prRepo repository.PullRequestRepository,
+	teamRepo repository.TeamRepository,
+	userRepo repository.UserRepository,
+	hours BusinessHours,
+) *ReviewSLAServiceImpl {
+	return &ReviewSLAServiceImpl{
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+		hours:    hours,
+	}
+}
+
+// GetOverdueByTeam возвращает просроченные назначения ревьюверов на открытые PR авторов команды.
+func (s *ReviewSLAServiceImpl) GetOverdueByTeam(ctx context.Context, teamName string) (*models.OverdueReviews, error) {
+	exists, err := s.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+
+	reviews, err := s.overdueReviews(ctx, models.ReviewAssignmentFilter{TeamName: teamName, OpenOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.OverdueReviews{TeamName: teamName, Reviews: reviews}, nil
+}
+
+// GetOverdueByReviewer возвращает просроченные назначения пользователя на открытые PR.
+func (s *ReviewSLAServiceImpl) GetOverdueByReviewer(ctx context.Context, userID string) (*models.OverdueReviews, error) {
+	exists, err := s.userRepo.UserExists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	reviews, err := s.overdueReviews(ctx, models.ReviewAssignmentFilter{ReviewerID: userID, OpenOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.OverdueReviews{UserID: userID, Reviews: reviews}, nil
+}
+
+// GetOpenReviewsByReviewers возвращает назначения пользователей на открытые PR с состоянием SLA
+// одним запросом, по ревьюверам.
+func (s *ReviewSLAServiceImpl) GetOpenReviewsByReviewers(ctx context.Context, userIDs []string) (map[string][]models.ReviewAssignment, error) {
+	if len(userIDs) == 0 {
+		return map[string][]models.ReviewAssignment{}, nil
+	}
+
+	assignments, err := s.prRepo.GetReviewAssignments(ctx, models.ReviewAssignmentFilter{ReviewerIDs: userIDs, OpenOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review assignments: %w", err)
+	}
+
+	now := time.Now()
+	reviews := make(map[string][]models.ReviewAssignment, len(userIDs))
+	for _, assignment := range assignments {
+		assignment.SLA = s.hours.reviewSLAState(assignment.TeamSLA, assignment.Status, assignment.AssignedAt, assignment.RespondedAt, now)
+		reviews[assignment.ReviewerID] = append(reviews[assignment.ReviewerID], *assignment)
+	}
+
+	return reviews, nil
+}
+
+func (s *ReviewSLAServiceImpl) overdueReviews(ctx context.Context, filter models.ReviewAssignmentFilter) ([]models.ReviewAssignment, error) {
+	assignments, err := s.prRepo.GetReviewAssignments(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review assignments: %w", err)
+	}
+
+	now := time.Now()
+	overdue := []models.ReviewAssignment{}
+	for _, assignment := range assignments {
+		assignment.SLA = s.hours.reviewSLAState(assignment.TeamSLA, assignment.Status, assignment.AssignedAt, assignment.RespondedAt, now)
+		if assignment.SLA.Overdue {
+			overdue = append(overdue, *assignment)
+		}
+	}
+
+	return overdue, nil
+}
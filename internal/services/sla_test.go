@@ -0,0 +1,274 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestBusinessHours_Add(t *testing.T) {
+	hours := DefaultBusinessHours()
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, time.January, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		duration time.Duration
+		want     time.Time
+	}{
+		{"within day", at(8, 10, 0), 3 * time.Hour, at(8, 13, 0)},
+		{"before start", at(8, 7, 0), 9 * time.Hour, at(8, 18, 0)},
+		{"after end", at(8, 18, 0), time.Hour, at(9, 10, 0)},
+		{"friday over weekend", at(5, 17, 0), 2 * time.Hour, at(8, 10, 0)},
+		{"from saturday", at(6, 12, 30), 90 * time.Minute, at(8, 10, 30)},
+		{"several days", at(8, 9, 0), 20 * time.Hour, at(10, 11, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hours.Add(tt.from, tt.duration))
+		})
+	}
+
+	t.Run("timezone", func(t *testing.T) {
+		moscow, err := NewBusinessHours("Europe/Moscow", 10, 19)
+		require.NoError(t, err)
+
+		// 06:00 UTC - 09:00 по Москве, рабочий день начинается в 10:00 (07:00 UTC).
+		assert.True(t, at(8, 8, 0).Equal(moscow.Add(at(8, 6, 0), time.Hour)))
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewBusinessHours("Mars/Olympus", 9, 18)
+		assert.Error(t, err)
+
+		_, err = NewBusinessHours("UTC", 18, 9)
+		assert.Error(t, err)
+	})
+}
+
+func TestBusinessHours_ReviewSLAState(t *testing.T) {
+	hours := DefaultBusinessHours()
+	assignedAt := time.Date(2024, time.January, 8, 10, 0, 0, 0, time.UTC)
+	firstResponse, completion := 2, 8
+	sla := models.ReviewSLA{FirstResponseHours: &firstResponse, CompletionHours: &completion}
+
+	t.Run("first response missed", func(t *testing.T) {
+		state := hours.reviewSLAState(sla, models.PRStatusOpen, assignedAt, nil, assignedAt.Add(3*time.Hour))
+
+		assert.True(t, state.Overdue)
+		assert.Equal(t, assignedAt.Add(2*time.Hour), *state.FirstResponseDueAt)
+		assert.Equal(t, time.Date(2024, time.January, 8, 18, 0, 0, 0, time.UTC), *state.CompletionDueAt)
+	})
+
+	t.Run("responded in time", func(t *testing.T) {
+		responded := assignedAt.Add(time.Hour)
+
+		state := hours.reviewSLAState(sla, models.PRStatusOpen, assignedAt, &responded, assignedAt.Add(3*time.Hour))
+
+		assert.False(t, state.Overdue)
+	})
+
+	t.Run("completion missed", func(t *testing.T) {
+		responded := assignedAt.Add(time.Hour)
+
+		state := hours.reviewSLAState(sla, models.PRStatusOpen, assignedAt, &responded, assignedAt.Add(48*time.Hour))
+
+		assert.True(t, state.Overdue)
+	})
+
+	t.Run("merged is never overdue", func(t *testing.T) {
+		state := hours.reviewSLAState(sla, models.PRStatusMerged, assignedAt, nil, assignedAt.Add(48*time.Hour))
+
+		assert.False(t, state.Overdue)
+	})
+
+	t.Run("no SLA", func(t *testing.T) {
+		state := hours.reviewSLAState(models.ReviewSLA{}, models.PRStatusOpen, assignedAt, nil, assignedAt.Add(48*time.Hour))
+
+		assert.False(t, state.Overdue)
+		assert.Nil(t, state.FirstResponseDueAt)
+		assert.Nil(t, state.CompletionDueAt)
+	})
+}
+
+func TestReviewSLAServiceImpl_GetOverdue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	slaSvc := NewReviewSLAService(mockPRRepo, mockTeamRepo, mockUserRepo, DefaultBusinessHours())
+
+	ctx := context.Background()
+	hoursSLA := 1
+	sla := models.ReviewSLA{FirstResponseHours: &hoursSLA}
+	longAgo := time.Now().AddDate(0, 0, -7)
+	justNow := time.Now()
+
+	assignments := func() []*models.ReviewAssignment {
+		return []*models.ReviewAssignment{
+			{PullRequestID: "pr1", ReviewerID: "u2", Status: models.PRStatusOpen, AssignedAt: longAgo, TeamSLA: sla},
+			{PullRequestID: "pr1", ReviewerID: "u3", Status: models.PRStatusOpen, AssignedAt: longAgo, RespondedAt: &justNow, TeamSLA: sla},
+			{PullRequestID: "pr2", ReviewerID: "u2", Status: models.PRStatusOpen, AssignedAt: justNow, TeamSLA: sla},
+			{PullRequestID: "pr3", ReviewerID: "u2", Status: models.PRStatusOpen, AssignedAt: longAgo},
+		}
+	}
+
+	t.Run("by team", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockPRRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{TeamName: "backend", OpenOnly: true}).Return(assignments(), nil)
+
+		overdue, err := slaSvc.GetOverdueByTeam(ctx, "backend")
+
+		require.NoError(t, err)
+		assert.Equal(t, "backend", overdue.TeamName)
+		require.Len(t, overdue.Reviews, 1)
+		assert.Equal(t, "u2", overdue.Reviews[0].ReviewerID)
+		assert.Equal(t, "pr1", overdue.Reviews[0].PullRequestID)
+		assert.True(t, overdue.Reviews[0].SLA.Overdue)
+	})
+
+	t.Run("by reviewer without overdue", func(t *testing.T) {
+		mockUserRepo.EXPECT().UserExists(ctx, "u4").Return(true, nil)
+		mockPRRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{ReviewerID: "u4", OpenOnly: true}).Return(nil, nil)
+
+		overdue, err := slaSvc.GetOverdueByReviewer(ctx, "u4")
+
+		require.NoError(t, err)
+		assert.NotNil(t, overdue.Reviews)
+		assert.Empty(t, overdue.Reviews)
+	})
+
+	t.Run("unknown team", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "missing").Return(false, nil)
+
+		_, err := slaSvc.GetOverdueByTeam(ctx, "missing")
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+
+	t.Run("unknown reviewer", func(t *testing.T) {
+		mockUserRepo.EXPECT().UserExists(ctx, "ghost").Return(false, nil)
+
+		_, err := slaSvc.GetOverdueByReviewer(ctx, "ghost")
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestReviewSLAServiceImpl_GetOpenReviewsByReviewers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	slaSvc := NewReviewSLAService(mockPRRepo, mocks.NewMockTeamRepository(ctrl), mocks.NewMockUserRepository(ctrl), DefaultBusinessHours())
+
+	ctx := context.Background()
+	hoursSLA := 1
+	sla := models.ReviewSLA{FirstResponseHours: &hoursSLA}
+	longAgo := time.Now().AddDate(0, 0, -7)
+
+	t.Run("groups by reviewer", func(t *testing.T) {
+		mockPRRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{ReviewerIDs: []string{"u2", "u3", "u4"}, OpenOnly: true}).
+			Return([]*models.ReviewAssignment{
+				{PullRequestID: "pr1", ReviewerID: "u2", Status: models.PRStatusOpen, AssignedAt: longAgo, TeamSLA: sla},
+				{PullRequestID: "pr1", ReviewerID: "u3", Status: models.PRStatusOpen, AssignedAt: longAgo},
+				{PullRequestID: "pr2", ReviewerID: "u2", Status: models.PRStatusOpen, AssignedAt: time.Now(), TeamSLA: sla},
+			}, nil)
+
+		reviews, err := slaSvc.GetOpenReviewsByReviewers(ctx, []string{"u2", "u3", "u4"})
+
+		require.NoError(t, err)
+		require.Len(t, reviews["u2"], 2)
+		assert.True(t, reviews["u2"][0].SLA.Overdue)
+		assert.False(t, reviews["u2"][1].SLA.Overdue)
+		require.Len(t, reviews["u3"], 1)
+		assert.False(t, reviews["u3"][0].SLA.Overdue)
+		assert.Empty(t, reviews["u4"])
+	})
+
+	t.Run("no reviewers", func(t *testing.T) {
+		reviews, err := slaSvc.GetOpenReviewsByReviewers(ctx, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, reviews)
+	})
+}
+
+func TestPullRequestServiceImpl_RecordReviewResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	prSvc := NewPullRequestService(mockPRRepo, mockUserRepo, mockTeamRepo, newDefaultRepoRepo(ctrl), nil, &UserServiceImpl{userRepo: mockUserRepo})
+
+	ctx := context.Background()
+	hoursSLA := 1
+	openPR := &models.PullRequest{PullRequestID: "pr1", Status: models.PRStatusOpen, AssignedReviewers: []string{"u2"}}
+
+	t.Run("success", func(t *testing.T) {
+		longAgo := time.Now().AddDate(0, 0, -7)
+		responded := time.Now()
+
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(openPR, nil)
+		mockPRRepo.EXPECT().SetReviewResponded(ctx, models.DefaultRepository, "pr1", "u2", gomock.Any(), "").Return(nil)
+		mockPRRepo.EXPECT().GetReviewAssignments(ctx, models.ReviewAssignmentFilter{
+			Repository:    models.DefaultRepository,
+			PullRequestID: "pr1",
+			ReviewerID:    "u2",
+		}).Return([]*models.ReviewAssignment{{
+			PullRequestID: "pr1",
+			ReviewerID:    "u2",
+			Status:        models.PRStatusOpen,
+			AssignedAt:    longAgo,
+			RespondedAt:   &responded,
+			TeamSLA:       models.ReviewSLA{FirstResponseHours: &hoursSLA},
+		}}, nil)
+
+		assignment, err := prSvc.RecordReviewResponse(ctx, "", "pr1", "u2", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, &responded, assignment.RespondedAt)
+		assert.False(t, assignment.SLA.Overdue)
+		assert.NotNil(t, assignment.SLA.FirstResponseDueAt)
+	})
+
+	t.Run("not assigned", func(t *testing.T) {
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr1").Return(openPR, nil)
+		mockPRRepo.EXPECT().SetReviewResponded(ctx, models.DefaultRepository, "pr1", "u9", gomock.Any(), "").Return(sql.ErrNoRows)
+
+		_, err := prSvc.RecordReviewResponse(ctx, "", "pr1", "u9", "")
+
+		assert.ErrorIs(t, err, ErrNotAssigned)
+	})
+
+	t.Run("merged", func(t *testing.T) {
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "pr2").Return(&models.PullRequest{PullRequestID: "pr2", Status: models.PRStatusMerged}, nil)
+
+		_, err := prSvc.RecordReviewResponse(ctx, "", "pr2", "u2", "")
+
+		assert.ErrorIs(t, err, ErrPRMerged)
+	})
+
+	t.Run("pull request not found", func(t *testing.T) {
+		mockPRRepo.EXPECT().GetPullRequestByID(ctx, models.DefaultRepository, "missing").Return(nil, nil)
+
+		_, err := prSvc.RecordReviewResponse(ctx, "", "missing", "u2", "")
+
+		assert.ErrorIs(t, err, ErrPRNotFound)
+	})
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"pr-reviewer-assignment-service/internal/models"
 	"pr-reviewer-assignment-service/internal/repository"
@@ -32,13 +34,18 @@ func (s *StatisticServiceImpl) GetAssignmentsByUsers(ctx context.Context) ([]*mo
 		return nil, fmt.Errorf("failed to get assignments by users: %w", err)
 	}
 
+	userIDs := make([]string, 0, len(assignments))
+	for userID := range assignments {
+		userIDs = append(userIDs, userID)
+	}
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
 	var stats []*models.UserAssignmentStats
 	for userID, count := range assignments {
-		user, err := s.userRepo.GetUserByID(ctx, userID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get user %s: %w", userID, err)
-		}
-		if user != nil {
+		if user, ok := users[userID]; ok {
 			stats = append(stats, &models.UserAssignmentStats{
 				UserID:          userID,
 				Username:        user.Username,
@@ -67,38 +74,167 @@ func (s *StatisticServiceImpl) GetPRCountByStatus(ctx context.Context) ([]*model
 	return stats, nil
 }
 
+// GetTeamStatistics возвращает показатели каждой команды и их свёртку по поддереву.
+// Участники и число PR всех команд читаются одним запросом каждое.
 func (s *StatisticServiceImpl) GetTeamStatistics(ctx context.Context) ([]*models.TeamStats, error) {
 	teams, err := s.teamRepo.GetAllTeams(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get all teams: %w", err)
 	}
 
-	var stats []*models.TeamStats
+	teamNames := make([]string, 0, len(teams))
 	for _, team := range teams {
-		fullTeam, err := s.teamRepo.GetTeamWithMembers(ctx, team.TeamName)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get team with members %s: %w", team.TeamName, err)
-		}
+		teamNames = append(teamNames, team.TeamName)
+	}
+	members, err := s.teamRepo.GetMembersByTeams(ctx, teamNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team members: %w", err)
+	}
+	prCounts, err := s.prRepo.GetPRCountsByTeam(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR counts by team: %w", err)
+	}
 
+	var stats []*models.TeamStats
+	children := make(map[string][]string)
+	for _, team := range teams {
 		activeCount := 0
-		for _, member := range fullTeam.Members {
+		for _, member := range members[team.TeamName] {
 			if member.IsActive {
 				activeCount++
 			}
 		}
 
-		prCount, err := s.prRepo.GetTeamPRCount(ctx, team.TeamName)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get PR count for team %s: %w", team.TeamName, err)
-		}
-
 		stats = append(stats, &models.TeamStats{
 			TeamName:          team.TeamName,
-			MemberCount:       len(fullTeam.Members),
+			ParentTeam:        team.ParentTeam,
+			MemberCount:       len(members[team.TeamName]),
 			ActiveMemberCount: activeCount,
-			PRCount:           prCount,
+			PRCount:           prCounts[team.TeamName],
 		})
+		if team.ParentTeam != "" {
+			children[team.ParentTeam] = append(children[team.ParentTeam], team.TeamName)
+		}
+	}
+
+	for _, stat := range stats {
+		stat.Subtree = rollUpTeamStats(stat.TeamName, children, members, prCounts)
 	}
 
 	return stats, nil
 }
+
+// GetFairnessReport показывает, как ревью PR авторов распределены между ревьюверами.
+// teamName ограничивает авторов основной командой, since - PR, созданные не раньше этого времени.
+func (s *StatisticServiceImpl) GetFairnessReport(ctx context.Context, teamName string, since *time.Time) (*models.FairnessReport, error) {
+	if teamName != "" {
+		exists, err := s.teamRepo.TeamExists(ctx, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check team existence: %w", err)
+		}
+		if !exists {
+			return nil, ErrTeamNotFound
+		}
+	}
+
+	pairings, err := s.prRepo.GetPairingCounts(ctx, teamName, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pairing counts: %w", err)
+	}
+
+	report := &models.FairnessReport{TeamName: teamName, Since: since, Authors: []models.AuthorPairings{}}
+	for _, pairing := range pairings {
+		last := len(report.Authors) - 1
+		if last < 0 || report.Authors[last].AuthorID != pairing.AuthorID {
+			report.Authors = append(report.Authors, models.AuthorPairings{AuthorID: pairing.AuthorID})
+			last++
+		}
+
+		author := &report.Authors[last]
+		author.ReviewCount += pairing.Count
+		author.DistinctReviewers++
+		author.Pairings = append(author.Pairings, pairing)
+	}
+
+	for i := range report.Authors {
+		author := &report.Authors[i]
+		top := 0
+		for _, pairing := range author.Pairings {
+			top = max(top, pairing.Count)
+		}
+		author.TopReviewerShare = float64(top) / float64(author.ReviewCount)
+	}
+
+	return report, nil
+}
+
+// GetTeamLoad возвращает число открытых PR, на которые назначен каждый участник команды.
+// Участники упорядочены от самых загруженных, при равенстве - по имени.
+func (s *StatisticServiceImpl) GetTeamLoad(ctx context.Context, teamName string) (*models.TeamLoad, error) {
+	team, err := s.teamRepo.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team with members: %w", err)
+	}
+	if team == nil {
+		return nil, ErrTeamNotFound
+	}
+
+	userIDs := make([]string, 0, len(team.Members))
+	for _, member := range team.Members {
+		userIDs = append(userIDs, member.UserID)
+	}
+	counts, err := s.prRepo.GetOpenReviewCounts(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open review counts: %w", err)
+	}
+
+	load := &models.TeamLoad{TeamName: teamName, Members: make([]models.MemberLoad, 0, len(team.Members))}
+	for _, member := range team.Members {
+		load.Members = append(load.Members, models.MemberLoad{
+			UserID:      member.UserID,
+			Username:    member.Username,
+			IsActive:    member.IsActive,
+			OpenReviews: counts[member.UserID],
+		})
+	}
+	sort.SliceStable(load.Members, func(i, j int) bool {
+		return load.Members[i].OpenReviews > load.Members[j].OpenReviews
+	})
+
+	return load, nil
+}
+
+// rollUpTeamStats суммирует показатели команды root и всех её потомков.
+// PR относится к основной команде автора, поэтому счётчики PR просто складываются.
+func rollUpTeamStats(root string, children map[string][]string, members map[string][]models.TeamMember, prCounts map[string]int) models.TeamSubtreeStats {
+	var subtree models.TeamSubtreeStats
+	seenUsers := make(map[string]bool)
+	visited := make(map[string]bool)
+
+	queue := []string{root}
+	for len(queue) > 0 {
+		team := queue[0]
+		queue = queue[1:]
+		if visited[team] {
+			continue
+		}
+		visited[team] = true
+
+		subtree.TeamCount++
+		subtree.PRCount += prCounts[team]
+		for _, member := range members[team] {
+			if seenUsers[member.UserID] {
+				continue
+			}
+			seenUsers[member.UserID] = true
+			subtree.MemberCount++
+			if member.IsActive {
+				subtree.ActiveMemberCount++
+			}
+		}
+
+		queue = append(queue, children[team]...)
+	}
+
+	return subtree
+}
@@ -43,8 +43,11 @@ func TestStatisticServiceImpl_GetAssignmentsByUsers(t *testing.T) {
 		}
 
 		mockPRRepo.EXPECT().GetAssignmentsByUsers(ctx).Return(assignments, nil)
-		mockUserRepo.EXPECT().GetUserByID(ctx, "user1").Return(user1, nil)
-		mockUserRepo.EXPECT().GetUserByID(ctx, "user2").Return(user2, nil)
+		mockUserRepo.EXPECT().GetUsersByIDs(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, userIDs []string) (map[string]*models.User, error) {
+				assert.ElementsMatch(t, []string{"user1", "user2"}, userIDs)
+				return map[string]*models.User{"user1": user1, "user2": user2}, nil
+			})
 
 		stats, err := statSvc.GetAssignmentsByUsers(ctx)
 
@@ -78,13 +81,13 @@ func TestStatisticServiceImpl_GetAssignmentsByUsers(t *testing.T) {
 		expectedErr := errors.New("user lookup error")
 
 		mockPRRepo.EXPECT().GetAssignmentsByUsers(ctx).Return(assignments, nil)
-		mockUserRepo.EXPECT().GetUserByID(ctx, "user1").Return(nil, expectedErr)
+		mockUserRepo.EXPECT().GetUsersByIDs(ctx, []string{"user1"}).Return(nil, expectedErr)
 
 		stats, err := statSvc.GetAssignmentsByUsers(ctx)
 
 		assert.Error(t, err)
 		assert.Nil(t, stats)
-		assert.Contains(t, err.Error(), "failed to get user")
+		assert.Contains(t, err.Error(), "failed to get users")
 	})
 }
 
@@ -158,26 +161,19 @@ func TestStatisticServiceImpl_GetTeamStatistics(t *testing.T) {
 			},
 		}
 
-		fullTeam1 := &models.Team{
-			TeamName: "team1",
-			Members: []models.TeamMember{
+		members := map[string][]models.TeamMember{
+			"team1": {
 				{UserID: "user1", IsActive: true},
 				{UserID: "user2", IsActive: false},
 			},
-		}
-
-		fullTeam2 := &models.Team{
-			TeamName: "team2",
-			Members: []models.TeamMember{
+			"team2": {
 				{UserID: "user3", IsActive: true},
 			},
 		}
 
 		mockTeamRepo.EXPECT().GetAllTeams(ctx).Return(teams, nil)
-		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "team1").Return(fullTeam1, nil)
-		mockPRRepo.EXPECT().GetTeamPRCount(ctx, "team1").Return(5, nil)
-		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "team2").Return(fullTeam2, nil)
-		mockPRRepo.EXPECT().GetTeamPRCount(ctx, "team2").Return(3, nil)
+		mockTeamRepo.EXPECT().GetMembersByTeams(ctx, []string{"team1", "team2"}).Return(members, nil)
+		mockPRRepo.EXPECT().GetPRCountsByTeam(ctx).Return(map[string]int{"team1": 5, "team2": 3}, nil)
 
 		stats, err := statSvc.GetTeamStatistics(ctx)
 
@@ -198,6 +194,42 @@ func TestStatisticServiceImpl_GetTeamStatistics(t *testing.T) {
 		assert.Equal(t, 3, found["team2"].PRCount)
 	})
 
+	t.Run("rolls up subtree", func(t *testing.T) {
+		teams := []*models.Team{
+			{TeamName: "org"},
+			{TeamName: "backend", ParentTeam: "org"},
+			{TeamName: "payments", ParentTeam: "backend"},
+		}
+
+		mockTeamRepo.EXPECT().GetAllTeams(ctx).Return(teams, nil)
+		mockTeamRepo.EXPECT().GetMembersByTeams(ctx, []string{"org", "backend", "payments"}).Return(map[string][]models.TeamMember{
+			"org": {{UserID: "cto", IsActive: true}},
+			"backend": {
+				{UserID: "user1", IsActive: true},
+				{UserID: "user2", IsActive: false},
+			},
+			"payments": {
+				{UserID: "user1", IsActive: true},
+				{UserID: "user3", IsActive: true},
+			},
+		}, nil)
+		mockPRRepo.EXPECT().GetPRCountsByTeam(ctx).Return(map[string]int{"org": 1, "backend": 4, "payments": 2}, nil)
+
+		stats, err := statSvc.GetTeamStatistics(ctx)
+
+		require.NoError(t, err)
+
+		found := make(map[string]*models.TeamStats)
+		for _, stat := range stats {
+			found[stat.TeamName] = stat
+		}
+
+		assert.Equal(t, models.TeamSubtreeStats{TeamCount: 3, MemberCount: 4, ActiveMemberCount: 3, PRCount: 7}, found["org"].Subtree)
+		assert.Equal(t, models.TeamSubtreeStats{TeamCount: 2, MemberCount: 3, ActiveMemberCount: 2, PRCount: 6}, found["backend"].Subtree)
+		assert.Equal(t, models.TeamSubtreeStats{TeamCount: 1, MemberCount: 2, ActiveMemberCount: 2, PRCount: 2}, found["payments"].Subtree)
+		assert.Equal(t, "backend", found["payments"].ParentTeam)
+	})
+
 	t.Run("get all teams error", func(t *testing.T) {
 		expectedErr := errors.New("db error")
 		mockTeamRepo.EXPECT().GetAllTeams(ctx).Return(nil, expectedErr)
@@ -209,17 +241,121 @@ func TestStatisticServiceImpl_GetTeamStatistics(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to get all teams")
 	})
 
-	t.Run("get team with members error", func(t *testing.T) {
+	t.Run("get team members error", func(t *testing.T) {
 		teams := []*models.Team{{TeamName: "team1"}}
 		expectedErr := errors.New("team error")
 
 		mockTeamRepo.EXPECT().GetAllTeams(ctx).Return(teams, nil)
-		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "team1").Return(nil, expectedErr)
+		mockTeamRepo.EXPECT().GetMembersByTeams(ctx, []string{"team1"}).Return(nil, expectedErr)
+
+		stats, err := statSvc.GetTeamStatistics(ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, stats)
+		assert.Contains(t, err.Error(), "failed to get team members")
+	})
+
+	t.Run("get PR counts error", func(t *testing.T) {
+		teams := []*models.Team{{TeamName: "team1"}}
+		expectedErr := errors.New("count error")
+
+		mockTeamRepo.EXPECT().GetAllTeams(ctx).Return(teams, nil)
+		mockTeamRepo.EXPECT().GetMembersByTeams(ctx, []string{"team1"}).Return(map[string][]models.TeamMember{}, nil)
+		mockPRRepo.EXPECT().GetPRCountsByTeam(ctx).Return(nil, expectedErr)
 
 		stats, err := statSvc.GetTeamStatistics(ctx)
 
 		assert.Error(t, err)
 		assert.Nil(t, stats)
-		assert.Contains(t, err.Error(), "failed to get team with members")
+		assert.Contains(t, err.Error(), "failed to get PR counts by team")
+	})
+}
+
+func TestStatisticServiceImpl_GetFairnessReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	statSvc := NewStatisticService(mockPRRepo, mockTeamRepo, mockUserRepo)
+
+	ctx := context.Background()
+
+	t.Run("groups pairings by author", func(t *testing.T) {
+		since := time.Now().Add(-7 * 24 * time.Hour)
+		pairings := []models.PairingStats{
+			{AuthorID: "alice", ReviewerID: "bob", Count: 3},
+			{AuthorID: "alice", ReviewerID: "carol", Count: 1},
+			{AuthorID: "dave", ReviewerID: "bob", Count: 2},
+		}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockPRRepo.EXPECT().GetPairingCounts(ctx, "backend", &since).Return(pairings, nil)
+
+		report, err := statSvc.GetFairnessReport(ctx, "backend", &since)
+
+		require.NoError(t, err)
+		require.Len(t, report.Authors, 2)
+		assert.Equal(t, "alice", report.Authors[0].AuthorID)
+		assert.Equal(t, 4, report.Authors[0].ReviewCount)
+		assert.Equal(t, 2, report.Authors[0].DistinctReviewers)
+		assert.InDelta(t, 0.75, report.Authors[0].TopReviewerShare, 0.001)
+		assert.Equal(t, 1.0, report.Authors[1].TopReviewerShare)
+	})
+
+	t.Run("empty history", func(t *testing.T) {
+		mockPRRepo.EXPECT().GetPairingCounts(ctx, "", nil).Return(nil, nil)
+
+		report, err := statSvc.GetFairnessReport(ctx, "", nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, report.Authors)
+		assert.NotNil(t, report.Authors)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "missing").Return(false, nil)
+
+		_, err := statSvc.GetFairnessReport(ctx, "missing", nil)
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
+
+func TestStatisticServiceImpl_GetTeamLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPRRepo := mocks.NewMockPullRequestRepository(ctrl)
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	statSvc := NewStatisticService(mockPRRepo, mockTeamRepo, nil)
+	ctx := context.Background()
+
+	t.Run("members ordered by open reviews", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(&models.Team{
+			TeamName: "backend",
+			Members: []models.TeamMember{
+				{UserID: "u1", Username: "alice", IsActive: true},
+				{UserID: "u2", Username: "bob", IsActive: true},
+				{UserID: "u3", Username: "eve", IsActive: false},
+			},
+		}, nil)
+		mockPRRepo.EXPECT().GetOpenReviewCounts(ctx, []string{"u1", "u2", "u3"}).Return(map[string]int{"u2": 3, "u3": 1}, nil)
+
+		load, err := statSvc.GetTeamLoad(ctx, "backend")
+		require.NoError(t, err)
+		assert.Equal(t, []models.MemberLoad{
+			{UserID: "u2", Username: "bob", IsActive: true, OpenReviews: 3},
+			{UserID: "u3", Username: "eve", OpenReviews: 1},
+			{UserID: "u1", Username: "alice", IsActive: true},
+		}, load.Members)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "missing").Return(nil, nil)
+
+		_, err := statSvc.GetTeamLoad(ctx, "missing")
+		assert.ErrorIs(t, err, ErrTeamNotFound)
 	})
 }
@@ -2,7 +2,6 @@ package services
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"pr-reviewer-assignment-service/internal/models"
@@ -27,12 +26,14 @@ func (s *TeamServiceImpl) CreateTeamWithMembers(ctx context.Context, teamName st
 		return nil, fmt.Errorf("failed to check team existence: %w", err)
 	}
 	if exists {
-		return nil, errors.New("team already exists")
+		return nil, ErrTeamExists
 	}
 
 	team := &models.Team{
-		TeamName: teamName,
-		Members:  members,
+		TeamName:        teamName,
+		Members:         members,
+		RotationPenalty: models.DefaultRotationPenalty,
+		AutoReassign:    models.AutoReassignSettings{MaxPerPR: models.DefaultAutoReassignMaxPerPR},
 	}
 
 	err = s.teamRepo.CreateTeam(ctx, team)
@@ -52,6 +53,11 @@ func (s *TeamServiceImpl) CreateTeamWithMembers(ctx context.Context, teamName st
 		if err != nil {
 			return nil, fmt.Errorf("failed to create/update user %s: %w", member.UserID, err)
 		}
+
+		err = s.teamRepo.AddTeamMember(ctx, teamName, member)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add user %s to team: %w", member.UserID, err)
+		}
 	}
 
 	return team, nil
@@ -63,8 +69,219 @@ func (s *TeamServiceImpl) GetTeamWithMembers(ctx context.Context, teamName strin
 		return nil, fmt.Errorf("failed to get team with members: %w", err)
 	}
 	if team == nil {
-		return nil, errors.New("team not found")
+		return nil, ErrTeamNotFound
 	}
 
 	return team, nil
 }
+
+// ListTeams возвращает все команды, включая архивные, без участников.
+func (s *TeamServiceImpl) ListTeams(ctx context.Context) ([]*models.Team, error) {
+	teams, err := s.teamRepo.GetAllTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+// GetMembersByTeams возвращает участников нескольких команд одним запросом.
+// Команды без участников и несуществующие команды в результат не попадают.
+func (s *TeamServiceImpl) GetMembersByTeams(ctx context.Context, teamNames []string) (map[string][]models.TeamMember, error) {
+	members, err := s.teamRepo.GetMembersByTeams(ctx, teamNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team members: %w", err)
+	}
+
+	return members, nil
+}
+
+// SetParentTeam встраивает команду в иерархию. Пустой parentTeam делает команду корневой.
+// Родитель не может быть самой командой или её потомком.
+func (s *TeamServiceImpl) SetParentTeam(ctx context.Context, teamName string, parentTeam string) (*models.Team, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	if team == nil {
+		return nil, ErrTeamNotFound
+	}
+
+	if parentTeam != "" {
+		if err := s.ensureNotDescendant(ctx, teamName, parentTeam); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.teamRepo.SetTeamParent(ctx, teamName, parentTeam)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set parent team: %w", err)
+	}
+
+	return s.GetTeamWithMembers(ctx, teamName)
+}
+
+// SetTeamPolicies заменяет политики наставничества команды.
+func (s *TeamServiceImpl) SetTeamPolicies(ctx context.Context, teamName string, policies []string) (*models.Team, error) {
+	normalized, err := normalizeTeamPolicies(policies)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+
+	err = s.teamRepo.SetTeamPolicies(ctx, teamName, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set team policies: %w", err)
+	}
+
+	return s.GetTeamWithMembers(ctx, teamName)
+}
+
+// SetRotationPenalty задаёт силу штрафа за повторное назначение недавних ревьюверов
+// авторов команды: 0 отключает ротацию, 100 - недавние ревьюверы выбираются в последнюю очередь.
+func (s *TeamServiceImpl) SetRotationPenalty(ctx context.Context, teamName string, penalty int) (*models.Team, error) {
+	penalty, err := normalizeRotationPenalty(penalty)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+
+	err = s.teamRepo.SetTeamRotationPenalty(ctx, teamName, penalty)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set team rotation penalty: %w", err)
+	}
+
+	return s.GetTeamWithMembers(ctx, teamName)
+}
+
+// SetTeamSLA задаёт сроки ревью PR авторов команды в рабочих часах. nil снимает срок.
+func (s *TeamServiceImpl) SetTeamSLA(ctx context.Context, teamName string, sla models.ReviewSLA) (*models.Team, error) {
+	sla, err := normalizeReviewSLA(sla)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+
+	err = s.teamRepo.SetTeamSLA(ctx, teamName, sla)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set team SLA: %w", err)
+	}
+
+	return s.GetTeamWithMembers(ctx, teamName)
+}
+
+// SetAutoReassign включает или отключает автоматическое переназначение ревьюверов, не ответивших
+// к сроку SLA на PR авторов команды. Нулевой MaxPerPR означает лимит по умолчанию.
+func (s *TeamServiceImpl) SetAutoReassign(ctx context.Context, teamName string, settings models.AutoReassignSettings) (*models.Team, error) {
+	if settings.MaxPerPR == 0 {
+		settings.MaxPerPR = models.DefaultAutoReassignMaxPerPR
+	}
+	if settings.MaxPerPR < 1 || settings.MaxPerPR > maxAutoReassignPerPR {
+		return nil, fmt.Errorf("%w: max_per_pr must be between 1 and %d", ErrInvalidArgument, maxAutoReassignPerPR)
+	}
+
+	exists, err := s.teamRepo.TeamExists(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	if !exists {
+		return nil, ErrTeamNotFound
+	}
+
+	err = s.teamRepo.SetTeamAutoReassign(ctx, teamName, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set team auto reassign: %w", err)
+	}
+
+	return s.GetTeamWithMembers(ctx, teamName)
+}
+
+// ensureNotDescendant проверяет, что parentTeam существует и не лежит в поддереве teamName.
+func (s *TeamServiceImpl) ensureNotDescendant(ctx context.Context, teamName string, parentTeam string) error {
+	visited := make(map[string]bool)
+	for current := parentTeam; current != ""; {
+		if current == teamName {
+			return fmt.Errorf("%w: team %s cannot be a parent of itself or its ancestor", ErrInvalidArgument, parentTeam)
+		}
+		if visited[current] {
+			break
+		}
+		visited[current] = true
+
+		team, err := s.teamRepo.GetTeamByName(ctx, current)
+		if err != nil {
+			return fmt.Errorf("failed to get team %s: %w", current, err)
+		}
+		if team == nil {
+			return fmt.Errorf("%w: %s", ErrTeamNotFound, current)
+		}
+		current = team.ParentTeam
+	}
+
+	return nil
+}
+
+// GetTeamSubtree возвращает команду rootTeam с участниками и всеми дочерними командами.
+func (s *TeamServiceImpl) GetTeamSubtree(ctx context.Context, rootTeam string) (*models.TeamNode, error) {
+	teams, err := s.teamRepo.GetTeamSubtree(ctx, rootTeam)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team subtree: %w", err)
+	}
+	if len(teams) == 0 {
+		return nil, ErrTeamNotFound
+	}
+
+	nodes := make(map[string]*models.TeamNode, len(teams))
+	for _, team := range teams {
+		fullTeam, err := s.teamRepo.GetTeamWithMembers(ctx, team.TeamName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team with members %s: %w", team.TeamName, err)
+		}
+
+		members := []models.TeamMember{}
+		if fullTeam != nil && fullTeam.Members != nil {
+			members = fullTeam.Members
+		}
+
+		nodes[team.TeamName] = &models.TeamNode{
+			TeamName:   team.TeamName,
+			ParentTeam: team.ParentTeam,
+			ArchivedAt: team.ArchivedAt,
+			Members:    members,
+			Children:   []*models.TeamNode{},
+		}
+	}
+
+	// teams отсортированы по имени, поэтому порядок детей детерминирован.
+	for _, team := range teams {
+		if team.TeamName == rootTeam {
+			continue
+		}
+		if parent, ok := nodes[team.ParentTeam]; ok {
+			parent.Children = append(parent.Children, nodes[team.TeamName])
+		}
+	}
+
+	return nodes[rootTeam], nil
+}
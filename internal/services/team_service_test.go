@@ -38,6 +38,7 @@ func TestTeamServiceImpl_CreateTeamWithMembers(t *testing.T) {
 		mockTeamRepo.EXPECT().TeamExists(ctx, teamName).Return(false, nil)
 		mockTeamRepo.EXPECT().CreateTeam(ctx, gomock.Any()).Return(nil)
 		mockUserRepo.EXPECT().CreateUser(ctx, gomock.Any()).Return(nil).Times(2)
+		mockTeamRepo.EXPECT().AddTeamMember(ctx, teamName, gomock.Any()).Return(nil).Times(2)
 
 		team, err := teamSvc.CreateTeamWithMembers(ctx, teamName, members)
 
@@ -54,6 +55,7 @@ func TestTeamServiceImpl_CreateTeamWithMembers(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, team)
 		assert.Contains(t, err.Error(), "team already exists")
+		assert.ErrorIs(t, err, ErrTeamExists)
 	})
 
 	t.Run("team exists check error", func(t *testing.T) {
@@ -91,6 +93,20 @@ func TestTeamServiceImpl_CreateTeamWithMembers(t *testing.T) {
 		assert.Nil(t, team)
 		assert.Contains(t, err.Error(), "failed to create/update user")
 	})
+
+	t.Run("add member error", func(t *testing.T) {
+		expectedErr := errors.New("membership error")
+		mockTeamRepo.EXPECT().TeamExists(ctx, teamName).Return(false, nil)
+		mockTeamRepo.EXPECT().CreateTeam(ctx, gomock.Any()).Return(nil)
+		mockUserRepo.EXPECT().CreateUser(ctx, gomock.Any()).Return(nil)
+		mockTeamRepo.EXPECT().AddTeamMember(ctx, teamName, members[0]).Return(expectedErr)
+
+		team, err := teamSvc.CreateTeamWithMembers(ctx, teamName, members)
+
+		assert.Error(t, err)
+		assert.Nil(t, team)
+		assert.Contains(t, err.Error(), "failed to add user")
+	})
 }
 
 func TestTeamServiceImpl_GetTeamWithMembers(t *testing.T) {
@@ -128,6 +144,7 @@ func TestTeamServiceImpl_GetTeamWithMembers(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, team)
 		assert.Contains(t, err.Error(), "team not found")
+		assert.ErrorIs(t, err, ErrTeamNotFound)
 	})
 
 	t.Run("repository error", func(t *testing.T) {
@@ -141,3 +158,243 @@ func TestTeamServiceImpl_GetTeamWithMembers(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to get team with members")
 	})
 }
+
+func TestTeamServiceImpl_SetParentTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	teamSvc := NewTeamService(mockTeamRepo, mockUserRepo)
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		squad := &models.Team{TeamName: "squad"}
+		updated := &models.Team{TeamName: "squad", ParentTeam: "department"}
+
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "squad").Return(squad, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "department").Return(&models.Team{TeamName: "department", ParentTeam: "org"}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "org").Return(&models.Team{TeamName: "org"}, nil)
+		mockTeamRepo.EXPECT().SetTeamParent(ctx, "squad", "department").Return(nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "squad").Return(updated, nil)
+
+		team, err := teamSvc.SetParentTeam(ctx, "squad", "department")
+
+		require.NoError(t, err)
+		assert.Equal(t, "department", team.ParentTeam)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "org").Return(&models.Team{TeamName: "org"}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "squad").Return(&models.Team{TeamName: "squad", ParentTeam: "org"}, nil)
+
+		_, err := teamSvc.SetParentTeam(ctx, "org", "squad")
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("parent not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "squad").Return(&models.Team{TeamName: "squad"}, nil)
+		mockTeamRepo.EXPECT().GetTeamByName(ctx, "missing").Return(nil, nil)
+
+		_, err := teamSvc.SetParentTeam(ctx, "squad", "missing")
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
+
+func TestTeamServiceImpl_SetTeamPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	teamSvc := NewTeamService(mockTeamRepo, mockUserRepo)
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		policies := []string{models.PolicyJuniorNeedsSenior, models.PolicyMentorshipPairing}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockTeamRepo.EXPECT().SetTeamPolicies(ctx, "backend", policies).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(&models.Team{TeamName: "backend", Policies: policies}, nil)
+
+		team, err := teamSvc.SetTeamPolicies(ctx, "backend", []string{"mentorship_pairing", "junior_needs_senior"})
+
+		require.NoError(t, err)
+		assert.Equal(t, policies, team.Policies)
+	})
+
+	t.Run("unknown policy", func(t *testing.T) {
+		_, err := teamSvc.SetTeamPolicies(ctx, "backend", []string{"no_friday_merges"})
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "missing").Return(false, nil)
+
+		_, err := teamSvc.SetTeamPolicies(ctx, "missing", []string{})
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
+
+func TestTeamServiceImpl_SetRotationPenalty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	teamSvc := NewTeamService(mockTeamRepo, mockUserRepo)
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockTeamRepo.EXPECT().SetTeamRotationPenalty(ctx, "backend", 80).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(&models.Team{TeamName: "backend", RotationPenalty: 80}, nil)
+
+		team, err := teamSvc.SetRotationPenalty(ctx, "backend", 80)
+
+		require.NoError(t, err)
+		assert.Equal(t, 80, team.RotationPenalty)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		_, err := teamSvc.SetRotationPenalty(ctx, "backend", 101)
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "missing").Return(false, nil)
+
+		_, err := teamSvc.SetRotationPenalty(ctx, "missing", 0)
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
+
+func TestTeamServiceImpl_SetTeamSLA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	teamSvc := NewTeamService(mockTeamRepo, mockUserRepo)
+
+	ctx := context.Background()
+	hours := func(h int) *int { return &h }
+
+	t.Run("success", func(t *testing.T) {
+		sla := models.ReviewSLA{FirstResponseHours: hours(4), CompletionHours: hours(16)}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockTeamRepo.EXPECT().SetTeamSLA(ctx, "backend", sla).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(&models.Team{TeamName: "backend", SLA: sla}, nil)
+
+		team, err := teamSvc.SetTeamSLA(ctx, "backend", sla)
+
+		require.NoError(t, err)
+		assert.Equal(t, 16, *team.SLA.CompletionHours)
+	})
+
+	t.Run("invalid hours", func(t *testing.T) {
+		_, err := teamSvc.SetTeamSLA(ctx, "backend", models.ReviewSLA{FirstResponseHours: hours(0)})
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+
+		_, err = teamSvc.SetTeamSLA(ctx, "backend", models.ReviewSLA{FirstResponseHours: hours(8), CompletionHours: hours(4)})
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "missing").Return(false, nil)
+
+		_, err := teamSvc.SetTeamSLA(ctx, "missing", models.ReviewSLA{})
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
+
+func TestTeamServiceImpl_SetAutoReassign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	teamSvc := NewTeamService(mockTeamRepo, mockUserRepo)
+
+	ctx := context.Background()
+
+	t.Run("default limit", func(t *testing.T) {
+		settings := models.AutoReassignSettings{Enabled: true, MaxPerPR: models.DefaultAutoReassignMaxPerPR}
+
+		mockTeamRepo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+		mockTeamRepo.EXPECT().SetTeamAutoReassign(ctx, "backend", settings).Return(nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(&models.Team{TeamName: "backend", AutoReassign: settings}, nil)
+
+		team, err := teamSvc.SetAutoReassign(ctx, "backend", models.AutoReassignSettings{Enabled: true})
+
+		require.NoError(t, err)
+		assert.True(t, team.AutoReassign.Enabled)
+	})
+
+	t.Run("limit out of range", func(t *testing.T) {
+		_, err := teamSvc.SetAutoReassign(ctx, "backend", models.AutoReassignSettings{Enabled: true, MaxPerPR: 11})
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().TeamExists(ctx, "missing").Return(false, nil)
+
+		_, err := teamSvc.SetAutoReassign(ctx, "missing", models.AutoReassignSettings{})
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
+
+func TestTeamServiceImpl_GetTeamSubtree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTeamRepo := mocks.NewMockTeamRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	teamSvc := NewTeamService(mockTeamRepo, mockUserRepo)
+
+	ctx := context.Background()
+
+	t.Run("builds tree", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamSubtree(ctx, "org").Return([]*models.Team{
+			{TeamName: "backend", ParentTeam: "org"},
+			{TeamName: "org"},
+			{TeamName: "payments", ParentTeam: "backend"},
+		}, nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "backend").Return(&models.Team{TeamName: "backend"}, nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "org").Return(&models.Team{TeamName: "org"}, nil)
+		mockTeamRepo.EXPECT().GetTeamWithMembers(ctx, "payments").Return(&models.Team{
+			TeamName: "payments",
+			Members:  []models.TeamMember{{UserID: "u1"}},
+		}, nil)
+
+		root, err := teamSvc.GetTeamSubtree(ctx, "org")
+
+		require.NoError(t, err)
+		assert.Equal(t, "org", root.TeamName)
+		require.Len(t, root.Children, 1)
+		assert.Equal(t, "backend", root.Children[0].TeamName)
+		require.Len(t, root.Children[0].Children, 1)
+		assert.Equal(t, []models.TeamMember{{UserID: "u1"}}, root.Children[0].Children[0].Members)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockTeamRepo.EXPECT().GetTeamSubtree(ctx, "missing").Return(nil, nil)
+
+		_, err := teamSvc.GetTeamSubtree(ctx, "missing")
+
+		assert.ErrorIs(t, err, ErrTeamNotFound)
+	})
+}
@@ -2,13 +2,19 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"pr-reviewer-assignment-service/internal/models"
 	"pr-reviewer-assignment-service/internal/repository"
 )
 
+// maxBlockReasonLength ограничивает длину причины запрета пары.
+const maxBlockReasonLength = 500
+
 type UserServiceImpl struct {
 	userRepo repository.UserRepository
 }
@@ -25,7 +31,7 @@ func (s *UserServiceImpl) SetUserActiveStatus(ctx context.Context, userID string
 		return fmt.Errorf("failed to check user existence: %w", err)
 	}
 	if !exists {
-		return errors.New("user not found")
+		return ErrUserNotFound
 	}
 
 	err = s.userRepo.SetUserActiveStatus(ctx, userID, isActive)
@@ -42,7 +48,7 @@ func (s *UserServiceImpl) ValidateUserExists(ctx context.Context, userID string)
 		return fmt.Errorf("failed to check user existence: %w", err)
 	}
 	if !exists {
-		return errors.New("user not found")
+		return ErrUserNotFound
 	}
 	return nil
 }
@@ -53,8 +59,123 @@ func (s *UserServiceImpl) GetUserWithTeam(ctx context.Context, userID string) (*
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 	if user == nil {
-		return nil, errors.New("user not found")
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+// SetUserSkills заменяет теги навыков пользователя.
+func (s *UserServiceImpl) SetUserSkills(ctx context.Context, userID string, skills []string) (*models.User, error) {
+	normalized, err := normalizeSkills(skills)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.GetUserWithTeam(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.userRepo.SetUserSkills(ctx, userID, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user skills: %w", err)
 	}
 
+	user.Skills = normalized
 	return user, nil
 }
+
+// SetUserLevel задаёт уровень пользователя; пустой level сбрасывает его.
+func (s *UserServiceImpl) SetUserLevel(ctx context.Context, userID string, level string) (*models.User, error) {
+	normalized, err := normalizeLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.GetUserWithTeam(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.userRepo.SetUserLevel(ctx, userID, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user level: %w", err)
+	}
+
+	user.Level = normalized
+	return user, nil
+}
+
+// BlockPair запрещает назначать reviewer ревьювером PR автора. mutual запрещает и обратную
+// пару - например, для соавторов одного изменения. Возвращает запреты автора.
+func (s *UserServiceImpl) BlockPair(ctx context.Context, pair *models.BlockedPair, mutual bool) (*models.BlockedPairList, error) {
+	pair.Reason = strings.TrimSpace(pair.Reason)
+	if len(pair.Reason) > maxBlockReasonLength {
+		return nil, fmt.Errorf("%w: reason must be at most %d characters", ErrInvalidArgument, maxBlockReasonLength)
+	}
+	if pair.AuthorID == pair.ReviewerID {
+		return nil, fmt.Errorf("%w: author and reviewer must differ", ErrInvalidArgument)
+	}
+	if pair.ExpiresAt != nil && !pair.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: expires_at must be in the future", ErrInvalidArgument)
+	}
+
+	for _, userID := range []string{pair.AuthorID, pair.ReviewerID} {
+		if err := s.ValidateUserExists(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	pairs := []*models.BlockedPair{pair}
+	if mutual {
+		reverse := *pair
+		reverse.AuthorID, reverse.ReviewerID = pair.ReviewerID, pair.AuthorID
+		pairs = append(pairs, &reverse)
+	}
+	for _, p := range pairs {
+		if err := s.userRepo.CreateBlockedPair(ctx, p); err != nil {
+			return nil, fmt.Errorf("failed to block pair: %w", err)
+		}
+	}
+
+	return s.GetBlockedPairs(ctx, pair.AuthorID)
+}
+
+// UnblockPair снимает запрет пары; mutual снимает и обратную пару, если она есть.
+// Возвращает оставшиеся запреты автора.
+func (s *UserServiceImpl) UnblockPair(ctx context.Context, authorID, reviewerID string, mutual bool) (*models.BlockedPairList, error) {
+	err := s.userRepo.DeleteBlockedPair(ctx, authorID, reviewerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBlockedPairNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to unblock pair: %w", err)
+	}
+
+	if mutual {
+		err = s.userRepo.DeleteBlockedPair(ctx, reviewerID, authorID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to unblock pair: %w", err)
+		}
+	}
+
+	return s.GetBlockedPairs(ctx, authorID)
+}
+
+// GetBlockedPairs возвращает запреты, где пользователь - автор или ревьювер.
+func (s *UserServiceImpl) GetBlockedPairs(ctx context.Context, userID string) (*models.BlockedPairList, error) {
+	if err := s.ValidateUserExists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	pairs, err := s.userRepo.GetBlockedPairs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocked pairs: %w", err)
+	}
+	if pairs == nil {
+		pairs = []*models.BlockedPair{}
+	}
+
+	return &models.BlockedPairList{UserID: userID, BlockedPairs: pairs}, nil
+}
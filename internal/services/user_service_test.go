@@ -151,3 +151,57 @@ func TestUserServiceImpl_GetUserWithTeam(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to get user")
 	})
 }
+
+func TestUserServiceImpl_SetUserSkills(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	userSvc := NewUserService(mockRepo)
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1"}, nil)
+		mockRepo.EXPECT().SetUserSkills(ctx, "u1", []string{"go", "postgres"}).Return(nil)
+
+		user, err := userSvc.SetUserSkills(ctx, "u1", []string{"Postgres", "go", "go"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go", "postgres"}, user.Skills)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetUserByID(ctx, "missing").Return(nil, nil)
+
+		_, err := userSvc.SetUserSkills(ctx, "missing", []string{"go"})
+
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestUserServiceImpl_SetUserLevel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	userSvc := NewUserService(mockRepo)
+
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().GetUserByID(ctx, "u1").Return(&models.User{UserID: "u1"}, nil)
+		mockRepo.EXPECT().SetUserLevel(ctx, "u1", models.LevelSenior).Return(nil)
+
+		user, err := userSvc.SetUserLevel(ctx, "u1", "Senior")
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LevelSenior, user.Level)
+	})
+
+	t.Run("invalid level", func(t *testing.T) {
+		_, err := userSvc.SetUserLevel(ctx, "u1", "intern")
+
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
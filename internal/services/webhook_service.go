@@ -0,0 +1,237 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/repository"
+)
+
+// webhookBatchSize - сколько событий и доставок диспетчер берёт за один запрос к базе.
+const webhookBatchSize = 100
+
+// Заголовки запросов к подписчикам.
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// WebhookRetryPolicy задаёт повторы неудачных доставок: задержка перед попыткой n равна
+// Backoff * 2^(n-1), но не больше MaxBackoff. После MaxAttempts попыток доставка
+// переносится в недоставленные.
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// delay возвращает задержку перед повтором после attempts неудачных попыток.
+func (p WebhookRetryPolicy) delay(attempts int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < attempts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+type WebhookServiceImpl struct {
+	repo   repository.WebhookRepository
+	client *http.Client
+	policy WebhookRetryPolicy
+}
+
+func NewWebhookService(repo repository.WebhookRepository, client *http.Client, policy WebhookRetryPolicy) *WebhookServiceImpl {
+	return &WebhookServiceImpl{
+		repo:   repo,
+		client: client,
+		policy: policy,
+	}
+}
+
+// Subscribe регистрирует подписку. URL должен быть абсолютным http(s)-адресом, Secret -
+// непустым; пустой Events подписывает на все типы событий.
+func (s *WebhookServiceImpl) Subscribe(ctx context.Context, sub *models.WebhookSubscription) (*models.WebhookSubscription, error) {
+	sub.URL = strings.TrimSpace(sub.URL)
+	target, err := url.Parse(sub.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http(s) URL", ErrInvalidArgument)
+	}
+	if sub.Secret == "" {
+		return nil, fmt.Errorf("%w: secret is required", ErrInvalidArgument)
+	}
+
+	events := []string{}
+	for _, eventType := range sub.Events {
+		if !slices.Contains(models.EventTypes, eventType) {
+			return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidArgument, eventType)
+		}
+		if !slices.Contains(events, eventType) {
+			events = append(events, eventType)
+		}
+	}
+	sub.Events = events
+
+	err = s.repo.CreateSubscription(ctx, sub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+func (s *WebhookServiceImpl) ListSubscriptions(ctx context.Context) ([]*models.WebhookSubscription, error) {
+	subs, err := s.repo.GetSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscriptions: %w", err)
+	}
+	if subs == nil {
+		subs = []*models.WebhookSubscription{}
+	}
+
+	return subs, nil
+}
+
+// DeleteSubscription удаляет подписку вместе с её ожидающими и недоставленными доставками.
+func (s *WebhookServiceImpl) DeleteSubscription(ctx context.Context, id int64) error {
+	err := s.repo.DeleteSubscription(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrWebhookNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (s *WebhookServiceImpl) GetDeadLetters(ctx context.Context, subscriptionID int64) ([]*models.WebhookDeadLetter, error) {
+	letters, err := s.repo.GetDeadLetters(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead letters: %w", err)
+	}
+	if letters == nil {
+		letters = []*models.WebhookDeadLetter{}
+	}
+
+	return letters, nil
+}
+
+// ReplayDeadLetters ставит недоставленные события обратно в очередь с новым счётчиком попыток.
+// Пустой ids - все недоставленные события подписки, subscriptionID = 0 - всех подписок.
+func (s *WebhookServiceImpl) ReplayDeadLetters(ctx context.Context, subscriptionID int64, ids []int64) (int, error) {
+	replayed, err := s.repo.ReplayDeadLetters(ctx, subscriptionID, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to replay dead letters: %w", err)
+	}
+
+	return replayed, nil
+}
+
+// Dispatch раскладывает новые события outbox по подпискам и отправляет доставки, срок
+// которых наступил. Ответ 2xx подтверждает доставку, иначе она повторяется по политике
+// повторов. Событие доставляется хотя бы один раз: подписчик различает повторы по
+// заголовку X-Webhook-Delivery.
+func (s *WebhookServiceImpl) Dispatch(ctx context.Context) error {
+	for {
+		queued, err := s.repo.EnqueueDeliveries(ctx, webhookBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+		}
+		if queued < webhookBatchSize {
+			break
+		}
+	}
+
+	deliveries, err := s.repo.GetDueDeliveries(ctx, time.Now(), webhookBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := s.deliver(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver отправляет одну доставку и записывает её результат.
+func (s *WebhookServiceImpl) deliver(ctx context.Context, delivery *models.WebhookDelivery) error {
+	sendErr := s.send(ctx, delivery)
+	if sendErr == nil {
+		if err := s.repo.MarkDelivered(ctx, delivery.ID); err != nil {
+			return fmt.Errorf("failed to mark webhook delivered: %w", err)
+		}
+		return nil
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= s.policy.MaxAttempts {
+		if err := s.repo.MoveToDeadLetter(ctx, delivery.ID, attempts, sendErr.Error()); err != nil {
+			return fmt.Errorf("failed to move webhook to dead letters: %w", err)
+		}
+		return nil
+	}
+
+	nextAttemptAt := time.Now().Add(s.policy.delay(attempts))
+	if err := s.repo.ScheduleRetry(ctx, delivery.ID, attempts, nextAttemptAt, sendErr.Error()); err != nil {
+		return fmt.Errorf("failed to schedule webhook retry: %w", err)
+	}
+	return nil
+}
+
+func (s *WebhookServiceImpl) send(ctx context.Context, delivery *models.WebhookDelivery) error {
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.Event.Type)
+	req.Header.Set(WebhookDeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(delivery.Secret, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhookPayload возвращает значение заголовка X-Webhook-Signature: HMAC-SHA256 тела
+// запроса на секрете подписки в виде "sha256=<hex>".
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
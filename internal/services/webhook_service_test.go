@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-reviewer-assignment-service/internal/mocks"
+	"pr-reviewer-assignment-service/internal/models"
+)
+
+func TestWebhookRetryPolicy_Delay(t *testing.T) {
+	policy := WebhookRetryPolicy{MaxAttempts: 8, Backoff: 30 * time.Second, MaxBackoff: 5 * time.Minute}
+
+	assert.Equal(t, 30*time.Second, policy.delay(1))
+	assert.Equal(t, 60*time.Second, policy.delay(2))
+	assert.Equal(t, 4*time.Minute, policy.delay(4))
+	assert.Equal(t, 5*time.Minute, policy.delay(5))
+	assert.Equal(t, 5*time.Minute, policy.delay(50))
+}
+
+func TestWebhookServiceImpl_Subscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	svc := NewWebhookService(mockRepo, http.DefaultClient, WebhookRetryPolicy{MaxAttempts: 3})
+	ctx := context.Background()
+
+	t.Run("deduplicates event types", func(t *testing.T) {
+		mockRepo.EXPECT().CreateSubscription(ctx, gomock.Any()).Return(nil)
+
+		sub, err := svc.Subscribe(ctx, &models.WebhookSubscription{
+			URL:    " https://hooks.example.com/pr ",
+			Events: []string{models.EventPullRequestMerged, models.EventPullRequestMerged},
+			Secret: "secret",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "https://hooks.example.com/pr", sub.URL)
+		assert.Equal(t, []string{models.EventPullRequestMerged}, sub.Events)
+	})
+
+	for name, sub := range map[string]*models.WebhookSubscription{
+		"relative url":       {URL: "/hooks", Secret: "secret"},
+		"unsupported scheme": {URL: "ftp://hooks.example.com", Secret: "secret"},
+		"missing secret":     {URL: "https://hooks.example.com"},
+		"unknown event":      {URL: "https://hooks.example.com", Secret: "secret", Events: []string{"pr.closed"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.Subscribe(ctx, sub)
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		})
+	}
+
+	t.Run("delete unknown subscription", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteSubscription(ctx, int64(42)).Return(sql.ErrNoRows)
+
+		assert.ErrorIs(t, svc.DeleteSubscription(ctx, 42), ErrWebhookNotFound)
+	})
+}
+
+func TestWebhookServiceImpl_Dispatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	ctx := context.Background()
+	policy := WebhookRetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: time.Hour}
+
+	status := http.StatusOK
+	var gotHeaders http.Header
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	svc := NewWebhookService(mockRepo, server.Client(), policy)
+
+	delivery := func(attempts int) *models.WebhookDelivery {
+		return &models.WebhookDelivery{
+			ID:             7,
+			SubscriptionID: 1,
+			URL:            server.URL,
+			Secret:         "secret",
+			Attempts:       attempts,
+			Event: models.Event{
+				ID:   3,
+				Type: models.EventReviewersAssigned,
+				Data: models.EventData{Repository: "default", PullRequestID: "pr-1", Reviewers: []string{"u2"}},
+			},
+		}
+	}
+
+	t.Run("signs and delivers pending events", func(t *testing.T) {
+		status = http.StatusNoContent
+		gomock.InOrder(
+			mockRepo.EXPECT().EnqueueDeliveries(ctx, webhookBatchSize).Return(webhookBatchSize, nil),
+			mockRepo.EXPECT().EnqueueDeliveries(ctx, webhookBatchSize).Return(1, nil),
+			mockRepo.EXPECT().GetDueDeliveries(ctx, gomock.Any(), webhookBatchSize).Return([]*models.WebhookDelivery{delivery(0)}, nil),
+			mockRepo.EXPECT().MarkDelivered(ctx, int64(7)).Return(nil),
+		)
+
+		require.NoError(t, svc.Dispatch(ctx))
+
+		assert.Equal(t, models.EventReviewersAssigned, gotHeaders.Get(WebhookEventHeader))
+		assert.Equal(t, "7", gotHeaders.Get(WebhookDeliveryHeader))
+		assert.Equal(t, SignWebhookPayload("secret", gotBody), gotHeaders.Get(WebhookSignatureHeader))
+
+		var event models.Event
+		require.NoError(t, json.Unmarshal(gotBody, &event))
+		assert.Equal(t, "pr-1", event.Data.PullRequestID)
+	})
+
+	t.Run("failed delivery is retried with backoff", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		mockRepo.EXPECT().EnqueueDeliveries(ctx, webhookBatchSize).Return(0, nil)
+		mockRepo.EXPECT().GetDueDeliveries(ctx, gomock.Any(), webhookBatchSize).Return([]*models.WebhookDelivery{delivery(1)}, nil)
+
+		before := time.Now()
+		mockRepo.EXPECT().ScheduleRetry(ctx, int64(7), 2, gomock.Any(), "unexpected status 500").
+			DoAndReturn(func(_ context.Context, _ int64, _ int, nextAttemptAt time.Time, _ string) error {
+				assert.WithinRange(t, nextAttemptAt, before.Add(2*time.Minute), time.Now().Add(2*time.Minute))
+				return nil
+			})
+
+		require.NoError(t, svc.Dispatch(ctx))
+	})
+
+	t.Run("exhausted delivery goes to dead letters", func(t *testing.T) {
+		status = http.StatusBadGateway
+		mockRepo.EXPECT().EnqueueDeliveries(ctx, webhookBatchSize).Return(0, nil)
+		mockRepo.EXPECT().GetDueDeliveries(ctx, gomock.Any(), webhookBatchSize).Return([]*models.WebhookDelivery{delivery(2)}, nil)
+		mockRepo.EXPECT().MoveToDeadLetter(ctx, int64(7), 3, "unexpected status 502").Return(nil)
+
+		require.NoError(t, svc.Dispatch(ctx))
+	})
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	// Контрольное значение: echo -n '{"a":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t,
+		"sha256=aa9e2e3575f5d7098b6caccd790888c36d5fdb63342a73bada2d6a51747a8494",
+		SignWebhookPayload("secret", []byte(`{"a":1}`)),
+	)
+}
@@ -0,0 +1 @@
+ALTER TABLE teams ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE user_teams (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE ON UPDATE CASCADE,
+    role VARCHAR(100) NULL,
+    weight INTEGER NOT NULL DEFAULT 1 CHECK (weight > 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, team_name)
+);
+
+CREATE INDEX idx_user_teams_team_name ON user_teams(team_name);
+
+-- users.team_name остаётся основной командой пользователя; переносим её в членства.
+INSERT INTO user_teams (user_id, team_name, created_at)
+SELECT user_id, team_name, created_at
+FROM users
+WHERE team_name IS NOT NULL;
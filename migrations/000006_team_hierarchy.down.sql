@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_teams_parent_team;
+ALTER TABLE teams DROP COLUMN IF EXISTS parent_team;
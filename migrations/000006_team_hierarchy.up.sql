@@ -0,0 +1,5 @@
+ALTER TABLE teams
+    ADD COLUMN parent_team VARCHAR(255) NULL REFERENCES teams(team_name) ON DELETE SET NULL ON UPDATE CASCADE,
+    ADD CONSTRAINT teams_parent_not_self CHECK (parent_team <> team_name);
+
+CREATE INDEX idx_teams_parent_team ON teams(parent_team);
@@ -0,0 +1,3 @@
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS skill_match;
+DROP TABLE IF EXISTS pr_required_skills;
+DROP TABLE IF EXISTS user_skills;
@@ -0,0 +1,16 @@
+CREATE TABLE user_skills (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    skill VARCHAR(100) NOT NULL,
+    PRIMARY KEY (user_id, skill)
+);
+
+CREATE INDEX idx_user_skills_skill ON user_skills(skill);
+
+CREATE TABLE pr_required_skills (
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    skill VARCHAR(100) NOT NULL,
+    PRIMARY KEY (pull_request_id, skill)
+);
+
+ALTER TABLE pull_requests
+    ADD COLUMN skill_match VARCHAR(16) NOT NULL DEFAULT 'prefer' CHECK (skill_match IN ('prefer', 'require'));
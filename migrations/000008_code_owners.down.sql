@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS pr_changed_files;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS repository;
+DROP TABLE IF EXISTS code_owners_files;
@@ -0,0 +1,15 @@
+CREATE TABLE code_owners_files (
+    repository VARCHAR(255) NOT NULL,
+    version INTEGER NOT NULL CHECK (version > 0),
+    content TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (repository, version)
+);
+
+ALTER TABLE pull_requests ADD COLUMN repository VARCHAR(255);
+
+CREATE TABLE pr_changed_files (
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    path TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, path)
+);
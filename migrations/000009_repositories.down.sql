@@ -0,0 +1,30 @@
+-- Откат возможен, только если ID PR не повторяются в разных репозиториях.
+ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_repository_pull_request_id_fkey, DROP CONSTRAINT pr_reviewers_pkey;
+ALTER TABLE pr_required_skills DROP CONSTRAINT pr_required_skills_repository_pull_request_id_fkey, DROP CONSTRAINT pr_required_skills_pkey;
+ALTER TABLE pr_changed_files DROP CONSTRAINT pr_changed_files_repository_pull_request_id_fkey, DROP CONSTRAINT pr_changed_files_pkey;
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT pull_requests_repository_fkey,
+    DROP CONSTRAINT pull_requests_pkey,
+    ADD PRIMARY KEY (pull_request_id),
+    ALTER COLUMN repository DROP NOT NULL,
+    ALTER COLUMN repository DROP DEFAULT;
+UPDATE pull_requests SET repository = NULL WHERE repository = 'default';
+
+ALTER TABLE pr_reviewers
+    DROP COLUMN repository,
+    ADD PRIMARY KEY (pull_request_id, user_id),
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE;
+ALTER TABLE pr_required_skills
+    DROP COLUMN repository,
+    ADD PRIMARY KEY (pull_request_id, skill),
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE;
+ALTER TABLE pr_changed_files
+    DROP COLUMN repository,
+    ADD PRIMARY KEY (pull_request_id, path),
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE;
+
+ALTER TABLE code_owners_files DROP CONSTRAINT code_owners_files_repository_fkey;
+
+DROP TABLE IF EXISTS repository_teams;
+DROP TABLE IF EXISTS repositories;
@@ -0,0 +1,68 @@
+CREATE TABLE repositories (
+    repository VARCHAR(255) PRIMARY KEY,
+    owner_team VARCHAR(255) REFERENCES teams(team_name) ON DELETE SET NULL,
+    reviewer_count INTEGER CHECK (reviewer_count BETWEEN 0 AND 10),
+    strategy VARCHAR(32) CHECK (strategy IN ('random', 'least_loaded')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Команды, из которых назначаются ревьюверы PR репозитория; пусто - команда автора.
+CREATE TABLE repository_teams (
+    repository VARCHAR(255) NOT NULL REFERENCES repositories(repository) ON DELETE CASCADE,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    PRIMARY KEY (repository, team_name)
+);
+
+-- PR без репозитория относятся к репозиторию default.
+INSERT INTO repositories (repository) VALUES ('default');
+INSERT INTO repositories (repository)
+SELECT repository FROM pull_requests WHERE repository IS NOT NULL
+UNION
+SELECT repository FROM code_owners_files
+ON CONFLICT (repository) DO NOTHING;
+
+UPDATE pull_requests SET repository = 'default' WHERE repository IS NULL;
+
+ALTER TABLE code_owners_files
+    ADD CONSTRAINT code_owners_files_repository_fkey
+    FOREIGN KEY (repository) REFERENCES repositories(repository) ON DELETE CASCADE;
+
+-- ID PR уникален в пределах репозитория: ключ PR и ссылки на него становятся составными.
+ALTER TABLE pr_reviewers ADD COLUMN repository VARCHAR(255);
+ALTER TABLE pr_required_skills ADD COLUMN repository VARCHAR(255);
+ALTER TABLE pr_changed_files ADD COLUMN repository VARCHAR(255);
+
+UPDATE pr_reviewers t SET repository = pr.repository FROM pull_requests pr WHERE pr.pull_request_id = t.pull_request_id;
+UPDATE pr_required_skills t SET repository = pr.repository FROM pull_requests pr WHERE pr.pull_request_id = t.pull_request_id;
+UPDATE pr_changed_files t SET repository = pr.repository FROM pull_requests pr WHERE pr.pull_request_id = t.pull_request_id;
+
+ALTER TABLE pr_reviewers
+    DROP CONSTRAINT pr_reviewers_pull_request_id_fkey,
+    DROP CONSTRAINT pr_reviewers_pkey,
+    ALTER COLUMN repository SET NOT NULL;
+ALTER TABLE pr_required_skills
+    DROP CONSTRAINT pr_required_skills_pull_request_id_fkey,
+    DROP CONSTRAINT pr_required_skills_pkey,
+    ALTER COLUMN repository SET NOT NULL;
+ALTER TABLE pr_changed_files
+    DROP CONSTRAINT pr_changed_files_pull_request_id_fkey,
+    DROP CONSTRAINT pr_changed_files_pkey,
+    ALTER COLUMN repository SET NOT NULL;
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT pull_requests_pkey,
+    ALTER COLUMN repository SET DEFAULT 'default',
+    ALTER COLUMN repository SET NOT NULL,
+    ADD PRIMARY KEY (repository, pull_request_id),
+    ADD CONSTRAINT pull_requests_repository_fkey FOREIGN KEY (repository) REFERENCES repositories(repository);
+
+ALTER TABLE pr_reviewers
+    ADD PRIMARY KEY (repository, pull_request_id, user_id),
+    ADD FOREIGN KEY (repository, pull_request_id) REFERENCES pull_requests(repository, pull_request_id) ON DELETE CASCADE;
+ALTER TABLE pr_required_skills
+    ADD PRIMARY KEY (repository, pull_request_id, skill),
+    ADD FOREIGN KEY (repository, pull_request_id) REFERENCES pull_requests(repository, pull_request_id) ON DELETE CASCADE;
+ALTER TABLE pr_changed_files
+    ADD PRIMARY KEY (repository, pull_request_id, path),
+    ADD FOREIGN KEY (repository, pull_request_id) REFERENCES pull_requests(repository, pull_request_id) ON DELETE CASCADE;
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS repository_rules;
+DROP TABLE IF EXISTS pr_labels;
+
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS priority,
+    DROP COLUMN IF EXISTS files_changed,
+    DROP COLUMN IF EXISTS lines_removed,
+    DROP COLUMN IF EXISTS lines_added,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS url;
@@ -0,0 +1,29 @@
+ALTER TABLE pull_requests
+    ADD COLUMN url TEXT,
+    ADD COLUMN description TEXT,
+    ADD COLUMN lines_added INTEGER NOT NULL DEFAULT 0 CHECK (lines_added >= 0),
+    ADD COLUMN lines_removed INTEGER NOT NULL DEFAULT 0 CHECK (lines_removed >= 0),
+    ADD COLUMN files_changed INTEGER NOT NULL DEFAULT 0 CHECK (files_changed >= 0),
+    ADD COLUMN priority VARCHAR(16) NOT NULL DEFAULT 'normal' CHECK (priority IN ('low', 'normal', 'high', 'critical'));
+
+CREATE TABLE pr_labels (
+    repository VARCHAR(255) NOT NULL,
+    pull_request_id VARCHAR(255) NOT NULL,
+    label VARCHAR(100) NOT NULL,
+    PRIMARY KEY (repository, pull_request_id, label),
+    FOREIGN KEY (repository, pull_request_id) REFERENCES pull_requests(repository, pull_request_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_pr_labels_label ON pr_labels(label);
+
+-- Правила репозитория, добавляющие ревьюверов PR по меткам, приоритету и размеру.
+CREATE TABLE repository_rules (
+    repository VARCHAR(255) NOT NULL REFERENCES repositories(repository) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    label VARCHAR(100),
+    priority VARCHAR(16) CHECK (priority IN ('low', 'normal', 'high', 'critical')),
+    min_changed_lines INTEGER CHECK (min_changed_lines > 0),
+    extra_reviewers INTEGER NOT NULL CHECK (extra_reviewers BETWEEN 1 AND 10),
+    PRIMARY KEY (repository, position),
+    CHECK (label IS NOT NULL OR priority IS NOT NULL OR min_changed_lines IS NOT NULL)
+);
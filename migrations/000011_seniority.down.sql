@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS team_policies;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS level;
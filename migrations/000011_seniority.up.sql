@@ -0,0 +1,9 @@
+ALTER TABLE users
+    ADD COLUMN level VARCHAR(16) CHECK (level IN ('junior', 'mid', 'senior', 'lead'));
+
+-- Политики наставничества команды, ограничивающие состав ревьюверов PR её участников.
+CREATE TABLE team_policies (
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    policy VARCHAR(32) NOT NULL CHECK (policy IN ('junior_needs_senior', 'mentorship_pairing')),
+    PRIMARY KEY (team_name, policy)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS pr_excluded_reviewers;
+DROP TABLE IF EXISTS blocked_pairs;
@@ -0,0 +1,21 @@
+-- Запрещённые пары автор → ревьювер (конфликт интересов). Без expires_at запрет бессрочный.
+CREATE TABLE blocked_pairs (
+    author_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reason TEXT,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (author_id, reviewer_id),
+    CHECK (author_id <> reviewer_id)
+);
+
+CREATE INDEX idx_blocked_pairs_reviewer_id ON blocked_pairs(reviewer_id);
+
+-- Пользователи, исключённые из ревьюверов конкретного PR.
+CREATE TABLE pr_excluded_reviewers (
+    repository VARCHAR(255) NOT NULL,
+    pull_request_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (repository, pull_request_id, user_id),
+    FOREIGN KEY (repository, pull_request_id) REFERENCES pull_requests(repository, pull_request_id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_pull_requests_author_created_at;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS rotation_penalty;
@@ -0,0 +1,5 @@
+-- Сила штрафа (0-100) за повторное назначение недавних ревьюверов автора из команды.
+ALTER TABLE teams
+    ADD COLUMN rotation_penalty INTEGER NOT NULL DEFAULT 50 CHECK (rotation_penalty BETWEEN 0 AND 100);
+
+CREATE INDEX idx_pull_requests_author_created_at ON pull_requests(author_id, created_at DESC);
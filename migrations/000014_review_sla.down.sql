@@ -0,0 +1,6 @@
+ALTER TABLE pr_reviewers
+    DROP COLUMN IF EXISTS responded_at;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS sla_completion_hours,
+    DROP COLUMN IF EXISTS sla_first_response_hours;
@@ -0,0 +1,8 @@
+-- SLA ревью PR авторов команды в рабочих часах; NULL - срок не задан.
+ALTER TABLE teams
+    ADD COLUMN sla_first_response_hours INTEGER CHECK (sla_first_response_hours > 0),
+    ADD COLUMN sla_completion_hours INTEGER CHECK (sla_completion_hours > 0);
+
+-- Время первого ответа ревьювера на PR.
+ALTER TABLE pr_reviewers
+    ADD COLUMN responded_at TIMESTAMP WITH TIME ZONE;
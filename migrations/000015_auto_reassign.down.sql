@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS auto_reassignments;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS auto_reassign_max_per_pr,
+    DROP COLUMN IF EXISTS auto_reassign_enabled;
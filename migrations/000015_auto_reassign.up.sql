@@ -0,0 +1,19 @@
+-- Автоматическое переназначение просроченных по SLA ревью PR авторов команды.
+ALTER TABLE teams
+    ADD COLUMN auto_reassign_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN auto_reassign_max_per_pr INTEGER NOT NULL DEFAULT 1 CHECK (auto_reassign_max_per_pr BETWEEN 1 AND 10);
+
+-- Аудит автоматических переназначений; число записей PR ограничивает повторные переназначения.
+CREATE TABLE auto_reassignments (
+    id BIGSERIAL PRIMARY KEY,
+    repository VARCHAR(255) NOT NULL,
+    pull_request_id VARCHAR(255) NOT NULL,
+    old_reviewer_id VARCHAR(255) NOT NULL,
+    new_reviewer_id VARCHAR(255) NOT NULL,
+    team_name VARCHAR(255) NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (repository, pull_request_id) REFERENCES pull_requests(repository, pull_request_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_auto_reassignments_pull_request ON auto_reassignments(repository, pull_request_id, created_at);
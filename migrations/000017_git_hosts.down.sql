@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS git_identities;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS draft;
@@ -0,0 +1,13 @@
+-- Черновик PR: ревьюверы назначаются, когда PR готов к ревью.
+ALTER TABLE pull_requests ADD COLUMN draft BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Соответствие логинов GitHub/GitLab пользователям сервиса; логины хранятся в нижнем регистре.
+CREATE TABLE git_identities (
+    provider VARCHAR(16) NOT NULL CHECK (provider IN ('github', 'gitlab')),
+    username VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (provider, username)
+);
+
+CREATE INDEX idx_git_identities_user ON git_identities(user_id);
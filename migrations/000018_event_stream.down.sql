@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_events_xid;
+
+ALTER TABLE events DROP COLUMN IF EXISTS xid;
+
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS verdict;
@@ -0,0 +1,10 @@
+-- Последний вердикт ревьювера по PR; NULL - ревьювер ответил без вердикта.
+ALTER TABLE pr_reviewers
+    ADD COLUMN verdict VARCHAR(32) CHECK (verdict IN ('approved', 'changes_requested', 'commented'));
+
+-- Транзакция, записавшая событие. ID выдаются до фиксации, поэтому поток событий читает их
+-- в порядке (xid, id) и только из завершённых транзакций: иначе событие транзакции, которая
+-- фиксируется позже соседней, оказалось бы позади курсора клиента.
+ALTER TABLE events ADD COLUMN xid xid8 NOT NULL DEFAULT pg_current_xact_id();
+
+CREATE INDEX idx_events_xid ON events(xid, id);
@@ -0,0 +1,5 @@
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS reminded_at;
+
+DROP TABLE IF EXISTS event_cursors;
+DROP TABLE IF EXISTS notification_digest_items;
+DROP TABLE IF EXISTS notification_preferences;
@@ -0,0 +1,30 @@
+-- Настройки уведомлений пользователя; без строки уведомления сразу пишутся в лог сервиса.
+CREATE TABLE notification_preferences (
+    user_id VARCHAR(255) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    mode VARCHAR(16) NOT NULL CHECK (mode IN ('immediate', 'digest')),
+    channel VARCHAR(16) NOT NULL CHECK (channel IN ('email', 'chat', 'log')),
+    email VARCHAR(255),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Уведомления, отложенные до дайджеста; отправленные удаляются.
+CREATE TABLE notification_digest_items (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_notification_digest_items_user ON notification_digest_items(user_id, id);
+
+-- Позиции потребителей журнала событий: последнее обработанное событие.
+CREATE TABLE event_cursors (
+    name VARCHAR(64) PRIMARY KEY,
+    last_event_id BIGINT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Время последнего напоминания ревьюверу о неотвеченном PR.
+ALTER TABLE pr_reviewers ADD COLUMN reminded_at TIMESTAMP WITH TIME ZONE;
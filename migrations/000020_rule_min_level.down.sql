@@ -0,0 +1,7 @@
+DELETE FROM repository_rules WHERE extra_reviewers = 0;
+
+ALTER TABLE repository_rules
+    DROP CONSTRAINT IF EXISTS repository_rules_effect_check,
+    DROP CONSTRAINT IF EXISTS repository_rules_extra_reviewers_check,
+    ADD CONSTRAINT repository_rules_extra_reviewers_check CHECK (extra_reviewers BETWEEN 1 AND 10),
+    DROP COLUMN IF EXISTS min_level;
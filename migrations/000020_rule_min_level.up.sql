@@ -0,0 +1,7 @@
+-- Правило может требовать ревьювера не ниже уровня min_level - вместе с дополнительными
+-- ревьюверами или вместо них.
+ALTER TABLE repository_rules
+    ADD COLUMN min_level VARCHAR(16) CHECK (min_level IN ('junior', 'mid', 'senior', 'lead')),
+    DROP CONSTRAINT repository_rules_extra_reviewers_check,
+    ADD CONSTRAINT repository_rules_extra_reviewers_check CHECK (extra_reviewers BETWEEN 0 AND 10),
+    ADD CONSTRAINT repository_rules_effect_check CHECK (extra_reviewers > 0 OR min_level IS NOT NULL);
@@ -1,15 +1,18 @@
 package e2e
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,12 +29,21 @@ import (
 
 	"pr-reviewer-assignment-service/internal/config"
 	"pr-reviewer-assignment-service/internal/database"
+	"pr-reviewer-assignment-service/internal/graphqlapi"
 	"pr-reviewer-assignment-service/internal/handlers"
 	"pr-reviewer-assignment-service/internal/middleware"
+	"pr-reviewer-assignment-service/internal/models"
+	"pr-reviewer-assignment-service/internal/notify"
 	"pr-reviewer-assignment-service/internal/repository"
 	"pr-reviewer-assignment-service/internal/services"
 )
 
+// Секреты входящих вебхуков Git-хостингов в тестовом роутере.
+const (
+	e2eGitHubSecret = "e2e-github-secret"
+	e2eGitLabToken  = "e2e-gitlab-token"
+)
+
 var (
 	e2eDBContainer testcontainers.Container
 	e2eDBPool      *pgxpool.Pool
@@ -147,13 +159,32 @@ func setupTestRouter(dbPool *pgxpool.Pool) *gin.Engine {
 	userRepo := repository.NewPostgresUserRepository(dbPool)
 	teamRepo := repository.NewPostgresTeamRepository(dbPool)
 	prRepo := repository.NewPostgresPullRequestRepository(dbPool)
+	repoRepo := repository.NewPostgresRepoRepository(dbPool)
+	codeOwnersRepo := repository.NewPostgresCodeOwnersRepository(dbPool)
+	idempotencyRepo := repository.NewPostgresIdempotencyRepository(dbPool)
+	eventRepo := repository.NewPostgresEventRepository(dbPool)
+	webhookRepo := repository.NewPostgresWebhookRepository(dbPool)
+	gitIdentityRepo := repository.NewPostgresGitIdentityRepository(dbPool)
 
 	userSvc := services.NewUserService(userRepo)
 	teamSvc := services.NewTeamService(teamRepo, userRepo)
-	prSvc := services.NewPullRequestService(prRepo, userRepo, teamRepo, userSvc)
+	prSvc := services.NewPullRequestService(prRepo, userRepo, teamRepo, repoRepo, codeOwnersRepo, userSvc)
+	transactor := repository.NewPostgresTransactor(dbPool)
+	prSvc.SetOutbox(transactor, eventRepo)
 	statSvc := services.NewStatisticService(prRepo, teamRepo, userRepo)
+	membershipSvc := services.NewMembershipService(teamRepo, userRepo, prRepo, prSvc, transactor)
+	codeOwnersSvc := services.NewCodeOwnersService(codeOwnersRepo, repoRepo, userRepo, teamRepo)
+	repoSvc := services.NewRepoService(repoRepo, teamRepo)
+	slaSvc := services.NewReviewSLAService(prRepo, teamRepo, userRepo, services.DefaultBusinessHours())
+	autoReassignSvc := services.NewAutoReassignService(prRepo, teamRepo, prSvc, services.DefaultBusinessHours())
+	webhookSvc := services.NewWebhookService(webhookRepo, http.DefaultClient, services.WebhookRetryPolicy{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: time.Minute})
+	gitHostSvc := services.NewGitHostService(prSvc, gitIdentityRepo, userRepo, services.GitHostSecrets{GitHub: e2eGitHubSecret, GitLab: e2eGitLabToken})
+	eventStreamSvc := services.NewEventStreamService(eventRepo, teamRepo, userRepo, 100*time.Millisecond)
+	notificationSvc := services.NewNotificationService(eventRepo, repository.NewPostgresNotificationRepository(dbPool), prRepo, userRepo,
+		map[string]notify.Notifier{notify.ChannelLog: notify.NewLogNotifier(nil)}, 24*time.Hour)
+	reviewDigestSvc := services.NewReviewDigestService(prRepo, teamRepo, userRepo, statSvc, slaSvc, notificationSvc, services.DefaultBusinessHours())
 
-	handler := handlers.NewHandler(teamSvc, userSvc, prSvc, statSvc)
+	handler := handlers.NewHandler(teamSvc, userSvc, prSvc, statSvc, membershipSvc, codeOwnersSvc, repoSvc, slaSvc, autoReassignSvc, webhookSvc, gitHostSvc, eventStreamSvc, notificationSvc, reviewDigestSvc)
 	healthHandler := handlers.NewHealthHandler(userRepo)
 
 	gin.SetMode(gin.TestMode)
@@ -164,27 +195,105 @@ func setupTestRouter(dbPool *pgxpool.Pool) *gin.Engine {
 
 	r.GET("/health", healthHandler.Health)
 
+	// Git-хостинги аутентифицируются подписью запроса, а не токеном API.
+	integrations := r.Group("/integrations")
+	{
+		integrations.POST("/github", handler.ReceiveGitHubWebhook)
+		integrations.POST("/gitlab", handler.ReceiveGitLabWebhook)
+	}
+
 	api := r.Group("/api")
 	api.Use(middleware.AuthMiddleware("admin-token", "user-token"))
+	api.Use(middleware.IdempotencyMiddleware(idempotencyRepo, time.Hour))
 	{
 		team := api.Group("/team")
 		{
 			team.POST("/add", handler.CreateTeam)
 			team.GET("/get", handler.GetTeam)
+			team.GET("/subtree", handler.GetTeamSubtree)
+			team.POST("/setParent", middleware.AdminOnlyMiddleware(), handler.SetParentTeam)
+			team.POST("/setPolicies", middleware.AdminOnlyMiddleware(), handler.SetTeamPolicies)
+			team.POST("/setRotationPenalty", middleware.AdminOnlyMiddleware(), handler.SetTeamRotationPenalty)
+			team.POST("/setSLA", middleware.AdminOnlyMiddleware(), handler.SetTeamSLA)
+			team.GET("/overdueReviews", handler.GetTeamOverdueReviews)
+			team.POST("/setAutoReassign", middleware.AdminOnlyMiddleware(), handler.SetTeamAutoReassign)
+			team.POST("/addMembers", middleware.AdminOnlyMiddleware(), handler.AddTeamMembers)
+			team.POST("/removeMembers", middleware.AdminOnlyMiddleware(), handler.RemoveTeamMembers)
+			team.POST("/transferMember", middleware.AdminOnlyMiddleware(), handler.TransferTeamMember)
+			team.POST("/archive", middleware.AdminOnlyMiddleware(), handler.ArchiveTeam)
+			team.POST("/restore", middleware.AdminOnlyMiddleware(), handler.RestoreTeam)
+			team.POST("/delete", middleware.AdminOnlyMiddleware(), handler.DeleteTeam)
 		}
 
 		user := api.Group("/users")
 		{
 			user.POST("/setIsActive", middleware.AdminOnlyMiddleware(), handler.SetUserActive)
+			user.POST("/setSkills", middleware.AdminOnlyMiddleware(), handler.SetUserSkills)
+			user.POST("/setLevel", middleware.AdminOnlyMiddleware(), handler.SetUserLevel)
+			user.POST("/blockPair", middleware.AdminOnlyMiddleware(), handler.BlockPair)
+			user.POST("/unblockPair", middleware.AdminOnlyMiddleware(), handler.UnblockPair)
+			user.GET("/blockedPairs", handler.GetBlockedPairs)
 			user.GET("/getReview", handler.GetUserReviews)
+			user.GET("/overdueReviews", handler.GetUserOverdueReviews)
+			user.POST("/setNotificationPreferences", middleware.AdminOnlyMiddleware(), handler.SetNotificationPreferences)
+			user.GET("/notificationPreferences", handler.GetNotificationPreferences)
+			user.GET("/reviewDigest", handler.GetReviewDigest)
 		}
 
 		pr := api.Group("/pullRequest")
 		{
 			pr.POST("/create", handler.CreatePullRequest)
+			pr.POST("/dryRun", handler.DryRunPullRequest)
 			pr.POST("/merge", handler.MergePullRequest)
+			pr.POST("/ready", handler.MarkReadyForReview)
 			pr.POST("/reassign", handler.ReassignReviewer)
+			pr.POST("/respond", handler.RecordReviewResponse)
+			pr.GET("/get", handler.GetPullRequest)
+			pr.GET("/list", handler.ListPullRequests)
+			pr.GET("/autoReassignments", handler.GetAutoReassignments)
+		}
+
+		codeOwners := api.Group("/codeOwners")
+		{
+			codeOwners.POST("/upload", middleware.AdminOnlyMiddleware(), handler.UploadCodeOwners)
+			codeOwners.GET("/get", handler.GetCodeOwners)
+			codeOwners.GET("/validate", handler.ValidateCodeOwners)
+		}
+
+		repo := api.Group("/repository")
+		{
+			repo.POST("/add", middleware.AdminOnlyMiddleware(), handler.CreateRepo)
+			repo.GET("/get", handler.GetRepo)
+			repo.POST("/update", middleware.AdminOnlyMiddleware(), handler.UpdateRepo)
+		}
+
+		webhooks := api.Group("/webhooks", middleware.AdminOnlyMiddleware())
+		{
+			webhooks.POST("/subscribe", handler.SubscribeWebhook)
+			webhooks.GET("/list", handler.ListWebhooks)
+			webhooks.POST("/delete", handler.DeleteWebhook)
+			webhooks.GET("/deadLetters", handler.GetWebhookDeadLetters)
+			webhooks.POST("/replay", handler.ReplayWebhooks)
 		}
+
+		gitUsers := api.Group("/gitUsers", middleware.AdminOnlyMiddleware())
+		{
+			gitUsers.POST("/map", handler.MapGitUser)
+			gitUsers.POST("/unmap", handler.UnmapGitUser)
+			gitUsers.GET("/list", handler.GetGitUsers)
+		}
+
+		events := api.Group("/events")
+		{
+			events.GET("/stream", handler.StreamEvents)
+		}
+
+		stats := api.Group("/stats")
+		{
+			stats.GET("/fairness", handler.GetFairnessReport)
+		}
+
+		api.POST("/graphql", gin.WrapH(graphqlapi.NewHandler(teamSvc, userSvc, slaSvc, statSvc)))
 	}
 
 	return r
@@ -237,8 +346,9 @@ func TestE2E_FullAPIWorkflow(t *testing.T) {
 	json.NewDecoder(resp.Body).Decode(&prResp)
 	resp.Body.Close()
 
-	assert.Equal(t, "e2e-pr-001", prResp["pull_request_id"])
-	assert.Contains(t, prResp, "assigned_reviewers")
+	createdPR := prResp["pr"].(map[string]interface{})
+	assert.Equal(t, "e2e-pr-001", createdPR["pull_request_id"])
+	assert.Contains(t, createdPR, "assigned_reviewers")
 
 	teamGetRequest := map[string]interface{}{
 		"team_name": "e2e-team",
@@ -277,7 +387,77 @@ func TestE2E_FullAPIWorkflow(t *testing.T) {
 	json.NewDecoder(resp.Body).Decode(&mergeResp)
 	resp.Body.Close()
 
-	assert.Contains(t, mergeResp, "message")
+	assert.Contains(t, mergeResp, "pr")
+}
+
+func TestE2E_PullRequestContract(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "contract-team",
+		"members": []map[string]interface{}{
+			{"user_id": "c-user1", "username": "Contract User 1", "is_active": true},
+			{"user_id": "c-user2", "username": "Contract User 2", "is_active": true},
+			{"user_id": "c-user3", "username": "Contract User 3", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	createBody := map[string]interface{}{
+		"pull_request_id":   "c-pr-001",
+		"pull_request_name": "Contract PR",
+		"author_id":         "c-user1",
+	}
+
+	resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", createBody)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	created := body["pr"].(map[string]interface{})
+	assert.Equal(t, "OPEN", created["status"])
+
+	t.Run("duplicate create returns PR_EXISTS with existing PR", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", createBody)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "PR_EXISTS", body["error"].(map[string]interface{})["code"])
+		existing := body["pr"].(map[string]interface{})
+		assert.Equal(t, "c-pr-001", existing["pull_request_id"])
+		assert.ElementsMatch(t, created["assigned_reviewers"], existing["assigned_reviewers"])
+	})
+
+	t.Run("merge is idempotent", func(t *testing.T) {
+		mergeBody := map[string]interface{}{"pull_request_id": "c-pr-001"}
+
+		resp, first := doE2ERequest(t, "POST", "/api/pullRequest/merge", "admin-token", mergeBody)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		firstPR := first["pr"].(map[string]interface{})
+		assert.Equal(t, "MERGED", firstPR["status"])
+		assert.NotEmpty(t, firstPR["mergedAt"])
+
+		resp, second := doE2ERequest(t, "POST", "/api/pullRequest/merge", "admin-token", mergeBody)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		secondPR := second["pr"].(map[string]interface{})
+		assert.Equal(t, "MERGED", secondPR["status"])
+		assert.Equal(t, firstPR["mergedAt"], secondPR["mergedAt"])
+	})
+
+	t.Run("reassign on merged PR returns PR_MERGED", func(t *testing.T) {
+		reviewers := created["assigned_reviewers"].([]interface{})
+		require.NotEmpty(t, reviewers)
+
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/reassign", "admin-token", map[string]interface{}{
+			"pull_request_id": "c-pr-001",
+			"old_reviewer_id": reviewers[0],
+		})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "PR_MERGED", body["error"].(map[string]interface{})["code"])
+	})
+
+	t.Run("merge of unknown PR returns NOT_FOUND", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/merge", "admin-token", map[string]interface{}{
+			"pull_request_id": "c-pr-missing",
+		})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "NOT_FOUND", body["error"].(map[string]interface{})["code"])
+	})
 }
 
 func TestE2E_HealthCheck(t *testing.T) {
@@ -310,12 +490,1686 @@ func TestE2E_Authentication(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
 
+func TestE2E_IdempotentReassign(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "idem-team",
+		"members": []map[string]interface{}{
+			{"user_id": "i-user1", "username": "Idem User 1", "is_active": true},
+			{"user_id": "i-user2", "username": "Idem User 2", "is_active": true},
+			{"user_id": "i-user3", "username": "Idem User 3", "is_active": true},
+			{"user_id": "i-user4", "username": "Idem User 4", "is_active": true},
+			{"user_id": "i-user5", "username": "Idem User 5", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+		"pull_request_id":   "i-pr-001",
+		"pull_request_name": "Idempotent PR",
+		"author_id":         "i-user1",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	reviewers := body["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+	require.NotEmpty(t, reviewers)
+
+	reassignBody := map[string]interface{}{
+		"pull_request_id": "i-pr-001",
+		"old_reviewer_id": reviewers[0],
+	}
+	headers := map[string]string{"Idempotency-Key": "retry-key-1"}
+
+	resp, first := doE2ERequestWithHeaders(t, "POST", "/api/pullRequest/reassign", "admin-token", reassignBody, headers)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, second := doE2ERequestWithHeaders(t, "POST", "/api/pullRequest/reassign", "admin-token", reassignBody, headers)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "true", resp.Header.Get("Idempotent-Replayed"))
+	assert.Equal(t, first, second)
+
+	var assigned int
+	err := e2eDBPool.QueryRow(context.Background(),
+		"SELECT COUNT(*) FROM pr_reviewers WHERE pull_request_id = $1 AND user_id = $2",
+		"i-pr-001", first["replaced_by"]).Scan(&assigned)
+	require.NoError(t, err)
+	assert.Equal(t, 1, assigned)
+}
+
+func TestE2E_IdempotencyAbandonedReservation(t *testing.T) {
+	setupE2ETestData(t)
+	ctx := context.Background()
+
+	payload := map[string]interface{}{
+		"team_name": "abandoned-team",
+		"members": []map[string]interface{}{
+			{"user_id": "ab-user1", "username": "Abandoned User 1", "is_active": true},
+		},
+	}
+	headers := map[string]string{"Idempotency-Key": "abandoned-key"}
+	reserve := func(createdAt time.Time) {
+		_, err := e2eDBPool.Exec(ctx,
+			`INSERT INTO idempotency_keys (idempotency_key, request_path, request_hash, status_code, response_body, created_at, expires_at)
+			VALUES ($1, $2, 'hash', 0, NULL, $3, $4)
+			ON CONFLICT (idempotency_key, request_path) DO UPDATE SET created_at = EXCLUDED.created_at`,
+			"abandoned-key", "/api/team/add", createdAt, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+	}
+
+	reserve(time.Now())
+	resp, body := doE2ERequestWithHeaders(t, "POST", "/api/team/add", "admin-token", payload, headers)
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Equal(t, "IDEMPOTENCY_KEY_IN_PROGRESS", body["error"].(map[string]interface{})["code"])
+
+	// Процесс упал, не сохранив ответ: после аренды ключ занимает повтор.
+	reserve(time.Now().Add(-10 * time.Minute))
+	resp, _ = doE2ERequestWithHeaders(t, "POST", "/api/team/add", "admin-token", payload, headers)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, _ = doE2ERequestWithHeaders(t, "POST", "/api/team/add", "admin-token", payload, headers)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "true", resp.Header.Get("Idempotent-Replayed"))
+}
+
+func TestE2E_PullRequestGetAndList(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "list-team",
+		"members": []map[string]interface{}{
+			{"user_id": "l-user1", "username": "List User 1", "is_active": true},
+			{"user_id": "l-user2", "username": "List User 2", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	for _, id := range []string{"l-pr-1", "l-pr-2", "l-pr-3"} {
+		resp, _ := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   id,
+			"pull_request_name": "List PR " + id,
+			"author_id":         "l-user1",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, body := doE2ERequest(t, "GET", "/api/pullRequest/get?pull_request_id=l-pr-2", "user-token", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "l-pr-2", body["pr"].(map[string]interface{})["pull_request_id"])
+
+	resp, _ = doE2ERequest(t, "GET", "/api/pullRequest/get?pull_request_id=missing", "user-token", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	var seen []interface{}
+	cursor := ""
+	for {
+		resp, page := doE2ERequest(t, "GET", "/api/pullRequest/list?team_name=list-team&sort_by=pull_request_id&order=asc&limit=2&cursor="+cursor, "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		for _, pr := range page["pull_requests"].([]interface{}) {
+			seen = append(seen, pr.(map[string]interface{})["pull_request_id"])
+		}
+		next, ok := page["next_cursor"].(string)
+		if !ok || next == "" {
+			break
+		}
+		cursor = next
+	}
+	assert.Equal(t, []interface{}{"l-pr-1", "l-pr-2", "l-pr-3"}, seen)
+
+	resp, _ = doE2ERequest(t, "GET", "/api/pullRequest/list?status=CLOSED", "user-token", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func doE2ERequest(t *testing.T, method, path, token string, payload interface{}) (*http.Response, map[string]interface{}) {
+	t.Helper()
+	return doE2ERequestWithHeaders(t, method, path, token, payload, nil)
+}
+
+func doE2ERequestWithHeaders(t *testing.T, method, path, token string, payload interface{}, headers map[string]string) (*http.Response, map[string]interface{}) {
+	t.Helper()
+
+	var reqBody bytes.Buffer
+	if payload != nil {
+		require.NoError(t, json.NewEncoder(&reqBody).Encode(payload))
+	}
+
+	req, err := http.NewRequest(method, testServer.URL+path, &reqBody)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var body map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&body)
+
+	return resp, body
+}
+
 func setupE2ETestData(t *testing.T) {
 	ctx := context.Background()
 
-	tables := []string{"pr_reviewers", "pull_requests", "users", "teams"}
+	tables := []string{"event_cursors", "notification_digest_items", "notification_preferences", "git_identities", "webhook_dead_letters", "webhook_deliveries", "webhook_subscriptions", "events", "idempotency_keys", "code_owners_files", "auto_reassignments", "pr_reviewers", "pull_requests", "repository_rules", "repository_teams", "team_policies", "blocked_pairs", "user_teams", "users", "teams"}
 	for _, table := range tables {
 		_, err := e2eDBPool.Exec(ctx, "DELETE FROM "+table)
 		require.NoError(t, err)
 	}
+
+	// Репозиторий по умолчанию создаётся миграцией - сбрасываем только его настройки.
+	_, err := e2eDBPool.Exec(ctx, "DELETE FROM repositories WHERE repository <> $1", models.DefaultRepository)
+	require.NoError(t, err)
+	_, err = e2eDBPool.Exec(ctx, "UPDATE repositories SET owner_team = NULL, reviewer_count = NULL, strategy = NULL")
+	require.NoError(t, err)
+}
+
+func TestE2E_TeamArchiveAndDelete(t *testing.T) {
+	setupE2ETestData(t)
+
+	for _, team := range []map[string]interface{}{
+		{
+			"team_name": "old-team",
+			"members": []map[string]interface{}{
+				{"user_id": "old-user1", "username": "Old User 1", "is_active": true},
+				{"user_id": "old-user2", "username": "Old User 2", "is_active": true},
+			},
+		},
+		{
+			"team_name": "new-team",
+			"members": []map[string]interface{}{
+				{"user_id": "new-user1", "username": "New User 1", "is_active": true},
+			},
+		},
+	} {
+		resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", team)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	t.Run("archived team is hidden from assignment", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/team/archive", "admin-token", map[string]interface{}{"team_name": "old-team"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEmpty(t, body["archived_at"])
+		assert.ElementsMatch(t, []interface{}{"old-user1", "old-user2"}, body["affected_users"])
+
+		resp, body = doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "old-pr-001",
+			"pull_request_name": "Archived team PR",
+			"author_id":         "old-user1",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Empty(t, body["pr"].(map[string]interface{})["assigned_reviewers"])
+	})
+
+	t.Run("restore requires admin", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/team/restore", "user-token", map[string]interface{}{"team_name": "old-team"})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, body := doE2ERequest(t, "POST", "/api/team/restore", "admin-token", map[string]interface{}{"team_name": "old-team"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Nil(t, body["archived_at"])
+	})
+
+	t.Run("delete with open PRs requires reassignment target", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/team/delete", "admin-token", map[string]interface{}{"team_name": "old-team"})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "TEAM_HAS_OPEN_PRS", body["error"].(map[string]interface{})["code"])
+
+		resp, body = doE2ERequest(t, "POST", "/api/team/delete", "admin-token", map[string]interface{}{
+			"team_name":   "old-team",
+			"reassign_to": "new-team",
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, true, body["deleted"])
+		assert.Equal(t, "new-team", body["moved_to"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"repository": models.DefaultRepository, "pull_request_id": "old-pr-001"},
+		}, body["open_pull_requests"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/team/get?team_name=new-team", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body["members"], 3)
+	})
+}
+
+func TestE2E_MultiTeamMembership(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "squad-a",
+		"members": []map[string]interface{}{
+			{"user_id": "mt-author", "username": "Author", "is_active": true},
+			{"user_id": "mt-shared", "username": "Shared", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, _ = doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "squad-b",
+		"members": []map[string]interface{}{
+			{"user_id": "mt-other", "username": "Other", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "POST", "/api/team/addMembers", "admin-token", map[string]interface{}{
+		"team_name": "squad-b",
+		"members": []map[string]interface{}{
+			{"user_id": "mt-shared", "username": "Shared", "is_active": true, "role": "reviewer", "weight": 3},
+		},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, body["members"], 2)
+
+	t.Run("shared member keeps primary team", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "GET", "/api/team/get?team_name=squad-a", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body["members"], 2)
+	})
+
+	t.Run("shared member reviews for both teams", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "mt-pr-b",
+			"pull_request_name": "Squad B PR",
+			"author_id":         "mt-other",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, []interface{}{"mt-shared"}, body["pr"].(map[string]interface{})["assigned_reviewers"])
+
+		resp, body = doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "mt-pr-a",
+			"pull_request_name": "Squad A PR",
+			"author_id":         "mt-author",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, []interface{}{"mt-shared"}, body["pr"].(map[string]interface{})["assigned_reviewers"])
+	})
+
+	t.Run("leaving secondary team keeps primary team", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/team/removeMembers", "admin-token", map[string]interface{}{
+			"team_name": "squad-b",
+			"user_ids":  []string{"mt-shared"},
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		removed := body["removed"].([]interface{})
+		require.Len(t, removed, 1)
+		user := removed[0].(map[string]interface{})["user"].(map[string]interface{})
+		assert.Equal(t, "squad-a", user["team_name"])
+	})
+}
+
+func TestE2E_TeamHierarchy(t *testing.T) {
+	setupE2ETestData(t)
+
+	for _, team := range []map[string]interface{}{
+		{
+			"team_name": "h-org",
+			"members": []map[string]interface{}{
+				{"user_id": "h-lead", "username": "Lead", "is_active": true},
+			},
+		},
+		{
+			"team_name": "h-squad",
+			"members": []map[string]interface{}{
+				{"user_id": "h-author", "username": "Author", "is_active": true},
+			},
+		},
+	} {
+		resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", team)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, body := doE2ERequest(t, "POST", "/api/team/setParent", "admin-token", map[string]interface{}{
+		"team_name":   "h-squad",
+		"parent_team": "h-org",
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "h-org", body["parent_team"])
+
+	t.Run("cycle is rejected", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/team/setParent", "admin-token", map[string]interface{}{
+			"team_name":   "h-org",
+			"parent_team": "h-squad",
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "INVALID_REQUEST", body["error"].(map[string]interface{})["code"])
+	})
+
+	t.Run("subtree", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "GET", "/api/team/subtree?team_name=h-org", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		children := body["children"].([]interface{})
+		require.Len(t, children, 1)
+		assert.Equal(t, "h-squad", children[0].(map[string]interface{})["team_name"])
+	})
+
+	t.Run("reviewers escalate to parent team", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "h-pr-001",
+			"pull_request_name": "Lonely squad PR",
+			"author_id":         "h-author",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, []interface{}{"h-lead"}, body["pr"].(map[string]interface{})["assigned_reviewers"])
+	})
+}
+
+func TestE2E_SkillMatchedAssignment(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "skills-team",
+		"members": []map[string]interface{}{
+			{"user_id": "s-author", "username": "Author", "is_active": true},
+			{"user_id": "s-dba", "username": "DBA", "is_active": true},
+			{"user_id": "s-front1", "username": "Frontend 1", "is_active": true},
+			{"user_id": "s-front2", "username": "Frontend 2", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "POST", "/api/users/setSkills", "admin-token", map[string]interface{}{
+		"user_id": "s-dba",
+		"skills":  []string{"Postgres", "go"},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []interface{}{"go", "postgres"}, body["skills"])
+
+	t.Run("migration PR gets a database reviewer", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "s-pr-001",
+			"pull_request_name": "Add migration",
+			"author_id":         "s-author",
+			"required_skills":   []string{"postgres"},
+			"skill_match":       "require",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		pr := body["pr"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"s-dba"}, pr["assigned_reviewers"])
+		assert.Equal(t, []interface{}{"postgres"}, pr["required_skills"])
+	})
+
+	t.Run("required skill nobody has", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "s-pr-002",
+			"pull_request_name": "Rust rewrite",
+			"author_id":         "s-author",
+			"required_skills":   []string{"rust"},
+			"skill_match":       "require",
+		})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "NO_CANDIDATE", body["error"].(map[string]interface{})["code"])
+	})
+}
+
+func TestE2E_MentorshipPolicies(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "mentor-team",
+		"members": []map[string]interface{}{
+			{"user_id": "m-junior", "username": "Junior", "is_active": true},
+			{"user_id": "m-mid", "username": "Mid", "is_active": true},
+			{"user_id": "m-senior", "username": "Senior", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	levels := map[string]string{"m-junior": "junior", "m-mid": "mid", "m-senior": "Senior"}
+	for userID, level := range levels {
+		resp, _ := doE2ERequest(t, "POST", "/api/users/setLevel", "admin-token", map[string]interface{}{
+			"user_id": userID,
+			"level":   level,
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	resp, body := doE2ERequest(t, "POST", "/api/team/setPolicies", "admin-token", map[string]interface{}{
+		"team_name": "mentor-team",
+		"policies":  []string{"junior_needs_senior"},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []interface{}{"junior_needs_senior"}, body["policies"])
+
+	t.Run("junior PR gets a senior reviewer", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "m-pr-001",
+			"pull_request_name": "First PR",
+			"author_id":         "m-junior",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.ElementsMatch(t, []interface{}{"m-senior", "m-mid"}, body["pr"].(map[string]interface{})["assigned_reviewers"])
+	})
+
+	t.Run("senior cannot be replaced by a mid", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/reassign", "admin-token", map[string]interface{}{
+			"pull_request_id": "m-pr-001",
+			"old_reviewer_id": "m-senior",
+		})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "POLICY_VIOLATION", body["error"].(map[string]interface{})["code"])
+	})
+
+	t.Run("unsatisfiable policy is reported", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/users/setIsActive", "admin-token", map[string]interface{}{
+			"user_id":   "m-senior",
+			"is_active": false,
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "m-pr-002",
+			"pull_request_name": "Second PR",
+			"author_id":         "m-junior",
+		})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "POLICY_VIOLATION", body["error"].(map[string]interface{})["code"])
+	})
+}
+
+func TestE2E_ReviewerConflicts(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "conflict-team",
+		"members": []map[string]interface{}{
+			{"user_id": "c-author", "username": "Author", "is_active": true},
+			{"user_id": "c-manager", "username": "Manager", "is_active": true},
+			{"user_id": "c-coauthor", "username": "Co-author", "is_active": true},
+			{"user_id": "c-dev", "username": "Developer", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "POST", "/api/users/blockPair", "admin-token", map[string]interface{}{
+		"author_id":   "c-author",
+		"reviewer_id": "c-manager",
+		"reason":      "performance review season",
+		"expires_at":  time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, body["blocked_pairs"], 1)
+
+	prBody := map[string]interface{}{
+		"pull_request_id":    "c-pr-001",
+		"pull_request_name":  "Shared change",
+		"author_id":          "c-author",
+		"excluded_reviewers": []string{"c-coauthor"},
+	}
+
+	t.Run("dry run cites blocked pair and exclusion", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/dryRun", "admin-token", prBody)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []interface{}{"c-dev"}, body["pr"].(map[string]interface{})["assigned_reviewers"])
+
+		excluded := body["excluded"].([]interface{})
+		require.Len(t, excluded, 2)
+		assert.Equal(t, "excluded", excluded[0].(map[string]interface{})["reason"])
+		assert.Equal(t, "blocked_pair", excluded[1].(map[string]interface{})["reason"])
+		assert.Equal(t, "performance review season", excluded[1].(map[string]interface{})["detail"])
+
+		resp, _ = doE2ERequest(t, "GET", "/api/pullRequest/get?pull_request_id=c-pr-001", "admin-token", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("create and reassign honour conflicts", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", prBody)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		pr := body["pr"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"c-dev"}, pr["assigned_reviewers"])
+		assert.Equal(t, []interface{}{"c-coauthor"}, pr["excluded_reviewers"])
+
+		resp, body = doE2ERequest(t, "POST", "/api/pullRequest/reassign", "admin-token", map[string]interface{}{
+			"pull_request_id": "c-pr-001",
+			"old_reviewer_id": "c-dev",
+		})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, "NO_CANDIDATE", body["error"].(map[string]interface{})["code"])
+	})
+
+	t.Run("unblocked reviewer becomes a candidate", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/users/unblockPair", "admin-token", map[string]interface{}{
+			"author_id":   "c-author",
+			"reviewer_id": "c-manager",
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, body["blocked_pairs"])
+
+		resp, body = doE2ERequest(t, "POST", "/api/pullRequest/reassign", "admin-token", map[string]interface{}{
+			"pull_request_id": "c-pr-001",
+			"old_reviewer_id": "c-dev",
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "c-manager", body["replaced_by"])
+	})
+}
+
+func TestE2E_ReviewerRotation(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "rotation-team",
+		"members": []map[string]interface{}{
+			{"user_id": "rot-author", "username": "Author", "is_active": true},
+			{"user_id": "rot-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "rot-r2", "username": "Reviewer 2", "is_active": true},
+			{"user_id": "rot-r3", "username": "Reviewer 3", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	t.Run("penalty is validated", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/team/setRotationPenalty", "admin-token", map[string]interface{}{
+			"team_name":        "rotation-team",
+			"rotation_penalty": 150,
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "INVALID_REQUEST", body["error"].(map[string]interface{})["code"])
+	})
+
+	resp, body := doE2ERequest(t, "POST", "/api/team/setRotationPenalty", "admin-token", map[string]interface{}{
+		"team_name":        "rotation-team",
+		"rotation_penalty": 100,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, float64(100), body["rotation_penalty"])
+
+	t.Run("next PR prefers the reviewer who was skipped", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "rot-pr-001",
+			"pull_request_name": "First",
+			"author_id":         "rot-author",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		first := body["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		require.Len(t, first, 2)
+
+		skipped := map[string]bool{"rot-r1": true, "rot-r2": true, "rot-r3": true}
+		for _, reviewer := range first {
+			delete(skipped, reviewer.(string))
+		}
+
+		resp, body = doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "rot-pr-002",
+			"pull_request_name": "Second",
+			"author_id":         "rot-author",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		second := body["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+		for reviewer := range skipped {
+			assert.Contains(t, second, reviewer)
+		}
+	})
+
+	t.Run("fairness report shows pairings", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "GET", "/api/stats/fairness?team_name=rotation-team", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		authors := body["authors"].([]interface{})
+		require.Len(t, authors, 1)
+		author := authors[0].(map[string]interface{})
+		assert.Equal(t, "rot-author", author["author_id"])
+		assert.Equal(t, float64(4), author["review_count"])
+		assert.Equal(t, float64(3), author["distinct_reviewers"])
+
+		resp, _ = doE2ERequest(t, "GET", "/api/stats/fairness?since=yesterday", "user-token", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestE2E_ReviewSLA(t *testing.T) {
+	setupE2ETestData(t)
+	ctx := context.Background()
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "sla-team",
+		"members": []map[string]interface{}{
+			{"user_id": "sla-author", "username": "Author", "is_active": true},
+			{"user_id": "sla-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "sla-r2", "username": "Reviewer 2", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	t.Run("first response must not exceed completion", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/team/setSLA", "admin-token", map[string]interface{}{
+			"team_name":            "sla-team",
+			"first_response_hours": 8,
+			"completion_hours":     4,
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	resp, body := doE2ERequest(t, "POST", "/api/team/setSLA", "admin-token", map[string]interface{}{
+		"team_name":            "sla-team",
+		"first_response_hours": 2,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, float64(2), body["sla"].(map[string]interface{})["first_response_hours"])
+
+	resp, _ = doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+		"pull_request_id":   "sla-pr-001",
+		"pull_request_name": "Slow review",
+		"author_id":         "sla-author",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	_, err := e2eDBPool.Exec(ctx, "UPDATE pr_reviewers SET assigned_at = NOW() - INTERVAL '14 days' WHERE pull_request_id = $1", "sla-pr-001")
+	require.NoError(t, err)
+
+	t.Run("unanswered reviews are overdue", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "GET", "/api/team/overdueReviews?team_name=sla-team", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body["reviews"], 2)
+
+		resp, body = doE2ERequest(t, "GET", "/api/users/getReview?user_id=sla-r1", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		prs := body["pull_requests"].([]interface{})
+		require.Len(t, prs, 1)
+		assert.Equal(t, true, prs[0].(map[string]interface{})["sla"].(map[string]interface{})["overdue"])
+	})
+
+	t.Run("response clears first response breach", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/respond", "user-token", map[string]interface{}{
+			"pull_request_id": "sla-pr-001",
+			"reviewer_id":     "sla-r1",
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotEmpty(t, body["responded_at"])
+		assert.Equal(t, false, body["sla"].(map[string]interface{})["overdue"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/users/overdueReviews?user_id=sla-r1", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, body["reviews"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/users/overdueReviews?user_id=sla-r2", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body["reviews"], 1)
+	})
+
+	t.Run("only assigned reviewers can respond", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/pullRequest/respond", "user-token", map[string]interface{}{
+			"pull_request_id": "sla-pr-001",
+			"reviewer_id":     "sla-author",
+		})
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+}
+
+func TestE2E_StaleReviewAutoReassign(t *testing.T) {
+	setupE2ETestData(t)
+	ctx := context.Background()
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "stale-team",
+		"members": []map[string]interface{}{
+			{"user_id": "stale-author", "username": "Author", "is_active": true},
+			{"user_id": "stale-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "stale-r2", "username": "Reviewer 2", "is_active": true},
+			{"user_id": "stale-r3", "username": "Reviewer 3", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, _ = doE2ERequest(t, "POST", "/api/team/setSLA", "admin-token", map[string]interface{}{
+		"team_name":            "stale-team",
+		"first_response_hours": 1,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	t.Run("limit is validated", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/team/setAutoReassign", "admin-token", map[string]interface{}{
+			"team_name":  "stale-team",
+			"enabled":    true,
+			"max_per_pr": 50,
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	resp, body := doE2ERequest(t, "POST", "/api/team/setAutoReassign", "admin-token", map[string]interface{}{
+		"team_name": "stale-team",
+		"enabled":   true,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, map[string]interface{}{"enabled": true, "max_per_pr": float64(1)}, body["auto_reassign"])
+
+	resp, body = doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+		"pull_request_id":   "stale-pr-001",
+		"pull_request_name": "Forgotten",
+		"author_id":         "stale-author",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	original := body["pr"].(map[string]interface{})["assigned_reviewers"].([]interface{})
+	require.Len(t, original, 2)
+
+	_, err := e2eDBPool.Exec(ctx, "UPDATE pr_reviewers SET assigned_at = NOW() - INTERVAL '14 days' WHERE pull_request_id = $1", "stale-pr-001")
+	require.NoError(t, err)
+
+	prRepo := repository.NewPostgresPullRequestRepository(e2eDBPool)
+	userRepo := repository.NewPostgresUserRepository(e2eDBPool)
+	teamRepo := repository.NewPostgresTeamRepository(e2eDBPool)
+	userSvc := services.NewUserService(userRepo)
+	prSvc := services.NewPullRequestService(prRepo, userRepo, teamRepo, repository.NewPostgresRepoRepository(e2eDBPool),
+		repository.NewPostgresCodeOwnersRepository(e2eDBPool), userSvc)
+	autoReassignSvc := services.NewAutoReassignService(prRepo, teamRepo, prSvc, services.DefaultBusinessHours())
+
+	t.Run("job reassigns once per PR and records audit", func(t *testing.T) {
+		records, err := autoReassignSvc.ReassignStaleReviews(ctx)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Contains(t, original, records[0].OldReviewerID)
+		assert.Equal(t, models.AutoReassignFirstResponseOverdue, records[0].Reason)
+
+		records, err = autoReassignSvc.ReassignStaleReviews(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+
+		resp, body := doE2ERequest(t, "GET", "/api/pullRequest/autoReassignments?pull_request_id=stale-pr-001", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		audit := body["reassignments"].([]interface{})
+		require.Len(t, audit, 1)
+		assert.Equal(t, "stale-team", audit[0].(map[string]interface{})["team_name"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/pullRequest/get?pull_request_id=stale-pr-001", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotContains(t, body["pr"].(map[string]interface{})["assigned_reviewers"], audit[0].(map[string]interface{})["old_reviewer_id"])
+	})
+}
+
+func TestE2E_Webhooks(t *testing.T) {
+	setupE2ETestData(t)
+	ctx := context.Background()
+
+	type received struct {
+		event     string
+		signature string
+		body      []byte
+	}
+	deliveries := make(chan received, 10)
+	subscriber := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		deliveries <- received{r.Header.Get(services.WebhookEventHeader), r.Header.Get(services.WebhookSignatureHeader), body}
+	}))
+	defer subscriber.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	resp, _ := doE2ERequest(t, "POST", "/api/webhooks/subscribe", "user-token", map[string]interface{}{
+		"url": subscriber.URL, "secret": "s3cret",
+	})
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, _ = doE2ERequest(t, "POST", "/api/webhooks/subscribe", "admin-token", map[string]interface{}{
+		"url": subscriber.URL, "secret": "s3cret", "events": []string{"unknown"},
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "POST", "/api/webhooks/subscribe", "admin-token", map[string]interface{}{
+		"url": subscriber.URL, "secret": "s3cret", "events": []string{models.EventReviewersAssigned, models.EventPullRequestMerged},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.NotContains(t, body, "secret")
+
+	resp, body = doE2ERequest(t, "POST", "/api/webhooks/subscribe", "admin-token", map[string]interface{}{
+		"url": failing.URL, "secret": "other",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	failingID := body["id"]
+
+	resp, _ = doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "hook-team",
+		"members": []map[string]interface{}{
+			{"user_id": "hook-author", "username": "Author", "is_active": true},
+			{"user_id": "hook-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "hook-r2", "username": "Reviewer 2", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, _ = doE2ERequest(t, "POST", "/api/pullRequest/create", "user-token", map[string]interface{}{
+		"pull_request_id":   "hook-pr-001",
+		"pull_request_name": "Webhooks",
+		"author_id":         "hook-author",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	for i := 0; i < 2; i++ {
+		resp, _ = doE2ERequest(t, "POST", "/api/pullRequest/merge", "user-token", map[string]interface{}{
+			"pull_request_id": "hook-pr-001",
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	webhookSvc := services.NewWebhookService(repository.NewPostgresWebhookRepository(e2eDBPool), http.DefaultClient,
+		services.WebhookRetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+	t.Run("events are delivered once and signed", func(t *testing.T) {
+		require.NoError(t, webhookSvc.Dispatch(ctx))
+
+		var events []string
+		for len(events) < 2 {
+			select {
+			case d := <-deliveries:
+				assert.Equal(t, services.SignWebhookPayload("s3cret", d.body), d.signature)
+				var event models.Event
+				require.NoError(t, json.Unmarshal(d.body, &event))
+				assert.Equal(t, d.event, event.Type)
+				assert.Equal(t, "hook-pr-001", event.Data.PullRequestID)
+				events = append(events, event.Type)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("expected 2 deliveries, got %v", events)
+			}
+		}
+		assert.Equal(t, []string{models.EventReviewersAssigned, models.EventPullRequestMerged}, events)
+
+		require.NoError(t, webhookSvc.Dispatch(ctx))
+		assert.Empty(t, deliveries)
+	})
+
+	t.Run("failed deliveries go to dead letters and can be replayed", func(t *testing.T) {
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, webhookSvc.Dispatch(ctx))
+
+		resp, body := doE2ERequest(t, "GET", fmt.Sprintf("/api/webhooks/deadLetters?subscription_id=%v", failingID), "admin-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		letters := body["dead_letters"].([]interface{})
+		require.Len(t, letters, 2)
+		assert.Equal(t, float64(2), letters[0].(map[string]interface{})["attempts"])
+		assert.Equal(t, "unexpected status 503", letters[0].(map[string]interface{})["last_error"])
+
+		resp, body = doE2ERequest(t, "POST", "/api/webhooks/replay", "admin-token", map[string]interface{}{
+			"subscription_id": failingID,
+			"dead_letter_ids": []interface{}{letters[0].(map[string]interface{})["id"]},
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, float64(1), body["replayed"])
+
+		var pending int
+		require.NoError(t, e2eDBPool.QueryRow(ctx, "SELECT COUNT(*) FROM webhook_deliveries").Scan(&pending))
+		assert.Equal(t, 1, pending)
+	})
+
+	t.Run("delete subscription", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/webhooks/delete", "admin-token", map[string]interface{}{"id": failingID})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, _ = doE2ERequest(t, "POST", "/api/webhooks/delete", "admin-token", map[string]interface{}{"id": failingID})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, body := doE2ERequest(t, "GET", "/api/webhooks/list", "admin-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body["subscriptions"], 1)
+	})
+}
+
+func TestE2E_GitHostWebhooks(t *testing.T) {
+	setupE2ETestData(t)
+
+	fixture := func(name string) []byte {
+		body, err := os.ReadFile("../../internal/services/testdata/" + name)
+		require.NoError(t, err)
+		return body
+	}
+	receive := func(path string, headers map[string]string, body []byte) (*http.Response, map[string]interface{}) {
+		req, err := http.NewRequest("POST", testServer.URL+path, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var result map[string]interface{}
+		_ = json.NewDecoder(resp.Body).Decode(&result)
+		return resp, result
+	}
+	github := func(name string) (*http.Response, map[string]interface{}) {
+		body := fixture(name)
+		return receive("/integrations/github", map[string]string{
+			"X-GitHub-Event":      "pull_request",
+			"X-Hub-Signature-256": services.SignWebhookPayload(e2eGitHubSecret, body),
+		}, body)
+	}
+	gitlab := func(name string) (*http.Response, map[string]interface{}) {
+		return receive("/integrations/gitlab", map[string]string{
+			"X-Gitlab-Event": "Merge Request Hook",
+			"X-Gitlab-Token": e2eGitLabToken,
+		}, fixture(name))
+	}
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "git-team",
+		"members": []map[string]interface{}{
+			{"user_id": "git-author", "username": "Author", "is_active": true},
+			{"user_id": "git-r1", "username": "Reviewer 1", "is_active": true},
+			{"user_id": "git-r2", "username": "Reviewer 2", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	for _, repo := range []string{"acme/payments", "acme/billing"} {
+		resp, _ = doE2ERequest(t, "POST", "/api/repository/add", "admin-token", map[string]interface{}{"repository": repo})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	t.Run("unmapped author is rejected", func(t *testing.T) {
+		resp, body := github("github_pull_request_opened.json")
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		assert.Equal(t, models.ErrorCodeUnprocessable, body["error"].(map[string]interface{})["code"])
+	})
+
+	for _, identity := range []map[string]interface{}{
+		{"provider": "github", "username": "octo-dev", "user_id": "git-author"},
+		{"provider": "gitlab", "username": "lab.dev", "user_id": "git-author"},
+	} {
+		resp, _ = doE2ERequest(t, "POST", "/api/gitUsers/map", "admin-token", identity)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	t.Run("invalid signature", func(t *testing.T) {
+		body := fixture("github_pull_request_opened.json")
+		resp, result := receive("/integrations/github", map[string]string{
+			"X-GitHub-Event":      "pull_request",
+			"X-Hub-Signature-256": services.SignWebhookPayload("wrong", body),
+		}, body)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.Equal(t, models.ErrorCodeInvalidSignature, result["error"].(map[string]interface{})["code"])
+	})
+
+	t.Run("github pull request lifecycle", func(t *testing.T) {
+		resp, body := github("github_pull_request_opened.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, models.GitHostActionCreated, body["action"])
+		pr := body["pr"].(map[string]interface{})
+		assert.Equal(t, "git-author", pr["author_id"])
+		assert.Len(t, pr["assigned_reviewers"], 2)
+
+		resp, body = github("github_pull_request_opened.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, models.GitHostActionIgnored, body["action"])
+
+		resp, body = github("github_pull_request_opened_draft.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		pr = body["pr"].(map[string]interface{})
+		assert.Equal(t, true, pr["draft"])
+		assert.Empty(t, pr["assigned_reviewers"])
+
+		resp, body = github("github_pull_request_ready_for_review.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, models.GitHostActionReadyForReview, body["action"])
+		pr = body["pr"].(map[string]interface{})
+		assert.Nil(t, pr["draft"])
+		assert.Len(t, pr["assigned_reviewers"], 2)
+
+		resp, body = github("github_pull_request_closed_merged.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, models.PRStatusMerged, body["pr"].(map[string]interface{})["status"])
+	})
+
+	t.Run("gitlab merge request lifecycle", func(t *testing.T) {
+		resp, body := gitlab("gitlab_merge_request_open.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, body["pr"].(map[string]interface{})["assigned_reviewers"])
+
+		resp, body = gitlab("gitlab_merge_request_update_ready.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body["pr"].(map[string]interface{})["assigned_reviewers"], 2)
+
+		resp, body = gitlab("gitlab_merge_request_merge.json")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, models.GitHostActionMerged, body["action"])
+	})
+
+	t.Run("mappings", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "GET", "/api/gitUsers/list?provider=github", "admin-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body["git_users"], 1)
+
+		resp, _ = doE2ERequest(t, "POST", "/api/gitUsers/unmap", "admin-token", map[string]interface{}{"provider": "github", "username": "Octo-Dev"})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		resp, _ = doE2ERequest(t, "POST", "/api/gitUsers/unmap", "admin-token", map[string]interface{}{"provider": "github", "username": "Octo-Dev"})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_CodeOwners(t *testing.T) {
+	setupE2ETestData(t)
+
+	for _, team := range []map[string]interface{}{
+		{
+			"team_name": "co-app",
+			"members": []map[string]interface{}{
+				{"user_id": "co-author", "username": "Author", "is_active": true},
+				{"user_id": "co-lead", "username": "Lead", "is_active": true},
+				{"user_id": "co-dev", "username": "Developer", "is_active": true},
+			},
+		},
+		{
+			"team_name": "co-dba",
+			"members": []map[string]interface{}{
+				{"user_id": "co-dba1", "username": "DBA", "is_active": true},
+			},
+		},
+	} {
+		resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", team)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, _ := doE2ERequest(t, "POST", "/api/codeOwners/upload", "admin-token", map[string]interface{}{
+		"repository": "co-repo",
+		"content":    "*.go @co-lead",
+	})
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, _ = doE2ERequest(t, "POST", "/api/repository/add", "admin-token", map[string]interface{}{
+		"repository": "co-repo",
+		"owner_team": "co-app",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "POST", "/api/codeOwners/upload", "admin-token", map[string]interface{}{
+		"repository": "co-repo",
+		"content":    "*.go @co-lead\n/migrations/ @acme/co-dba\n",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, float64(1), body["version"])
+
+	resp, body = doE2ERequest(t, "POST", "/api/codeOwners/upload", "admin-token", map[string]interface{}{
+		"repository": "co-repo",
+		"content":    "*.go @co-lead @co-ghost\n/migrations/ @acme/co-dba\n",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, float64(2), body["version"])
+
+	t.Run("validation reports unknown owners", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "GET", "/api/codeOwners/validate?repository=co-repo", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, false, body["valid"])
+		issues := body["issues"].([]interface{})
+		require.Len(t, issues, 1)
+		assert.Equal(t, "@co-ghost", issues[0].(map[string]interface{})["owner"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/codeOwners/validate?repository=co-repo&version=1", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, true, body["valid"])
+	})
+
+	t.Run("owners of changed files become reviewers", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "co-pr-001",
+			"pull_request_name": "Add migration",
+			"author_id":         "co-author",
+			"repository":        "co-repo",
+			"changed_files":     []string{"migrations/000009_x.up.sql", "internal/app.go"},
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		pr := body["pr"].(map[string]interface{})
+		assert.ElementsMatch(t, []interface{}{"co-lead", "co-dba1"}, pr["assigned_reviewers"])
+		assert.Equal(t, "co-repo", pr["repository"])
+	})
+
+	t.Run("upload rejects malformed rules", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/codeOwners/upload", "admin-token", map[string]interface{}{
+			"repository": "co-repo",
+			"content":    "*.go lead@example.com",
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, _ = doE2ERequest(t, "POST", "/api/codeOwners/upload", "user-token", map[string]interface{}{
+			"repository": "co-repo",
+			"content":    "*.go @co-lead",
+		})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
+
+func TestE2E_Repositories(t *testing.T) {
+	setupE2ETestData(t)
+
+	for _, team := range []map[string]interface{}{
+		{
+			"team_name": "rp-app",
+			"members": []map[string]interface{}{
+				{"user_id": "rp-author", "username": "Author", "is_active": true},
+				{"user_id": "rp-dev1", "username": "Developer 1", "is_active": true},
+				{"user_id": "rp-dev2", "username": "Developer 2", "is_active": true},
+			},
+		},
+		{
+			"team_name": "rp-infra",
+			"members": []map[string]interface{}{
+				{"user_id": "rp-ops1", "username": "Ops 1", "is_active": true},
+				{"user_id": "rp-ops2", "username": "Ops 2", "is_active": true},
+				{"user_id": "rp-ops3", "username": "Ops 3", "is_active": true},
+			},
+		},
+	} {
+		resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", team)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	t.Run("settings are validated", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/repository/add", "user-token", map[string]interface{}{"repository": "rp-infra"})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, body := doE2ERequest(t, "POST", "/api/repository/add", "admin-token", map[string]interface{}{
+			"repository": "rp-infra",
+			"strategy":   "round_robin",
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "INVALID_REQUEST", body["error"].(map[string]interface{})["code"])
+
+		resp, _ = doE2ERequest(t, "POST", "/api/repository/add", "admin-token", map[string]interface{}{
+			"repository":     "rp-infra",
+			"eligible_teams": []string{"rp-missing"},
+		})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	resp, body := doE2ERequest(t, "POST", "/api/repository/add", "admin-token", map[string]interface{}{
+		"repository":     "rp-infra",
+		"owner_team":     "rp-infra",
+		"reviewer_count": 3,
+		"strategy":       "least_loaded",
+		"eligible_teams": []string{"rp-infra"},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, float64(3), body["reviewer_count"])
+
+	resp, body = doE2ERequest(t, "POST", "/api/repository/add", "admin-token", map[string]interface{}{"repository": "rp-infra"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "REPOSITORY_EXISTS", body["error"].(map[string]interface{})["code"])
+
+	t.Run("same PR ID in different repositories", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "rp-pr-1",
+			"pull_request_name": "App change",
+			"author_id":         "rp-author",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		pr := body["pr"].(map[string]interface{})
+		assert.Equal(t, models.DefaultRepository, pr["repository"])
+		assert.ElementsMatch(t, []interface{}{"rp-dev1", "rp-dev2"}, pr["assigned_reviewers"])
+
+		resp, body = doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "rp-pr-1",
+			"pull_request_name": "Infra change",
+			"author_id":         "rp-author",
+			"repository":        "rp-infra",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		pr = body["pr"].(map[string]interface{})
+		assert.ElementsMatch(t, []interface{}{"rp-ops1", "rp-ops2", "rp-ops3"}, pr["assigned_reviewers"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/pullRequest/get?repository=rp-infra&pull_request_id=rp-pr-1", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "Infra change", body["pr"].(map[string]interface{})["pull_request_name"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/pullRequest/list?repository=rp-infra", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, body["pull_requests"], 1)
+
+		resp, body = doE2ERequest(t, "POST", "/api/pullRequest/merge", "admin-token", map[string]interface{}{
+			"repository":      "rp-infra",
+			"pull_request_id": "rp-pr-1",
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "MERGED", body["pr"].(map[string]interface{})["status"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/pullRequest/get?pull_request_id=rp-pr-1", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "OPEN", body["pr"].(map[string]interface{})["status"])
+	})
+
+	t.Run("unknown repository is rejected", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "rp-pr-2",
+			"pull_request_name": "Lost change",
+			"author_id":         "rp-author",
+			"repository":        "rp-missing",
+		})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("update replaces settings", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/repository/update", "admin-token", map[string]interface{}{
+			"repository":     "rp-infra",
+			"reviewer_count": 1,
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, float64(1), body["reviewer_count"])
+		assert.Empty(t, body["eligible_teams"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/repository/get?repository=rp-infra", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Nil(t, body["strategy"])
+		assert.Nil(t, body["owner_team"])
+	})
+}
+
+func TestE2E_PullRequestMetadata(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "md-team",
+		"members": []map[string]interface{}{
+			{"user_id": "md-author", "username": "Author", "is_active": true},
+			{"user_id": "md-dev1", "username": "Developer 1", "is_active": true},
+			{"user_id": "md-dev2", "username": "Developer 2", "is_active": true},
+			{"user_id": "md-dev3", "username": "Developer 3", "is_active": true},
+			{"user_id": "md-dev4", "username": "Developer 4", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "POST", "/api/repository/add", "admin-token", map[string]interface{}{
+		"repository": "md-repo",
+		"rules": []map[string]interface{}{
+			{"label": "security", "extra_reviewers": 1},
+			{"min_changed_lines": 500, "extra_reviewers": 1},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Len(t, body["rules"], 2)
+
+	t.Run("metadata is stored and drives rules", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "md-pr-1",
+			"pull_request_name": "Rotate keys",
+			"author_id":         "md-author",
+			"repository":        "md-repo",
+			"url":               "https://git.example.com/md-repo/pull/1",
+			"description":       "Rotates signing keys",
+			"labels":            []string{"Security"},
+			"lines_added":       420,
+			"lines_removed":     120,
+			"files_changed":     7,
+			"priority":          "high",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		pr := body["pr"].(map[string]interface{})
+		assert.Len(t, pr["assigned_reviewers"], 4)
+
+		resp, body = doE2ERequest(t, "GET", "/api/pullRequest/get?repository=md-repo&pull_request_id=md-pr-1", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		pr = body["pr"].(map[string]interface{})
+		assert.Equal(t, "https://git.example.com/md-repo/pull/1", pr["url"])
+		assert.Equal(t, "Rotates signing keys", pr["description"])
+		assert.Equal(t, []interface{}{"security"}, pr["labels"])
+		assert.Equal(t, float64(420), pr["lines_added"])
+		assert.Equal(t, float64(120), pr["lines_removed"])
+		assert.Equal(t, float64(7), pr["files_changed"])
+		assert.Equal(t, "high", pr["priority"])
+	})
+
+	t.Run("rule requires reviewer level", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/repository/add", "admin-token", map[string]interface{}{
+			"repository": "md-level-repo",
+			"rules":      []map[string]interface{}{{"min_changed_lines": 500, "min_level": "Senior"}},
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		rule := body["rules"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "senior", rule["min_level"])
+		assert.Equal(t, float64(0), rule["extra_reviewers"])
+
+		// В md-team нет senior и lead участников.
+		resp, body = doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "md-level-pr",
+			"pull_request_name": "Big refactoring",
+			"author_id":         "md-author",
+			"repository":        "md-level-repo",
+			"lines_added":       600,
+		})
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		assert.Equal(t, models.ErrorCodePolicyViolation, body["error"].(map[string]interface{})["code"])
+	})
+
+	t.Run("list filters by label and priority", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "md-pr-2",
+			"pull_request_name": "Fix typo",
+			"author_id":         "md-author",
+			"repository":        "md-repo",
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, body := doE2ERequest(t, "GET", "/api/pullRequest/list?label=security", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, body["pull_requests"], 1)
+		assert.Equal(t, "md-pr-1", body["pull_requests"].([]interface{})[0].(map[string]interface{})["pull_request_id"])
+
+		resp, body = doE2ERequest(t, "GET", "/api/pullRequest/list?priority=normal", "user-token", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, body["pull_requests"], 1)
+		assert.Equal(t, "md-pr-2", body["pull_requests"].([]interface{})[0].(map[string]interface{})["pull_request_id"])
+
+		resp, _ = doE2ERequest(t, "GET", "/api/pullRequest/list?priority=urgent", "user-token", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("invalid metadata is rejected", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/pullRequest/create", "admin-token", map[string]interface{}{
+			"pull_request_id":   "md-pr-3",
+			"pull_request_name": "Bad link",
+			"author_id":         "md-author",
+			"url":               "not a url",
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+// e2eStreamEvent - событие, прочитанное из потока /api/events/stream.
+type e2eStreamEvent struct {
+	id    string
+	event string
+	data  models.Event
+}
+
+// openE2EEventStream открывает поток событий и разбирает его в канал до закрытия соединения.
+func openE2EEventStream(t *testing.T, ctx context.Context, query, lastEventID string) (*http.Response, <-chan e2eStreamEvent) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", testServer.URL+"/api/events/stream"+query, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer user-token")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	events := make(chan e2eStreamEvent, 16)
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+
+		var current e2eStreamEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				current.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				current.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &current.data)
+			case line == "" && current.event != "":
+				events <- current
+				current = e2eStreamEvent{}
+			}
+		}
+	}()
+
+	return resp, events
+}
+
+func nextE2EStreamEvent(t *testing.T, events <-chan e2eStreamEvent) e2eStreamEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "event stream closed")
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event received from stream")
+		return e2eStreamEvent{}
+	}
+}
+
+func TestE2E_EventStream(t *testing.T) {
+	setupE2ETestData(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "sse-team",
+		"members": []map[string]interface{}{
+			{"user_id": "sse-author", "username": "Author", "is_active": true},
+			{"user_id": "sse-r1", "username": "Reviewer 1", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp, _ = doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "sse-other",
+		"members": []map[string]interface{}{
+			{"user_id": "sse-other-author", "username": "Other Author", "is_active": true},
+			{"user_id": "sse-other-r1", "username": "Other Reviewer", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, teamEvents := openE2EEventStream(t, ctx, "?team_name=sse-team", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	for _, pr := range []struct{ id, author string }{{"sse-other-pr", "sse-other-author"}, {"sse-pr", "sse-author"}} {
+		resp, _ = doE2ERequest(t, "POST", "/api/pullRequest/create", "user-token", map[string]interface{}{
+			"pull_request_id": pr.id, "pull_request_name": "Streaming", "author_id": pr.author,
+		})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, _ = doE2ERequest(t, "POST", "/api/pullRequest/respond", "user-token", map[string]interface{}{
+		"pull_request_id": "sse-pr", "reviewer_id": "sse-r1", "verdict": "lgtm",
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp, body := doE2ERequest(t, "POST", "/api/pullRequest/respond", "user-token", map[string]interface{}{
+		"pull_request_id": "sse-pr", "reviewer_id": "sse-r1", "verdict": models.ReviewVerdictApproved,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, models.ReviewVerdictApproved, body["verdict"])
+
+	resp, _ = doE2ERequest(t, "POST", "/api/pullRequest/merge", "user-token", map[string]interface{}{
+		"pull_request_id": "sse-pr",
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	assigned := nextE2EStreamEvent(t, teamEvents)
+	assert.Equal(t, models.EventReviewersAssigned, assigned.event)
+	assert.Equal(t, "sse-pr", assigned.data.Data.PullRequestID)
+	assert.Equal(t, fmt.Sprint(assigned.data.ID), assigned.id)
+
+	verdict := nextE2EStreamEvent(t, teamEvents)
+	assert.Equal(t, models.EventReviewSubmitted, verdict.event)
+	assert.Equal(t, "sse-r1", verdict.data.Data.ReviewerID)
+	assert.Equal(t, models.ReviewVerdictApproved, verdict.data.Data.Verdict)
+
+	merged := nextE2EStreamEvent(t, teamEvents)
+	assert.Equal(t, models.EventPullRequestMerged, merged.event)
+
+	t.Run("resumes after last event id", func(t *testing.T) {
+		resp, resumed := openE2EEventStream(t, ctx, "?user_id=sse-r1", assigned.id)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		assert.Equal(t, verdict.id, nextE2EStreamEvent(t, resumed).id)
+		assert.Equal(t, merged.id, nextE2EStreamEvent(t, resumed).id)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		resp, _ := openE2EEventStream(t, ctx, "?team_name=missing", "")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, _ = openE2EEventStream(t, ctx, "", "not-a-number")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestE2E_NotificationPreferences(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "notify-team",
+		"members": []map[string]interface{}{
+			{"user_id": "notify-u1", "username": "Alice", "is_active": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "GET", "/api/users/notificationPreferences?user_id=notify-u1", "user-token", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, models.NotificationModeImmediate, body["mode"])
+	assert.Equal(t, notify.ChannelLog, body["channel"])
+
+	resp, body = doE2ERequest(t, "POST", "/api/users/setNotificationPreferences", "admin-token", map[string]interface{}{
+		"user_id": "notify-u1", "mode": models.NotificationModeDigest,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, models.NotificationModeDigest, body["mode"])
+
+	resp, body = doE2ERequest(t, "GET", "/api/users/notificationPreferences?user_id=notify-u1", "user-token", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, models.NotificationModeDigest, body["mode"])
+	assert.Equal(t, notify.ChannelLog, body["channel"])
+
+	t.Run("requires admin token", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/users/setNotificationPreferences", "user-token", map[string]interface{}{
+			"user_id": "notify-u1", "mode": models.NotificationModeImmediate,
+		})
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("channel not configured", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/users/setNotificationPreferences", "admin-token", map[string]interface{}{
+			"user_id": "notify-u1", "channel": notify.ChannelEmail, "email": "alice@example.com",
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/users/setNotificationPreferences", "admin-token", map[string]interface{}{
+			"user_id": "notify-missing",
+		})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, _ = doE2ERequest(t, "GET", "/api/users/notificationPreferences?user_id=notify-missing", "user-token", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_ReviewDigest(t *testing.T) {
+	setupE2ETestData(t)
+
+	resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", map[string]interface{}{
+		"team_name": "digest-team",
+		"members": []map[string]interface{}{
+			{"user_id": "digest-author", "username": "Author", "is_active": true},
+			{"user_id": "digest-r1", "username": "Reviewer", "is_active": true},
+			{"user_id": "digest-lead", "username": "Lead", "is_active": false, "role": models.TeamRoleLead},
+		},
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp, body := doE2ERequest(t, "POST", "/api/pullRequest/create", "user-token", map[string]interface{}{
+		"pull_request_id": "digest-pr", "pull_request_name": "Digest <feature>", "author_id": "digest-author",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	// Неактивный руководитель не назначается, поэтому единственный ревьювер - digest-r1.
+	assert.Equal(t, []interface{}{"digest-r1"}, body["pr"].(map[string]interface{})["assigned_reviewers"])
+	reviewer := "digest-r1"
+
+	resp, body = doE2ERequest(t, "GET", "/api/users/reviewDigest?user_id="+reviewer, "user-token", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	pending := body["pending_reviews"].([]interface{})
+	require.Len(t, pending, 1)
+	assert.Equal(t, "digest-pr", pending[0].(map[string]interface{})["pull_request_id"])
+
+	getDigest := func(query string) (*http.Response, string) {
+		req, err := http.NewRequest("GET", testServer.URL+"/api/users/reviewDigest"+query, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer user-token")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		content, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(content)
+	}
+
+	resp, text := getDigest("?format=text&user_id=" + reviewer)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/plain; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Contains(t, text, "default/digest-pr \"Digest <feature>\" by digest-author")
+
+	resp, html := getDigest("?format=html&user_id=digest-lead")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Contains(t, html, "<h2>Team digest-team</h2>")
+	assert.Contains(t, html, "<p>You have no pending reviews.</p>")
+
+	t.Run("invalid requests", func(t *testing.T) {
+		resp, _ := getDigest("?format=pdf&user_id=digest-lead")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, _ = getDigest("?user_id=digest-missing")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestE2E_GraphQL(t *testing.T) {
+	setupE2ETestData(t)
+
+	for _, team := range []map[string]interface{}{
+		{"team_name": "gql-backend", "members": []map[string]interface{}{
+			{"user_id": "gql-author", "username": "Author", "is_active": true},
+			{"user_id": "gql-r1", "username": "Reviewer", "is_active": true},
+		}},
+		{"team_name": "gql-frontend", "members": []map[string]interface{}{
+			{"user_id": "gql-r2", "username": "Frontend", "is_active": false},
+		}},
+	} {
+		resp, _ := doE2ERequest(t, "POST", "/api/team/add", "admin-token", team)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	resp, _ := doE2ERequest(t, "POST", "/api/pullRequest/create", "user-token", map[string]interface{}{
+		"pull_request_id": "gql-pr", "pull_request_name": "GraphQL", "author_id": "gql-author",
+	})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, body := doE2ERequest(t, "POST", "/api/graphql", "user-token", map[string]interface{}{
+		"query": `{
+			teams {
+				name
+				stats { memberCount prCount }
+				members { userId openReviews { pullRequestId } stats { openReviewCount assignmentCount } }
+			}
+		}`,
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Nil(t, body["errors"])
+
+	teams := body["data"].(map[string]interface{})["teams"].([]interface{})
+	require.Len(t, teams, 2)
+
+	backend := teams[0].(map[string]interface{})
+	assert.Equal(t, "gql-backend", backend["name"])
+	assert.Equal(t, map[string]interface{}{"memberCount": float64(2), "prCount": float64(1)}, backend["stats"])
+	members := backend["members"].([]interface{})
+	require.Len(t, members, 2)
+	reviewer := members[1].(map[string]interface{})
+	assert.Equal(t, "gql-r1", reviewer["userId"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"pullRequestId": "gql-pr"}}, reviewer["openReviews"])
+	assert.Equal(t, map[string]interface{}{"openReviewCount": float64(1), "assignmentCount": float64(1)}, reviewer["stats"])
+
+	frontend := teams[1].(map[string]interface{})
+	assert.Equal(t, "gql-frontend", frontend["name"])
+	assert.Len(t, frontend["members"], 1)
+
+	t.Run("not found", func(t *testing.T) {
+		resp, body := doE2ERequest(t, "POST", "/api/graphql", "user-token", map[string]interface{}{
+			"query": `{ team(name: "gql-missing") { name } }`,
+		})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		errs := body["errors"].([]interface{})
+		require.Len(t, errs, 1)
+		assert.Equal(t, "NOT_FOUND", errs[0].(map[string]interface{})["extensions"].(map[string]interface{})["code"])
+	})
+
+	t.Run("requires token", func(t *testing.T) {
+		resp, _ := doE2ERequest(t, "POST", "/api/graphql", "", map[string]interface{}{"query": `{ teams { name } }`})
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
 }
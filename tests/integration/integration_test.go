@@ -127,10 +127,12 @@ func TestIntegration_FullWorkflow(t *testing.T) {
 	userRepo := repository.NewPostgresUserRepository(dbPool)
 	teamRepo := repository.NewPostgresTeamRepository(dbPool)
 	prRepo := repository.NewPostgresPullRequestRepository(dbPool)
+	repoRepo := repository.NewPostgresRepoRepository(dbPool)
+	codeOwnersRepo := repository.NewPostgresCodeOwnersRepository(dbPool)
 
 	userSvc := services.NewUserService(userRepo)
 	teamSvc := services.NewTeamService(teamRepo, userRepo)
-	prSvc := services.NewPullRequestService(prRepo, userRepo, teamRepo, userSvc)
+	prSvc := services.NewPullRequestService(prRepo, userRepo, teamRepo, repoRepo, codeOwnersRepo, userSvc)
 
 	teamMembers := []models.TeamMember{
 		{UserID: "user1", Username: "User One", IsActive: true},
@@ -155,21 +157,28 @@ func TestIntegration_FullWorkflow(t *testing.T) {
 	assert.Equal(t, "pr-001", createdPR.PullRequestID)
 	assert.Len(t, createdPR.AssignedReviewers, 2)
 
-	reviewers, err := prRepo.GetAssignedReviewers(ctx, "pr-001")
+	reviewers, err := prRepo.GetAssignedReviewers(ctx, models.DefaultRepository, "pr-001")
 	require.NoError(t, err)
 	assert.Len(t, reviewers, 2)
 	assert.NotContains(t, reviewers, "user1")
 
-	err = prSvc.MergePullRequest(ctx, "pr-001")
+	mergedResult, err := prSvc.MergePullRequest(ctx, models.DefaultRepository, "pr-001")
 	require.NoError(t, err)
+	assert.Equal(t, models.PRStatusMerged, mergedResult.Status)
 
-	mergedPR, err := prRepo.GetPullRequestByID(ctx, "pr-001")
+	mergedPR, err := prRepo.GetPullRequestByID(ctx, models.DefaultRepository, "pr-001")
 	require.NoError(t, err)
 	assert.Equal(t, models.PRStatusMerged, mergedPR.Status)
 	assert.NotNil(t, mergedPR.MergedAt)
 
-	userPRs, err := prSvc.GetUserPullRequests(ctx, reviewers[0])
+	openPRs, err := prSvc.GetUserPullRequests(ctx, reviewers[0], models.ReviewFilter{}, "")
 	require.NoError(t, err)
-	assert.Len(t, userPRs, 1)
-	assert.Equal(t, "pr-001", userPRs[0].PullRequestID)
+	assert.Empty(t, openPRs.PullRequests)
+	assert.Equal(t, 0, openPRs.TotalCount)
+
+	userPRs, err := prSvc.GetUserPullRequests(ctx, reviewers[0], models.ReviewFilter{Status: models.PRStatusAll}, "")
+	require.NoError(t, err)
+	assert.Len(t, userPRs.PullRequests, 1)
+	assert.Equal(t, 1, userPRs.TotalCount)
+	assert.Equal(t, "pr-001", userPRs.PullRequests[0].PullRequestID)
 }